@@ -1,6 +1,6 @@
 // Package api provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/deepmap/oapi-codegen version v1.15.0 DO NOT EDIT.
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
 package api
 
 import (
@@ -28,6 +28,26 @@ const (
 	Basic_authScopes = "basic_auth.Scopes"
 )
 
+// Defines values for AccessSubjectKind.
+const (
+	AllUsers  AccessSubjectKind = "all_users"
+	OrgAdmins AccessSubjectKind = "org_admins"
+	User      AccessSubjectKind = "user"
+)
+
+// Defines values for AuditLogEntryOutcome.
+const (
+	Failure AuditLogEntryOutcome = "failure"
+	Success AuditLogEntryOutcome = "success"
+)
+
+// Defines values for AuditUpdateOperation.
+const (
+	CREATE AuditUpdateOperation = "CREATE"
+	DELETE AuditUpdateOperation = "DELETE"
+	TOUCH  AuditUpdateOperation = "TOUCH"
+)
+
 // Defines values for CrossAccountRequestPatchStatus.
 const (
 	CrossAccountRequestPatchStatusApproved  CrossAccountRequestPatchStatus = "approved"
@@ -37,12 +57,40 @@ const (
 	CrossAccountRequestPatchStatusPending   CrossAccountRequestPatchStatus = "pending"
 )
 
+// Defines values for ExplainStepResult.
+const (
+	ExplainStepResultConditional ExplainStepResult = "conditional"
+	ExplainStepResultDenied      ExplainStepResult = "denied"
+	ExplainStepResultGranted     ExplainStepResult = "granted"
+)
+
+// Defines values for ImportReportEntryKind.
+const (
+	ImportReportEntryKindAccess    ImportReportEntryKind = "access"
+	ImportReportEntryKindGroup     ImportReportEntryKind = "group"
+	ImportReportEntryKindPolicy    ImportReportEntryKind = "policy"
+	ImportReportEntryKindPrincipal ImportReportEntryKind = "principal"
+	ImportReportEntryKindRole      ImportReportEntryKind = "role"
+	ImportReportEntryKindWorkspace ImportReportEntryKind = "workspace"
+)
+
 // Defines values for ResourceDefinitionFilterOperation.
 const (
 	Equal ResourceDefinitionFilterOperation = "equal"
 	In    ResourceDefinitionFilterOperation = "in"
 )
 
+// Defines values for WebhookEventType.
+const (
+	AdminGroupPrincipalsAdded  WebhookEventType = "admin_group.principals_added"
+	GroupPrincipalsAdded       WebhookEventType = "group.principals_added"
+	GroupPrincipalsRemoved     WebhookEventType = "group.principals_removed"
+	GroupRolesAdded            WebhookEventType = "group.roles_added"
+	RoleCreated                WebhookEventType = "role.created"
+	RoleUpdated                WebhookEventType = "role.updated"
+	RoleWritePermissionGranted WebhookEventType = "role.write_permission_granted"
+)
+
 // Defines values for NameMatchCriteria.
 const (
 	NameMatchCriteriaExact   NameMatchCriteria = "exact"
@@ -82,11 +130,11 @@ const (
 
 // Defines values for ListCrossAccountRequestsParamsStatus.
 const (
-	ListCrossAccountRequestsParamsStatusApproved  ListCrossAccountRequestsParamsStatus = "approved"
-	ListCrossAccountRequestsParamsStatusCancelled ListCrossAccountRequestsParamsStatus = "cancelled"
-	ListCrossAccountRequestsParamsStatusDenied    ListCrossAccountRequestsParamsStatus = "denied"
-	ListCrossAccountRequestsParamsStatusExpired   ListCrossAccountRequestsParamsStatus = "expired"
-	ListCrossAccountRequestsParamsStatusPending   ListCrossAccountRequestsParamsStatus = "pending"
+	Approved  ListCrossAccountRequestsParamsStatus = "approved"
+	Cancelled ListCrossAccountRequestsParamsStatus = "cancelled"
+	Denied    ListCrossAccountRequestsParamsStatus = "denied"
+	Expired   ListCrossAccountRequestsParamsStatus = "expired"
+	Pending   ListCrossAccountRequestsParamsStatus = "pending"
 )
 
 // Defines values for ListCrossAccountRequestsParamsOrderBy.
@@ -294,6 +342,43 @@ type AccessPagination struct {
 	Meta  *PaginationMeta  `json:"meta,omitempty"`
 }
 
+// AccessPath defines model for AccessPath.
+type AccessPath struct {
+	// Group The group the subject is a member of, if the role binding is to a group
+	Group *string `json:"group,omitempty"`
+
+	// Permission The permission of the role that grants access
+	Permission  string  `json:"permission"`
+	Role        string  `json:"role"`
+	RoleBinding string  `json:"role_binding"`
+	RoleName    *string `json:"role_name,omitempty"`
+
+	// Workspace The workspace the role is bound in, the resource's own or one of its ancestors
+	Workspace string `json:"workspace"`
+}
+
+// AccessSubject defines model for AccessSubject.
+type AccessSubject struct {
+	// ExcludedUsernames Users excluded from access granted to all users
+	ExcludedUsernames *[]string `json:"excluded_usernames,omitempty"`
+
+	// Kind Whether access is granted to a single user, to all users, or to all organization administrators
+	Kind  AccessSubjectKind `json:"kind"`
+	Paths *[]AccessPath     `json:"paths,omitempty"`
+
+	// Username Set for kind user
+	Username *string `json:"username,omitempty"`
+}
+
+// AccessSubjectKind Whether access is granted to a single user, to all users, or to all organization administrators
+type AccessSubjectKind string
+
+// AccessSubjectList defines model for AccessSubjectList.
+type AccessSubjectList struct {
+	Data []AccessSubject `json:"data"`
+	Meta PaginationMeta  `json:"meta"`
+}
+
 // AdditionalGroup defines model for AdditionalGroup.
 type AdditionalGroup struct {
 	Description *string `json:"description,omitempty"`
@@ -301,6 +386,113 @@ type AdditionalGroup struct {
 	Uuid        *string `json:"uuid,omitempty"`
 }
 
+// AuditLogEntry defines model for AuditLogEntry.
+type AuditLogEntry struct {
+	Actor struct {
+		IsOrgAdmin *bool `json:"is_org_admin,omitempty"`
+
+		// Username Empty for changes replicated from RBAC v1 or made by the command line
+		Username string `json:"username"`
+	} `json:"actor"`
+	Error     *string              `json:"error,omitempty"`
+	Id        openapi_types.UUID   `json:"id"`
+	Operation string               `json:"operation"`
+	OrgId     string               `json:"org_id"`
+	Outcome   AuditLogEntryOutcome `json:"outcome"`
+	Targets   []AuditTarget        `json:"targets"`
+	Time      time.Time            `json:"time"`
+	Updates   []AuditUpdate        `json:"updates"`
+
+	// ZedToken The revision the updates were written at, if they were
+	ZedToken *string `json:"zed_token,omitempty"`
+}
+
+// AuditLogEntryOutcome defines model for AuditLogEntry.Outcome.
+type AuditLogEntryOutcome string
+
+// AuditLogPagination defines model for AuditLogPagination.
+type AuditLogPagination struct {
+	Data  []AuditLogEntry  `json:"data"`
+	Links *PaginationLinks `json:"links,omitempty"`
+	Meta  *PaginationMeta  `json:"meta,omitempty"`
+}
+
+// AuditTarget defines model for AuditTarget.
+type AuditTarget struct {
+	Id   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// AuditUpdate defines model for AuditUpdate.
+type AuditUpdate struct {
+	Operation    AuditUpdateOperation `json:"operation"`
+	Relationship string               `json:"relationship"`
+}
+
+// AuditUpdateOperation defines model for AuditUpdate.Operation.
+type AuditUpdateOperation string
+
+// CheckBulkIn defines model for CheckBulkIn.
+type CheckBulkIn struct {
+	Data []CheckItem `json:"data"`
+
+	// Debug Include the SpiceDB debug trace of each check
+	Debug *bool `json:"debug,omitempty"`
+
+	// Username Unique username of the principal to check, the principal from the identity header if not set
+	Username *string `json:"username,omitempty"`
+}
+
+// CheckIn defines model for CheckIn.
+type CheckIn struct {
+	// Debug Include the SpiceDB debug trace of each check
+	Debug      *bool  `json:"debug,omitempty"`
+	Permission string `json:"permission"`
+	ResourceId string `json:"resource_id"`
+
+	// ResourceType Resource type as defined in the schema
+	ResourceType string `json:"resource_type"`
+
+	// Username Unique username of the principal to check, the principal from the identity header if not set
+	Username *string `json:"username,omitempty"`
+}
+
+// CheckItem defines model for CheckItem.
+type CheckItem struct {
+	Permission string `json:"permission"`
+	ResourceId string `json:"resource_id"`
+
+	// ResourceType Resource type as defined in the schema
+	ResourceType string `json:"resource_type"`
+}
+
+// CheckOptions defines model for CheckOptions.
+type CheckOptions struct {
+	// Debug Include the SpiceDB debug trace of each check
+	Debug *bool `json:"debug,omitempty"`
+
+	// Username Unique username of the principal to check, the principal from the identity header if not set
+	Username *string `json:"username,omitempty"`
+}
+
+// CheckResult defines model for CheckResult.
+type CheckResult struct {
+	Allowed bool `json:"allowed"`
+
+	// DebugTrace The SpiceDB debug trace for each subject the principal was checked as: the principal itself and the default groups it is implicitly a member of
+	DebugTrace *[]map[string]interface{} `json:"debug_trace,omitempty"`
+	Permission string                    `json:"permission"`
+	ResourceId string                    `json:"resource_id"`
+
+	// ResourceType Resource type as defined in the schema
+	ResourceType string `json:"resource_type"`
+}
+
+// CheckResultList defines model for CheckResultList.
+type CheckResultList struct {
+	Data []CheckResult `json:"data"`
+}
+
 // CrossAccountRequest defines model for CrossAccountRequest.
 type CrossAccountRequest struct {
 	Created       *time.Time          `json:"created,omitempty"`
@@ -482,6 +674,52 @@ type ErrorNotFound struct {
 	} `json:"errors"`
 }
 
+// ExplainStep defines model for ExplainStep.
+type ExplainStep struct {
+	// Cached The result was reused from an earlier evaluation, so has no steps
+	Cached *bool  `json:"cached,omitempty"`
+	Id     string `json:"id"`
+
+	// Name Name of the workspace, role or group
+	Name *string `json:"name,omitempty"`
+
+	// Permission The permission or relation evaluated on the object, in RBAC v1 form where there is one
+	Permission string            `json:"permission"`
+	Result     ExplainStepResult `json:"result"`
+	Steps      *[]ExplainStep    `json:"steps,omitempty"`
+
+	// Type Object type as defined in the schema
+	Type string `json:"type"`
+}
+
+// ExplainStepResult defines model for ExplainStep.Result.
+type ExplainStepResult string
+
+// ExplainTrace defines model for ExplainTrace.
+type ExplainTrace struct {
+	Allowed bool `json:"allowed"`
+
+	// Subject What the principal was checked as: the user itself, or a default group it is implicitly a member of
+	Subject string       `json:"subject"`
+	Trace   *ExplainStep `json:"trace,omitempty"`
+}
+
+// Explanation defines model for Explanation.
+type Explanation struct {
+	Allowed    bool          `json:"allowed"`
+	GrantedBy  *[]AccessPath `json:"granted_by,omitempty"`
+	Permission string        `json:"permission"`
+
+	// Reason Why access is granted, or where the chain from the resource to the principal breaks
+	Reason     string `json:"reason"`
+	ResourceId string `json:"resource_id"`
+
+	// ResourceType Resource type as defined in the schema
+	ResourceType string         `json:"resource_type"`
+	Traces       []ExplainTrace `json:"traces"`
+	Username     string         `json:"username"`
+}
+
 // Group defines model for Group.
 type Group struct {
 	Description *string `json:"description,omitempty"`
@@ -537,12 +775,54 @@ type GroupWithPrincipalsAndRoles struct {
 	Uuid        openapi_types.UUID `json:"uuid"`
 }
 
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	// Batches Number of write requests made, or that would be made
+	Batches  int                 `json:"batches"`
+	Created  []ImportReportEntry `json:"created"`
+	DryRun   bool                `json:"dry_run"`
+	Rejected []ImportReportEntry `json:"rejected"`
+
+	// Relationships Number of relationship updates written, or that would be written
+	Relationships int                 `json:"relationships"`
+	Skipped       []ImportReportEntry `json:"skipped"`
+}
+
+// ImportReportEntry defines model for ImportReportEntry.
+type ImportReportEntry struct {
+	// Id UUID of the role, group or policy, id of the workspace, or username of the principal
+	Id   string                `json:"id"`
+	Kind ImportReportEntryKind `json:"kind"`
+	Name *string               `json:"name,omitempty"`
+
+	// Reason Why the entry was skipped or rejected, or for access, what of the role it is about
+	Reason *string `json:"reason,omitempty"`
+}
+
+// ImportReportEntryKind defines model for ImportReportEntry.Kind.
+type ImportReportEntryKind string
+
 // ListPagination defines model for ListPagination.
 type ListPagination struct {
 	Links *PaginationLinks `json:"links,omitempty"`
 	Meta  *PaginationMeta  `json:"meta,omitempty"`
 }
 
+// Organization defines model for Organization.
+type Organization struct {
+	AdminDefaultGroup    openapi_types.UUID `json:"admin_default_group"`
+	OrgId                string             `json:"org_id"`
+	PlatformDefaultGroup openapi_types.UUID `json:"platform_default_group"`
+	Realm                string             `json:"realm"`
+	RootWorkspace        string             `json:"root_workspace"`
+}
+
+// OrganizationIn defines model for OrganizationIn.
+type OrganizationIn struct {
+	// Realm Realm the organization belongs to
+	Realm *string `json:"realm,omitempty"`
+}
+
 // PaginationLinks defines model for PaginationLinks.
 type PaginationLinks struct {
 	First    *string `json:"first,omitempty"`
@@ -655,6 +935,69 @@ type PrincipalPagination_Data_Item struct {
 	union json.RawMessage
 }
 
+// RbacExport defines model for RbacExport.
+type RbacExport struct {
+	Groups   *[]RbacExportGroup  `json:"groups,omitempty"`
+	Policies *[]RbacExportPolicy `json:"policies,omitempty"`
+
+	// Principals Principals of the tenant. If given, group members must be among them.
+	Principals *[]PrincipalIn    `json:"principals,omitempty"`
+	Roles      *[]RbacExportRole `json:"roles,omitempty"`
+
+	// Workspaces Workspaces below the tenant's root workspace, each after its parent. Not part of RBAC v1.
+	Workspaces *[]RbacExportWorkspace `json:"workspaces,omitempty"`
+}
+
+// RbacExportGroup defines model for RbacExportGroup.
+type RbacExportGroup struct {
+	AdminDefault    *bool              `json:"admin_default,omitempty"`
+	Description     *string            `json:"description,omitempty"`
+	Name            string             `json:"name"`
+	PlatformDefault *bool              `json:"platform_default,omitempty"`
+	Principals      *[]PrincipalIn     `json:"principals,omitempty"`
+	System          *bool              `json:"system,omitempty"`
+	Uuid            openapi_types.UUID `json:"uuid"`
+}
+
+// RbacExportPolicy defines model for RbacExportPolicy.
+type RbacExportPolicy struct {
+	Description *string              `json:"description,omitempty"`
+	Group       openapi_types.UUID   `json:"group"`
+	Name        string               `json:"name"`
+	Roles       []openapi_types.UUID `json:"roles"`
+	Uuid        *openapi_types.UUID  `json:"uuid,omitempty"`
+}
+
+// RbacExportRole defines model for RbacExportRole.
+type RbacExportRole struct {
+	Access          []Access           `json:"access"`
+	AdminDefault    *bool              `json:"admin_default,omitempty"`
+	Description     *string            `json:"description,omitempty"`
+	DisplayName     *string            `json:"display_name,omitempty"`
+	Name            string             `json:"name"`
+	PlatformDefault *bool              `json:"platform_default,omitempty"`
+	System          *bool              `json:"system,omitempty"`
+	Uuid            openapi_types.UUID `json:"uuid"`
+}
+
+// RbacExportWorkspace defines model for RbacExportWorkspace.
+type RbacExportWorkspace struct {
+	Description *string `json:"description,omitempty"`
+	Id          string  `json:"id"`
+	Name        string  `json:"name"`
+
+	// Parent ID of the parent workspace, the tenant's root workspace if not set
+	Parent *string `json:"parent,omitempty"`
+}
+
+// Resource defines model for Resource.
+type Resource = ResourceIn
+
+// ResourceBulkIn defines model for ResourceBulkIn.
+type ResourceBulkIn struct {
+	Data []ResourceIn `json:"data"`
+}
+
 // ResourceDefinition defines model for ResourceDefinition.
 type ResourceDefinition struct {
 	AttributeFilter ResourceDefinitionFilter `json:"attributeFilter"`
@@ -670,6 +1013,41 @@ type ResourceDefinitionFilter struct {
 // ResourceDefinitionFilterOperation defines model for ResourceDefinitionFilter.Operation.
 type ResourceDefinitionFilterOperation string
 
+// ResourceIdPagination defines model for ResourceIdPagination.
+type ResourceIdPagination struct {
+	Data  []string `json:"data"`
+	Links *struct {
+		Next *string `json:"next,omitempty"`
+	} `json:"links,omitempty"`
+	Meta struct {
+		// Cursor Cursor for the next page, not set on the last page
+		Cursor *string `json:"cursor,omitempty"`
+		Limit  int     `json:"limit"`
+	} `json:"meta"`
+}
+
+// ResourceIn defines model for ResourceIn.
+type ResourceIn struct {
+	Id string `json:"id"`
+
+	// Type Resource type as defined in the schema
+	Type string `json:"type"`
+
+	// Workspace ID of the workspace the resource is placed in
+	Workspace string `json:"workspace"`
+}
+
+// ResourceList defines model for ResourceList.
+type ResourceList struct {
+	Data []Resource `json:"data"`
+}
+
+// ResourceMove defines model for ResourceMove.
+type ResourceMove struct {
+	// Workspace ID of the workspace the resource is placed in
+	Workspace string `json:"workspace"`
+}
+
 // Role defines model for Role.
 type Role struct {
 	Description *string `json:"description,omitempty"`
@@ -761,6 +1139,12 @@ type RoleWithAccess struct {
 type Status struct {
 	ApiVersion int64   `json:"api_version"`
 	Commit     *string `json:"commit,omitempty"`
+
+	// ServicesLoaded When the services mapping in use was loaded
+	ServicesLoaded *time.Time `json:"services_loaded,omitempty"`
+
+	// ServicesVersion Version of the services mapping in use, changing whenever a change to it is reloaded
+	ServicesVersion *string `json:"services_version,omitempty"`
 }
 
 // Timestamped defines model for Timestamped.
@@ -774,6 +1158,61 @@ type UUID struct {
 	Uuid openapi_types.UUID `json:"uuid"`
 }
 
+// Webhook defines model for Webhook.
+type Webhook struct {
+	Created    time.Time          `json:"created"`
+	EventTypes []WebhookEventType `json:"event_types"`
+	Url        string             `json:"url"`
+	Uuid       openapi_types.UUID `json:"uuid"`
+}
+
+// WebhookEventType group.principals_added, group.principals_removed and group.roles_added follow changes to groups; admin_group.principals_added follows principals added to groups that administer user access, by the User Access Administrator role or a role with unrestricted rbac write permissions; role.created and role.updated follow changes to roles, and role.write_permission_granted follows roles created with, or updated to gain, write permissions
+type WebhookEventType string
+
+// WebhookIn defines model for WebhookIn.
+type WebhookIn struct {
+	EventTypes []WebhookEventType `json:"event_types"`
+
+	// Secret Key of the HMAC deliveries are signed with
+	Secret *string `json:"secret,omitempty"`
+	Url    string  `json:"url"`
+}
+
+// WebhookPagination defines model for WebhookPagination.
+type WebhookPagination struct {
+	Data  []Webhook        `json:"data"`
+	Links *PaginationLinks `json:"links,omitempty"`
+	Meta  *PaginationMeta  `json:"meta,omitempty"`
+}
+
+// Workspace defines model for Workspace.
+type Workspace struct {
+	Created     time.Time `json:"created"`
+	Description *string   `json:"description,omitempty"`
+	Id          string    `json:"id"`
+	Modified    time.Time `json:"modified"`
+	Name        string    `json:"name"`
+
+	// Parent ID of the parent workspace, the tenant's root workspace if not set
+	Parent *string `json:"parent,omitempty"`
+}
+
+// WorkspaceIn defines model for WorkspaceIn.
+type WorkspaceIn struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+
+	// Parent ID of the parent workspace, the tenant's root workspace if not set
+	Parent *string `json:"parent,omitempty"`
+}
+
+// WorkspacePagination defines model for WorkspacePagination.
+type WorkspacePagination struct {
+	Data  []Workspace      `json:"data"`
+	Links *PaginationLinks `json:"links,omitempty"`
+	Meta  *PaginationMeta  `json:"meta,omitempty"`
+}
+
 // GroupNameFilter defines model for GroupNameFilter.
 type GroupNameFilter = string
 
@@ -786,18 +1225,33 @@ type NameFilter = string
 // NameMatchCriteria defines model for NameMatchCriteria.
 type NameMatchCriteria string
 
+// OrgId defines model for OrgId.
+type OrgId = string
+
 // QueryLimit defines model for QueryLimit.
 type QueryLimit = int
 
 // QueryOffset defines model for QueryOffset.
 type QueryOffset = int
 
+// ResourceId defines model for ResourceId.
+type ResourceId = string
+
+// ResourceName defines model for ResourceName.
+type ResourceName = string
+
+// ResourceNamespace defines model for ResourceNamespace.
+type ResourceNamespace = string
+
 // ScopeFilter defines model for ScopeFilter.
 type ScopeFilter string
 
 // SystemFilter defines model for SystemFilter.
 type SystemFilter = bool
 
+// WorkspaceId defines model for WorkspaceId.
+type WorkspaceId = string
+
 // GetPrincipalAccessParams defines parameters for GetPrincipalAccess.
 type GetPrincipalAccessParams struct {
 	// Application The application name(s) to obtain access for the principal. This is an exact match. When no application is supplied, all permissions for the principal are returned. You may also use a comma-separated list to match on multiple applications.
@@ -825,6 +1279,78 @@ type GetPrincipalAccessParamsOrderBy string
 // GetPrincipalAccessParamsStatus defines parameters for GetPrincipalAccess.
 type GetPrincipalAccessParamsStatus string
 
+// ExplainAccessParams defines parameters for ExplainAccess.
+type ExplainAccessParams struct {
+	// ResourceType Resource type as defined in the schema
+	ResourceType string `form:"resource_type" json:"resource_type"`
+
+	// ResourceId ID of the resource
+	ResourceId string `form:"resource_id" json:"resource_id"`
+
+	// Permission The permission to explain
+	Permission string `form:"permission" json:"permission"`
+
+	// Username Unique username of the principal to explain access for, the principal from the identity header if not set
+	Username *string `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// LookupAccessibleResourcesParams defines parameters for LookupAccessibleResources.
+type LookupAccessibleResourcesParams struct {
+	// ResourceType Resource type as defined in the schema
+	ResourceType string `form:"resource_type" json:"resource_type"`
+
+	// Permission The permission to look up resources for
+	Permission string `form:"permission" json:"permission"`
+
+	// Username Unique username of the principal to look up resources for, the principal from the identity header if not set
+	Username *string `form:"username,omitempty" json:"username,omitempty"`
+
+	// Limit Parameter for selecting the amount of data returned.
+	Limit *QueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Continuation cursor from the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// LookupAccessSubjectsParams defines parameters for LookupAccessSubjects.
+type LookupAccessSubjectsParams struct {
+	// ResourceType Resource type as defined in the schema
+	ResourceType string `form:"resource_type" json:"resource_type"`
+
+	// ResourceId ID of the resource
+	ResourceId string `form:"resource_id" json:"resource_id"`
+
+	// Permission The permission to list subjects for
+	Permission string `form:"permission" json:"permission"`
+
+	// Paths Include the paths through which each subject has the permission
+	Paths *bool `form:"paths,omitempty" json:"paths,omitempty"`
+}
+
+// ListAuditLogParams defines parameters for ListAuditLog.
+type ListAuditLogParams struct {
+	// Limit Parameter for selecting the amount of data returned.
+	Limit *QueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Parameter for selecting the offset of data.
+	Offset *QueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Start Only entries at or after this time
+	Start *time.Time `form:"start,omitempty" json:"start,omitempty"`
+
+	// End Only entries before this time
+	End *time.Time `form:"end,omitempty" json:"end,omitempty"`
+
+	// Actor Only entries of operations by this username
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Target Only entries targeting the object with this id, e.g. a group, role, workspace or principal
+	Target *string `form:"target,omitempty" json:"target,omitempty"`
+
+	// Operation Only entries of this operation, e.g. addPrincipalToGroup
+	Operation *string `form:"operation,omitempty" json:"operation,omitempty"`
+}
+
 // ListCrossAccountRequestsParams defines parameters for ListCrossAccountRequests.
 type ListCrossAccountRequestsParams struct {
 	// Limit Parameter for selecting the amount of data returned.
@@ -1014,6 +1540,15 @@ type ListRolesForGroupParams struct {
 // ListRolesForGroupParamsOrderBy defines parameters for ListRolesForGroup.
 type ListRolesForGroupParamsOrderBy string
 
+// ImportRbacExportParams defines parameters for ImportRbacExport.
+type ImportRbacExportParams struct {
+	// DryRun Report what would be imported without writing anything
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// BatchSize Number of relationships to write per request to SpiceDB
+	BatchSize *int `form:"batch_size,omitempty" json:"batch_size,omitempty"`
+}
+
 // ListPermissionsParams defines parameters for ListPermissions.
 type ListPermissionsParams struct {
 	// Limit Parameter for selecting the amount of data returned.
@@ -1245,6 +1780,39 @@ type GetRoleAccessParams struct {
 	Offset *QueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// ListWebhooksParams defines parameters for ListWebhooks.
+type ListWebhooksParams struct {
+	// Limit Parameter for selecting the amount of data returned.
+	Limit *QueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Parameter for selecting the offset of data.
+	Offset *QueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListWorkspacesParams defines parameters for ListWorkspaces.
+type ListWorkspacesParams struct {
+	// Limit Parameter for selecting the amount of data returned.
+	Limit *QueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Parameter for selecting the offset of data.
+	Offset *QueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListWorkspaceChildrenParams defines parameters for ListWorkspaceChildren.
+type ListWorkspaceChildrenParams struct {
+	// Limit Parameter for selecting the amount of data returned.
+	Limit *QueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Parameter for selecting the offset of data.
+	Offset *QueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// CheckAccessJSONRequestBody defines body for CheckAccess for application/json ContentType.
+type CheckAccessJSONRequestBody = CheckIn
+
+// CheckAccessBulkJSONRequestBody defines body for CheckAccessBulk for application/json ContentType.
+type CheckAccessBulkJSONRequestBody = CheckBulkIn
+
 // CreateCrossAccountRequestsJSONRequestBody defines body for CreateCrossAccountRequests for application/json ContentType.
 type CreateCrossAccountRequestsJSONRequestBody = CrossAccountRequestIn
 
@@ -1266,12 +1834,27 @@ type AddPrincipalToGroupJSONRequestBody = GroupPrincipalIn
 // AddRoleToGroupJSONRequestBody defines body for AddRoleToGroup for application/json ContentType.
 type AddRoleToGroupJSONRequestBody = GroupRoleIn
 
+// ImportRbacExportJSONRequestBody defines body for ImportRbacExport for application/json ContentType.
+type ImportRbacExportJSONRequestBody = RbacExport
+
+// ProvisionOrganizationJSONRequestBody defines body for ProvisionOrganization for application/json ContentType.
+type ProvisionOrganizationJSONRequestBody = OrganizationIn
+
 // CreatePoliciesJSONRequestBody defines body for CreatePolicies for application/json ContentType.
 type CreatePoliciesJSONRequestBody = PolicyIn
 
 // UpdatePolicyJSONRequestBody defines body for UpdatePolicy for application/json ContentType.
 type UpdatePolicyJSONRequestBody = PolicyIn
 
+// CreateResourceJSONRequestBody defines body for CreateResource for application/json ContentType.
+type CreateResourceJSONRequestBody = ResourceIn
+
+// UpsertResourcesJSONRequestBody defines body for UpsertResources for application/json ContentType.
+type UpsertResourcesJSONRequestBody = ResourceBulkIn
+
+// MoveResourceJSONRequestBody defines body for MoveResource for application/json ContentType.
+type MoveResourceJSONRequestBody = ResourceMove
+
 // CreateRoleJSONRequestBody defines body for CreateRole for application/json ContentType.
 type CreateRoleJSONRequestBody = RoleIn
 
@@ -1281,6 +1864,15 @@ type PatchRoleJSONRequestBody = RolePatch
 // UpdateRoleJSONRequestBody defines body for UpdateRole for application/json ContentType.
 type UpdateRoleJSONRequestBody = RoleWithAccess
 
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = WebhookIn
+
+// CreateWorkspaceJSONRequestBody defines body for CreateWorkspace for application/json ContentType.
+type CreateWorkspaceJSONRequestBody = WorkspaceIn
+
+// UpdateWorkspaceJSONRequestBody defines body for UpdateWorkspace for application/json ContentType.
+type UpdateWorkspaceJSONRequestBody = WorkspaceIn
+
 // AsCrossAccountRequestDetailByAccount returns the union data inside the CrossAccountRequestDetail as a CrossAccountRequestDetailByAccount
 func (t CrossAccountRequestDetail) AsCrossAccountRequestDetailByAccount() (CrossAccountRequestDetailByAccount, error) {
 	var body CrossAccountRequestDetailByAccount
@@ -1534,6 +2126,24 @@ type ServerInterface interface {
 	// Get the permitted access for a principal in the tenant (defaults to principal from the identity header)
 	// (GET /access/)
 	GetPrincipalAccess(w http.ResponseWriter, r *http.Request, params GetPrincipalAccessParams)
+	// Check whether a principal has a permission on a resource (defaults to principal from the identity header)
+	// (POST /access/check/)
+	CheckAccess(w http.ResponseWriter, r *http.Request)
+	// Check a principal's permissions on many resources at once (defaults to principal from the identity header)
+	// (POST /access/check/bulk/)
+	CheckAccessBulk(w http.ResponseWriter, r *http.Request)
+	// Explain why a principal has or lacks a permission on a resource (defaults to principal from the identity header)
+	// (GET /access/explain/)
+	ExplainAccess(w http.ResponseWriter, r *http.Request, params ExplainAccessParams)
+	// List the resources of a type a principal has a permission on (defaults to principal from the identity header)
+	// (GET /access/resources/)
+	LookupAccessibleResources(w http.ResponseWriter, r *http.Request, params LookupAccessibleResourcesParams)
+	// List who has a permission on a resource in the tenant
+	// (GET /access/subjects/)
+	LookupAccessSubjects(w http.ResponseWriter, r *http.Request, params LookupAccessSubjectsParams)
+	// List the tenant's audit log, newest first
+	// (GET /audit-log/)
+	ListAuditLog(w http.ResponseWriter, r *http.Request, params ListAuditLogParams)
 	// List the cross account requests for a user or account
 	// (GET /cross-account-requests/)
 	ListCrossAccountRequests(w http.ResponseWriter, r *http.Request, params ListCrossAccountRequestsParams)
@@ -1549,6 +2159,9 @@ type ServerInterface interface {
 	// Update a cross account request
 	// (PUT /cross-account-requests/{uuid}/)
 	PutCrossAccountRequest(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID)
+	// Export the tenant's roles, groups, principals, policies and workspaces
+	// (GET /export/)
+	ExportRbac(w http.ResponseWriter, r *http.Request)
 	// List the groups for a tenant
 	// (GET /groups/)
 	ListGroups(w http.ResponseWriter, r *http.Request, params ListGroupsParams)
@@ -1582,6 +2195,15 @@ type ServerInterface interface {
 	// Add a role to a group in the tenant
 	// (POST /groups/{uuid}/roles/)
 	AddRoleToGroup(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID)
+	// Import roles, groups, principals and policies from an RBAC v1 export
+	// (POST /import/)
+	ImportRbacExport(w http.ResponseWriter, r *http.Request, params ImportRbacExportParams)
+	// Deprovision a tenant
+	// (DELETE /organizations/{org_id}/)
+	DeprovisionOrganization(w http.ResponseWriter, r *http.Request, orgId OrgId)
+	// Provision a tenant
+	// (PUT /organizations/{org_id}/)
+	ProvisionOrganization(w http.ResponseWriter, r *http.Request, orgId OrgId)
 	// List the permissions for a tenant
 	// (GET /permissions/)
 	ListPermissions(w http.ResponseWriter, r *http.Request, params ListPermissionsParams)
@@ -1606,6 +2228,21 @@ type ServerInterface interface {
 	// List the principals for a tenant
 	// (GET /principals/)
 	ListPrincipals(w http.ResponseWriter, r *http.Request, params ListPrincipalsParams)
+	// Register a resource in a workspace
+	// (POST /resources/)
+	CreateResource(w http.ResponseWriter, r *http.Request)
+	// Register or move many resources at once
+	// (PUT /resources/)
+	UpsertResources(w http.ResponseWriter, r *http.Request)
+	// Unregister a resource
+	// (DELETE /resources/{namespace}/{name}/{id}/)
+	DeleteResource(w http.ResponseWriter, r *http.Request, namespace ResourceNamespace, name ResourceName, id ResourceId)
+	// Get the workspace a resource is registered in
+	// (GET /resources/{namespace}/{name}/{id}/)
+	GetResource(w http.ResponseWriter, r *http.Request, namespace ResourceNamespace, name ResourceName, id ResourceId)
+	// Move a resource to another workspace
+	// (PUT /resources/{namespace}/{name}/{id}/)
+	MoveResource(w http.ResponseWriter, r *http.Request, namespace ResourceNamespace, name ResourceName, id ResourceId)
 	// List the roles for a tenant
 	// (GET /roles/)
 	ListRoles(w http.ResponseWriter, r *http.Request, params ListRolesParams)
@@ -1630,6 +2267,39 @@ type ServerInterface interface {
 	// Obtain server status
 	// (GET /status/)
 	GetStatus(w http.ResponseWriter, r *http.Request)
+	// List the webhooks registered by the tenant
+	// (GET /webhooks/)
+	ListWebhooks(w http.ResponseWriter, r *http.Request, params ListWebhooksParams)
+	// Register a webhook for the tenant
+	// (POST /webhooks/)
+	CreateWebhook(w http.ResponseWriter, r *http.Request)
+	// Delete a webhook registered by the tenant
+	// (DELETE /webhooks/{uuid}/)
+	DeleteWebhook(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID)
+	// Get a webhook registered by the tenant
+	// (GET /webhooks/{uuid}/)
+	GetWebhook(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID)
+	// List the workspaces in the tenant
+	// (GET /workspaces/)
+	ListWorkspaces(w http.ResponseWriter, r *http.Request, params ListWorkspacesParams)
+	// Create a workspace in the tenant
+	// (POST /workspaces/)
+	CreateWorkspace(w http.ResponseWriter, r *http.Request)
+	// Delete a workspace in the tenant
+	// (DELETE /workspaces/{id}/)
+	DeleteWorkspace(w http.ResponseWriter, r *http.Request, id WorkspaceId)
+	// Get a workspace in the tenant
+	// (GET /workspaces/{id}/)
+	GetWorkspace(w http.ResponseWriter, r *http.Request, id WorkspaceId)
+	// Rename or move a workspace in the tenant
+	// (PUT /workspaces/{id}/)
+	UpdateWorkspace(w http.ResponseWriter, r *http.Request, id WorkspaceId)
+	// List the ancestors of a workspace, nearest first
+	// (GET /workspaces/{id}/ancestors/)
+	ListWorkspaceAncestors(w http.ResponseWriter, r *http.Request, id WorkspaceId)
+	// List the workspaces directly beneath a workspace
+	// (GET /workspaces/{id}/children/)
+	ListWorkspaceChildren(w http.ResponseWriter, r *http.Request, id WorkspaceId, params ListWorkspaceChildrenParams)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -1642,6 +2312,42 @@ func (_ Unimplemented) GetPrincipalAccess(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Check whether a principal has a permission on a resource (defaults to principal from the identity header)
+// (POST /access/check/)
+func (_ Unimplemented) CheckAccess(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Check a principal's permissions on many resources at once (defaults to principal from the identity header)
+// (POST /access/check/bulk/)
+func (_ Unimplemented) CheckAccessBulk(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Explain why a principal has or lacks a permission on a resource (defaults to principal from the identity header)
+// (GET /access/explain/)
+func (_ Unimplemented) ExplainAccess(w http.ResponseWriter, r *http.Request, params ExplainAccessParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the resources of a type a principal has a permission on (defaults to principal from the identity header)
+// (GET /access/resources/)
+func (_ Unimplemented) LookupAccessibleResources(w http.ResponseWriter, r *http.Request, params LookupAccessibleResourcesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List who has a permission on a resource in the tenant
+// (GET /access/subjects/)
+func (_ Unimplemented) LookupAccessSubjects(w http.ResponseWriter, r *http.Request, params LookupAccessSubjectsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the tenant's audit log, newest first
+// (GET /audit-log/)
+func (_ Unimplemented) ListAuditLog(w http.ResponseWriter, r *http.Request, params ListAuditLogParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List the cross account requests for a user or account
 // (GET /cross-account-requests/)
 func (_ Unimplemented) ListCrossAccountRequests(w http.ResponseWriter, r *http.Request, params ListCrossAccountRequestsParams) {
@@ -1672,6 +2378,12 @@ func (_ Unimplemented) PutCrossAccountRequest(w http.ResponseWriter, r *http.Req
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Export the tenant's roles, groups, principals, policies and workspaces
+// (GET /export/)
+func (_ Unimplemented) ExportRbac(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List the groups for a tenant
 // (GET /groups/)
 func (_ Unimplemented) ListGroups(w http.ResponseWriter, r *http.Request, params ListGroupsParams) {
@@ -1738,19 +2450,37 @@ func (_ Unimplemented) AddRoleToGroup(w http.ResponseWriter, r *http.Request, uu
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List the permissions for a tenant
-// (GET /permissions/)
-func (_ Unimplemented) ListPermissions(w http.ResponseWriter, r *http.Request, params ListPermissionsParams) {
+// Import roles, groups, principals and policies from an RBAC v1 export
+// (POST /import/)
+func (_ Unimplemented) ImportRbacExport(w http.ResponseWriter, r *http.Request, params ImportRbacExportParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List the available options for fields of permissions for a tenant
-// (GET /permissions/options/)
-func (_ Unimplemented) ListPermissionOptions(w http.ResponseWriter, r *http.Request, params ListPermissionOptionsParams) {
+// Deprovision a tenant
+// (DELETE /organizations/{org_id}/)
+func (_ Unimplemented) DeprovisionOrganization(w http.ResponseWriter, r *http.Request, orgId OrgId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List the policies in the tenant
+// Provision a tenant
+// (PUT /organizations/{org_id}/)
+func (_ Unimplemented) ProvisionOrganization(w http.ResponseWriter, r *http.Request, orgId OrgId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the permissions for a tenant
+// (GET /permissions/)
+func (_ Unimplemented) ListPermissions(w http.ResponseWriter, r *http.Request, params ListPermissionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the available options for fields of permissions for a tenant
+// (GET /permissions/options/)
+func (_ Unimplemented) ListPermissionOptions(w http.ResponseWriter, r *http.Request, params ListPermissionOptionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the policies in the tenant
 // (GET /policies/)
 func (_ Unimplemented) ListPolicies(w http.ResponseWriter, r *http.Request, params ListPoliciesParams) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -1786,6 +2516,36 @@ func (_ Unimplemented) ListPrincipals(w http.ResponseWriter, r *http.Request, pa
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Register a resource in a workspace
+// (POST /resources/)
+func (_ Unimplemented) CreateResource(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Register or move many resources at once
+// (PUT /resources/)
+func (_ Unimplemented) UpsertResources(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unregister a resource
+// (DELETE /resources/{namespace}/{name}/{id}/)
+func (_ Unimplemented) DeleteResource(w http.ResponseWriter, r *http.Request, namespace ResourceNamespace, name ResourceName, id ResourceId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the workspace a resource is registered in
+// (GET /resources/{namespace}/{name}/{id}/)
+func (_ Unimplemented) GetResource(w http.ResponseWriter, r *http.Request, namespace ResourceNamespace, name ResourceName, id ResourceId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Move a resource to another workspace
+// (PUT /resources/{namespace}/{name}/{id}/)
+func (_ Unimplemented) MoveResource(w http.ResponseWriter, r *http.Request, namespace ResourceNamespace, name ResourceName, id ResourceId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List the roles for a tenant
 // (GET /roles/)
 func (_ Unimplemented) ListRoles(w http.ResponseWriter, r *http.Request, params ListRolesParams) {
@@ -1834,6 +2594,72 @@ func (_ Unimplemented) GetStatus(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List the webhooks registered by the tenant
+// (GET /webhooks/)
+func (_ Unimplemented) ListWebhooks(w http.ResponseWriter, r *http.Request, params ListWebhooksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Register a webhook for the tenant
+// (POST /webhooks/)
+func (_ Unimplemented) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a webhook registered by the tenant
+// (DELETE /webhooks/{uuid}/)
+func (_ Unimplemented) DeleteWebhook(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a webhook registered by the tenant
+// (GET /webhooks/{uuid}/)
+func (_ Unimplemented) GetWebhook(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the workspaces in the tenant
+// (GET /workspaces/)
+func (_ Unimplemented) ListWorkspaces(w http.ResponseWriter, r *http.Request, params ListWorkspacesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a workspace in the tenant
+// (POST /workspaces/)
+func (_ Unimplemented) CreateWorkspace(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a workspace in the tenant
+// (DELETE /workspaces/{id}/)
+func (_ Unimplemented) DeleteWorkspace(w http.ResponseWriter, r *http.Request, id WorkspaceId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a workspace in the tenant
+// (GET /workspaces/{id}/)
+func (_ Unimplemented) GetWorkspace(w http.ResponseWriter, r *http.Request, id WorkspaceId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Rename or move a workspace in the tenant
+// (PUT /workspaces/{id}/)
+func (_ Unimplemented) UpdateWorkspace(w http.ResponseWriter, r *http.Request, id WorkspaceId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the ancestors of a workspace, nearest first
+// (GET /workspaces/{id}/ancestors/)
+func (_ Unimplemented) ListWorkspaceAncestors(w http.ResponseWriter, r *http.Request, id WorkspaceId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the workspaces directly beneath a workspace
+// (GET /workspaces/{id}/children/)
+func (_ Unimplemented) ListWorkspaceChildren(w http.ResponseWriter, r *http.Request, id WorkspaceId, params ListWorkspaceChildrenParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -1920,8 +2746,42 @@ func (siw *ServerInterfaceWrapper) GetPrincipalAccess(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListCrossAccountRequests operation middleware
-func (siw *ServerInterfaceWrapper) ListCrossAccountRequests(w http.ResponseWriter, r *http.Request) {
+// CheckAccess operation middleware
+func (siw *ServerInterfaceWrapper) CheckAccess(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CheckAccess(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CheckAccessBulk operation middleware
+func (siw *ServerInterfaceWrapper) CheckAccessBulk(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CheckAccessBulk(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ExplainAccess operation middleware
+func (siw *ServerInterfaceWrapper) ExplainAccess(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1929,74 +2789,63 @@ func (siw *ServerInterfaceWrapper) ListCrossAccountRequests(w http.ResponseWrite
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListCrossAccountRequestsParams
-
-	// ------------- Optional query parameter "limit" -------------
+	var params ExplainAccessParams
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+	// ------------- Required query parameter "resource_type" -------------
 
-	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("resource_type"); paramValue != "" {
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "resource_type"})
 		return
 	}
 
-	// ------------- Optional query parameter "query_by" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "query_by", r.URL.Query(), &params.QueryBy)
+	err = runtime.BindQueryParameter("form", true, true, "resource_type", r.URL.Query(), &params.ResourceType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query_by", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource_type", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "account" -------------
+	// ------------- Required query parameter "resource_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "account", r.URL.Query(), &params.Account)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "account", Err: err})
+	if paramValue := r.URL.Query().Get("resource_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "resource_id"})
 		return
 	}
 
-	// ------------- Optional query parameter "org_id" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "org_id", r.URL.Query(), &params.OrgId)
+	err = runtime.BindQueryParameter("form", true, true, "resource_id", r.URL.Query(), &params.ResourceId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "org_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "approved_only" -------------
+	// ------------- Required query parameter "permission" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "approved_only", r.URL.Query(), &params.ApprovedOnly)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "approved_only", Err: err})
+	if paramValue := r.URL.Query().Get("permission"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "permission"})
 		return
 	}
 
-	// ------------- Optional query parameter "status" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	err = runtime.BindQueryParameter("form", true, true, "permission", r.URL.Query(), &params.Permission)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "permission", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "order_by" -------------
+	// ------------- Optional query parameter "username" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListCrossAccountRequests(w, r, params)
+		siw.Handler.ExplainAccess(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2006,69 +2855,73 @@ func (siw *ServerInterfaceWrapper) ListCrossAccountRequests(w http.ResponseWrite
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateCrossAccountRequests operation middleware
-func (siw *ServerInterfaceWrapper) CreateCrossAccountRequests(w http.ResponseWriter, r *http.Request) {
+// LookupAccessibleResources operation middleware
+func (siw *ServerInterfaceWrapper) LookupAccessibleResources(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCrossAccountRequests(w, r)
-	}))
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupAccessibleResourcesParams
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// ------------- Required query parameter "resource_type" -------------
+
+	if paramValue := r.URL.Query().Get("resource_type"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "resource_type"})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	err = runtime.BindQueryParameter("form", true, true, "resource_type", r.URL.Query(), &params.ResourceType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource_type", Err: err})
+		return
+	}
 
-// GetCrossAccountRequest operation middleware
-func (siw *ServerInterfaceWrapper) GetCrossAccountRequest(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// ------------- Required query parameter "permission" -------------
 
-	var err error
+	if paramValue := r.URL.Query().Get("permission"); paramValue != "" {
 
-	// ------------- Path parameter "uuid" -------------
-	var uuid openapi_types.UUID
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "permission"})
+		return
+	}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "uuid", runtime.ParamLocationPath, chi.URLParam(r, "uuid"), &uuid)
+	err = runtime.BindQueryParameter("form", true, true, "permission", r.URL.Query(), &params.Permission)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "permission", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCrossAccountRequestParams
-
-	// ------------- Optional query parameter "query_by" -------------
+	// ------------- Optional query parameter "username" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "query_by", r.URL.Query(), &params.QueryBy)
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query_by", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "account" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "account", r.URL.Query(), &params.Account)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "account", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "approved_only" -------------
+	// ------------- Optional query parameter "cursor" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "approved_only", r.URL.Query(), &params.ApprovedOnly)
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "approved_only", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCrossAccountRequest(w, r, uuid, params)
+		siw.Handler.LookupAccessibleResources(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2078,53 +2931,72 @@ func (siw *ServerInterfaceWrapper) GetCrossAccountRequest(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PatchCrossAccountRequest operation middleware
-func (siw *ServerInterfaceWrapper) PatchCrossAccountRequest(w http.ResponseWriter, r *http.Request) {
+// LookupAccessSubjects operation middleware
+func (siw *ServerInterfaceWrapper) LookupAccessSubjects(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "uuid" -------------
-	var uuid openapi_types.UUID
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "uuid", runtime.ParamLocationPath, chi.URLParam(r, "uuid"), &uuid)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupAccessSubjectsParams
+
+	// ------------- Required query parameter "resource_type" -------------
+
+	if paramValue := r.URL.Query().Get("resource_type"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "resource_type"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "resource_type", r.URL.Query(), &params.ResourceType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource_type", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+	// ------------- Required query parameter "resource_id" -------------
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchCrossAccountRequest(w, r, uuid)
-	}))
+	if paramValue := r.URL.Query().Get("resource_id"); paramValue != "" {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "resource_id"})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	err = runtime.BindQueryParameter("form", true, true, "resource_id", r.URL.Query(), &params.ResourceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource_id", Err: err})
+		return
+	}
 
-// PutCrossAccountRequest operation middleware
-func (siw *ServerInterfaceWrapper) PutCrossAccountRequest(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// ------------- Required query parameter "permission" -------------
 
-	var err error
+	if paramValue := r.URL.Query().Get("permission"); paramValue != "" {
 
-	// ------------- Path parameter "uuid" -------------
-	var uuid openapi_types.UUID
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "permission"})
+		return
+	}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "uuid", runtime.ParamLocationPath, chi.URLParam(r, "uuid"), &uuid)
+	err = runtime.BindQueryParameter("form", true, true, "permission", r.URL.Query(), &params.Permission)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "permission", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+	// ------------- Optional query parameter "paths" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "paths", r.URL.Query(), &params.Paths)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "paths", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutCrossAccountRequest(w, r, uuid)
+		siw.Handler.LookupAccessSubjects(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2134,8 +3006,8 @@ func (siw *ServerInterfaceWrapper) PutCrossAccountRequest(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListGroups operation middleware
-func (siw *ServerInterfaceWrapper) ListGroups(w http.ResponseWriter, r *http.Request) {
+// ListAuditLog operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditLog(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2143,7 +3015,7 @@ func (siw *ServerInterfaceWrapper) ListGroups(w http.ResponseWriter, r *http.Req
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListGroupsParams
+	var params ListAuditLogParams
 
 	// ------------- Optional query parameter "limit" -------------
 
@@ -2161,104 +3033,134 @@ func (siw *ServerInterfaceWrapper) ListGroups(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// ------------- Optional query parameter "name" -------------
+	// ------------- Optional query parameter "start" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	err = runtime.BindQueryParameter("form", true, false, "start", r.URL.Query(), &params.Start)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "name_match" -------------
+	// ------------- Optional query parameter "end" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "name_match", r.URL.Query(), &params.NameMatch)
+	err = runtime.BindQueryParameter("form", true, false, "end", r.URL.Query(), &params.End)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name_match", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "scope" -------------
+	// ------------- Optional query parameter "actor" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "username" -------------
+	// ------------- Optional query parameter "target" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	err = runtime.BindQueryParameter("form", true, false, "target", r.URL.Query(), &params.Target)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "target", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "exclude_username" -------------
+	// ------------- Optional query parameter "operation" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "exclude_username", r.URL.Query(), &params.ExcludeUsername)
+	err = runtime.BindQueryParameter("form", true, false, "operation", r.URL.Query(), &params.Operation)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_username", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operation", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "uuid" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAuditLog(w, r, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", false, false, "uuid", r.URL.Query(), &params.Uuid)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListCrossAccountRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListCrossAccountRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCrossAccountRequestsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "role_names" -------------
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", false, false, "role_names", r.URL.Query(), &params.RoleNames)
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role_names", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "role_discriminator" -------------
+	// ------------- Optional query parameter "query_by" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "role_discriminator", r.URL.Query(), &params.RoleDiscriminator)
+	err = runtime.BindQueryParameter("form", true, false, "query_by", r.URL.Query(), &params.QueryBy)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role_discriminator", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query_by", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "order_by" -------------
+	// ------------- Optional query parameter "account" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	err = runtime.BindQueryParameter("form", true, false, "account", r.URL.Query(), &params.Account)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "account", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "platform_default" -------------
+	// ------------- Optional query parameter "org_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "platform_default", r.URL.Query(), &params.PlatformDefault)
+	err = runtime.BindQueryParameter("form", true, false, "org_id", r.URL.Query(), &params.OrgId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "platform_default", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "org_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "admin_default" -------------
+	// ------------- Optional query parameter "approved_only" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "admin_default", r.URL.Query(), &params.AdminDefault)
+	err = runtime.BindQueryParameter("form", true, false, "approved_only", r.URL.Query(), &params.ApprovedOnly)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "admin_default", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "approved_only", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "system" -------------
+	// ------------- Optional query parameter "status" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "system", r.URL.Query(), &params.System)
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "system", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListGroups(w, r, params)
+		siw.Handler.ListCrossAccountRequests(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2268,14 +3170,14 @@ func (siw *ServerInterfaceWrapper) ListGroups(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateGroup operation middleware
-func (siw *ServerInterfaceWrapper) CreateGroup(w http.ResponseWriter, r *http.Request) {
+// CreateCrossAccountRequests operation middleware
+func (siw *ServerInterfaceWrapper) CreateCrossAccountRequests(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateGroup(w, r)
+		siw.Handler.CreateCrossAccountRequests(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2285,8 +3187,8 @@ func (siw *ServerInterfaceWrapper) CreateGroup(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteGroup operation middleware
-func (siw *ServerInterfaceWrapper) DeleteGroup(w http.ResponseWriter, r *http.Request) {
+// GetCrossAccountRequest operation middleware
+func (siw *ServerInterfaceWrapper) GetCrossAccountRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2302,8 +3204,35 @@ func (siw *ServerInterfaceWrapper) DeleteGroup(w http.ResponseWriter, r *http.Re
 
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCrossAccountRequestParams
+
+	// ------------- Optional query parameter "query_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query_by", r.URL.Query(), &params.QueryBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "account" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account", r.URL.Query(), &params.Account)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "account", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "approved_only" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "approved_only", r.URL.Query(), &params.ApprovedOnly)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "approved_only", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteGroup(w, r, uuid)
+		siw.Handler.GetCrossAccountRequest(w, r, uuid, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2313,8 +3242,8 @@ func (siw *ServerInterfaceWrapper) DeleteGroup(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetGroup operation middleware
-func (siw *ServerInterfaceWrapper) GetGroup(w http.ResponseWriter, r *http.Request) {
+// PatchCrossAccountRequest operation middleware
+func (siw *ServerInterfaceWrapper) PatchCrossAccountRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2331,7 +3260,7 @@ func (siw *ServerInterfaceWrapper) GetGroup(w http.ResponseWriter, r *http.Reque
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetGroup(w, r, uuid)
+		siw.Handler.PatchCrossAccountRequest(w, r, uuid)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2341,8 +3270,8 @@ func (siw *ServerInterfaceWrapper) GetGroup(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateGroup operation middleware
-func (siw *ServerInterfaceWrapper) UpdateGroup(w http.ResponseWriter, r *http.Request) {
+// PutCrossAccountRequest operation middleware
+func (siw *ServerInterfaceWrapper) PutCrossAccountRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2359,7 +3288,7 @@ func (siw *ServerInterfaceWrapper) UpdateGroup(w http.ResponseWriter, r *http.Re
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateGroup(w, r, uuid)
+		siw.Handler.PutCrossAccountRequest(w, r, uuid)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2369,101 +3298,111 @@ func (siw *ServerInterfaceWrapper) UpdateGroup(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeletePrincipalFromGroup operation middleware
-func (siw *ServerInterfaceWrapper) DeletePrincipalFromGroup(w http.ResponseWriter, r *http.Request) {
+// ExportRbac operation middleware
+func (siw *ServerInterfaceWrapper) ExportRbac(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-	// ------------- Path parameter "uuid" -------------
-	var uuid openapi_types.UUID
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportRbac(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "uuid", runtime.ParamLocationPath, chi.URLParam(r, "uuid"), &uuid)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListGroups operation middleware
+func (siw *ServerInterfaceWrapper) ListGroups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params DeletePrincipalFromGroupParams
-
-	// ------------- Required query parameter "usernames" -------------
+	var params ListGroupsParams
 
-	if paramValue := r.URL.Query().Get("usernames"); paramValue != "" {
+	// ------------- Optional query parameter "limit" -------------
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "usernames"})
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "usernames", r.URL.Query(), &params.Usernames)
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "usernames", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeletePrincipalFromGroup(w, r, uuid, params)
-	}))
+	// ------------- Optional query parameter "name" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// GetPrincipalsFromGroup operation middleware
-func (siw *ServerInterfaceWrapper) GetPrincipalsFromGroup(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// ------------- Optional query parameter "name_match" -------------
 
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "name_match", r.URL.Query(), &params.NameMatch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name_match", Err: err})
+		return
+	}
 
-	// ------------- Path parameter "uuid" -------------
-	var uuid openapi_types.UUID
+	// ------------- Optional query parameter "scope" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "uuid", runtime.ParamLocationPath, chi.URLParam(r, "uuid"), &uuid)
+	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+	// ------------- Optional query parameter "username" -------------
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPrincipalsFromGroupParams
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
 
-	// ------------- Optional query parameter "admin_only" -------------
+	// ------------- Optional query parameter "exclude_username" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "admin_only", r.URL.Query(), &params.AdminOnly)
+	err = runtime.BindQueryParameter("form", true, false, "exclude_username", r.URL.Query(), &params.ExcludeUsername)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "admin_only", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_username", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "principal_username" -------------
+	// ------------- Optional query parameter "uuid" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "principal_username", r.URL.Query(), &params.PrincipalUsername)
+	err = runtime.BindQueryParameter("form", false, false, "uuid", r.URL.Query(), &params.Uuid)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "principal_username", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Optional query parameter "role_names" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindQueryParameter("form", false, false, "role_names", r.URL.Query(), &params.RoleNames)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role_names", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Optional query parameter "role_discriminator" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "role_discriminator", r.URL.Query(), &params.RoleDiscriminator)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role_discriminator", Err: err})
 		return
 	}
 
@@ -2475,16 +3414,32 @@ func (siw *ServerInterfaceWrapper) GetPrincipalsFromGroup(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Optional query parameter "username_only" -------------
+	// ------------- Optional query parameter "platform_default" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "username_only", r.URL.Query(), &params.UsernameOnly)
+	err = runtime.BindQueryParameter("form", true, false, "platform_default", r.URL.Query(), &params.PlatformDefault)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username_only", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "platform_default", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "admin_default" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "admin_default", r.URL.Query(), &params.AdminDefault)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "admin_default", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "system" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "system", r.URL.Query(), &params.System)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "system", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPrincipalsFromGroup(w, r, uuid, params)
+		siw.Handler.ListGroups(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2494,9 +3449,26 @@ func (siw *ServerInterfaceWrapper) GetPrincipalsFromGroup(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AddPrincipalToGroup operation middleware
-func (siw *ServerInterfaceWrapper) AddPrincipalToGroup(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// CreateGroup operation middleware
+func (siw *ServerInterfaceWrapper) CreateGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateGroup(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteGroup operation middleware
+func (siw *ServerInterfaceWrapper) DeleteGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
 	var err error
 
@@ -2512,7 +3484,7 @@ func (siw *ServerInterfaceWrapper) AddPrincipalToGroup(w http.ResponseWriter, r
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddPrincipalToGroup(w, r, uuid)
+		siw.Handler.DeleteGroup(w, r, uuid)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2522,8 +3494,8 @@ func (siw *ServerInterfaceWrapper) AddPrincipalToGroup(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteRoleFromGroup operation middleware
-func (siw *ServerInterfaceWrapper) DeleteRoleFromGroup(w http.ResponseWriter, r *http.Request) {
+// GetGroup operation middleware
+func (siw *ServerInterfaceWrapper) GetGroup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2539,26 +3511,36 @@ func (siw *ServerInterfaceWrapper) DeleteRoleFromGroup(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteRoleFromGroupParams
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGroup(w, r, uuid)
+	}))
 
-	// ------------- Required query parameter "roles" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	if paramValue := r.URL.Query().Get("roles"); paramValue != "" {
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "roles"})
-		return
-	}
+// UpdateGroup operation middleware
+func (siw *ServerInterfaceWrapper) UpdateGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, true, "roles", r.URL.Query(), &params.Roles)
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "uuid", runtime.ParamLocationPath, chi.URLParam(r, "uuid"), &uuid)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roles", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteRoleFromGroup(w, r, uuid, params)
+		siw.Handler.UpdateGroup(w, r, uuid)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2568,8 +3550,8 @@ func (siw *ServerInterfaceWrapper) DeleteRoleFromGroup(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListRolesForGroup operation middleware
-func (siw *ServerInterfaceWrapper) ListRolesForGroup(w http.ResponseWriter, r *http.Request) {
+// DeletePrincipalFromGroup operation middleware
+func (siw *ServerInterfaceWrapper) DeletePrincipalFromGroup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2586,53 +3568,67 @@ func (siw *ServerInterfaceWrapper) ListRolesForGroup(w http.ResponseWriter, r *h
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListRolesForGroupParams
+	var params DeletePrincipalFromGroupParams
 
-	// ------------- Optional query parameter "exclude" -------------
+	// ------------- Required query parameter "usernames" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "exclude", r.URL.Query(), &params.Exclude)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude", Err: err})
+	if paramValue := r.URL.Query().Get("usernames"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "usernames"})
 		return
 	}
 
-	// ------------- Optional query parameter "role_name" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "role_name", r.URL.Query(), &params.RoleName)
+	err = runtime.BindQueryParameter("form", true, true, "usernames", r.URL.Query(), &params.Usernames)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role_name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "usernames", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "role_display_name" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePrincipalFromGroup(w, r, uuid, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "role_display_name", r.URL.Query(), &params.RoleDisplayName)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role_display_name", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "role_description" -------------
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "role_description", r.URL.Query(), &params.RoleDescription)
+// GetPrincipalsFromGroup operation middleware
+func (siw *ServerInterfaceWrapper) GetPrincipalsFromGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "uuid", runtime.ParamLocationPath, chi.URLParam(r, "uuid"), &uuid)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role_description", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "role_system" -------------
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "role_system", r.URL.Query(), &params.RoleSystem)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPrincipalsFromGroupParams
+
+	// ------------- Optional query parameter "admin_only" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "admin_only", r.URL.Query(), &params.AdminOnly)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role_system", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "admin_only", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "role_external_tenant" -------------
+	// ------------- Optional query parameter "principal_username" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "role_external_tenant", r.URL.Query(), &params.RoleExternalTenant)
+	err = runtime.BindQueryParameter("form", true, false, "principal_username", r.URL.Query(), &params.PrincipalUsername)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role_external_tenant", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "principal_username", Err: err})
 		return
 	}
 
@@ -2660,8 +3656,16 @@ func (siw *ServerInterfaceWrapper) ListRolesForGroup(w http.ResponseWriter, r *h
 		return
 	}
 
+	// ------------- Optional query parameter "username_only" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username_only", r.URL.Query(), &params.UsernameOnly)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username_only", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListRolesForGroup(w, r, uuid, params)
+		siw.Handler.GetPrincipalsFromGroup(w, r, uuid, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2671,8 +3675,8 @@ func (siw *ServerInterfaceWrapper) ListRolesForGroup(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AddRoleToGroup operation middleware
-func (siw *ServerInterfaceWrapper) AddRoleToGroup(w http.ResponseWriter, r *http.Request) {
+// AddPrincipalToGroup operation middleware
+func (siw *ServerInterfaceWrapper) AddPrincipalToGroup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2689,7 +3693,7 @@ func (siw *ServerInterfaceWrapper) AddRoleToGroup(w http.ResponseWriter, r *http
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddRoleToGroup(w, r, uuid)
+		siw.Handler.AddPrincipalToGroup(w, r, uuid)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2699,99 +3703,146 @@ func (siw *ServerInterfaceWrapper) AddRoleToGroup(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListPermissions operation middleware
-func (siw *ServerInterfaceWrapper) ListPermissions(w http.ResponseWriter, r *http.Request) {
+// DeleteRoleFromGroup operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRoleFromGroup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "uuid", runtime.ParamLocationPath, chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListPermissionsParams
+	var params DeleteRoleFromGroupParams
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Required query parameter "roles" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if paramValue := r.URL.Query().Get("roles"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "roles"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "roles", r.URL.Query(), &params.Roles)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roles", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRoleFromGroup(w, r, uuid, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListRolesForGroup operation middleware
+func (siw *ServerInterfaceWrapper) ListRolesForGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "uuid", runtime.ParamLocationPath, chi.URLParam(r, "uuid"), &uuid)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "order_by" -------------
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRolesForGroupParams
+
+	// ------------- Optional query parameter "exclude" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude", r.URL.Query(), &params.Exclude)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "application" -------------
+	// ------------- Optional query parameter "role_name" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "application", r.URL.Query(), &params.Application)
+	err = runtime.BindQueryParameter("form", true, false, "role_name", r.URL.Query(), &params.RoleName)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "application", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role_name", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "resource_type" -------------
+	// ------------- Optional query parameter "role_display_name" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "resource_type", r.URL.Query(), &params.ResourceType)
+	err = runtime.BindQueryParameter("form", true, false, "role_display_name", r.URL.Query(), &params.RoleDisplayName)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role_display_name", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "verb" -------------
+	// ------------- Optional query parameter "role_description" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "verb", r.URL.Query(), &params.Verb)
+	err = runtime.BindQueryParameter("form", true, false, "role_description", r.URL.Query(), &params.RoleDescription)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "verb", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role_description", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "permission" -------------
+	// ------------- Optional query parameter "role_system" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "permission", r.URL.Query(), &params.Permission)
+	err = runtime.BindQueryParameter("form", true, false, "role_system", r.URL.Query(), &params.RoleSystem)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "permission", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role_system", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "exclude_globals" -------------
+	// ------------- Optional query parameter "role_external_tenant" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "exclude_globals", r.URL.Query(), &params.ExcludeGlobals)
+	err = runtime.BindQueryParameter("form", true, false, "role_external_tenant", r.URL.Query(), &params.RoleExternalTenant)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_globals", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role_external_tenant", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "exclude_roles" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "exclude_roles", r.URL.Query(), &params.ExcludeRoles)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_roles", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "allowed_only" -------------
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "allowed_only", r.URL.Query(), &params.AllowedOnly)
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "allowed_only", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListPermissions(w, r, params)
+		siw.Handler.ListRolesForGroup(w, r, uuid, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2801,9 +3852,233 @@ func (siw *ServerInterfaceWrapper) ListPermissions(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListPermissionOptions operation middleware
-func (siw *ServerInterfaceWrapper) ListPermissionOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// AddRoleToGroup operation middleware
+func (siw *ServerInterfaceWrapper) AddRoleToGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "uuid", runtime.ParamLocationPath, chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddRoleToGroup(w, r, uuid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ImportRbacExport operation middleware
+func (siw *ServerInterfaceWrapper) ImportRbacExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportRbacExportParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "batch_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "batch_size", r.URL.Query(), &params.BatchSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "batch_size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportRbacExport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeprovisionOrganization operation middleware
+func (siw *ServerInterfaceWrapper) DeprovisionOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "org_id" -------------
+	var orgId OrgId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "org_id", runtime.ParamLocationPath, chi.URLParam(r, "org_id"), &orgId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "org_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeprovisionOrganization(w, r, orgId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ProvisionOrganization operation middleware
+func (siw *ServerInterfaceWrapper) ProvisionOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "org_id" -------------
+	var orgId OrgId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "org_id", runtime.ParamLocationPath, chi.URLParam(r, "org_id"), &orgId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "org_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ProvisionOrganization(w, r, orgId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListPermissions operation middleware
+func (siw *ServerInterfaceWrapper) ListPermissions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPermissionsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "application" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "application", r.URL.Query(), &params.Application)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "application", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "resource_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resource_type", r.URL.Query(), &params.ResourceType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "verb" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "verb", r.URL.Query(), &params.Verb)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "verb", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "permission" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "permission", r.URL.Query(), &params.Permission)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "permission", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "exclude_globals" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude_globals", r.URL.Query(), &params.ExcludeGlobals)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_globals", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "exclude_roles" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude_roles", r.URL.Query(), &params.ExcludeRoles)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_roles", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "allowed_only" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "allowed_only", r.URL.Query(), &params.AllowedOnly)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "allowed_only", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPermissions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListPermissionOptions operation middleware
+func (siw *ServerInterfaceWrapper) ListPermissionOptions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
 	var err error
 
@@ -3175,58 +4450,230 @@ func (siw *ServerInterfaceWrapper) ListPrincipals(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListRoles operation middleware
-func (siw *ServerInterfaceWrapper) ListRoles(w http.ResponseWriter, r *http.Request) {
+// CreateResource operation middleware
+func (siw *ServerInterfaceWrapper) CreateResource(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
 	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListRolesParams
-
-	// ------------- Optional query parameter "limit" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateResource(w, r)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
+// UpsertResources operation middleware
+func (siw *ServerInterfaceWrapper) UpsertResources(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpsertResources(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "name" -------------
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+// DeleteResource operation middleware
+func (siw *ServerInterfaceWrapper) DeleteResource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace ResourceNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, chi.URLParam(r, "namespace"), &namespace)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "system" -------------
+	// ------------- Path parameter "name" -------------
+	var name ResourceName
 
-	err = runtime.BindQueryParameter("form", true, false, "system", r.URL.Query(), &params.System)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, chi.URLParam(r, "name"), &name)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "system", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "display_name" -------------
+	// ------------- Path parameter "id" -------------
+	var id ResourceId
 
-	err = runtime.BindQueryParameter("form", true, false, "display_name", r.URL.Query(), &params.DisplayName)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "display_name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "name_match" -------------
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteResource(w, r, namespace, name, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetResource operation middleware
+func (siw *ServerInterfaceWrapper) GetResource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace ResourceNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, chi.URLParam(r, "namespace"), &namespace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name ResourceName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, chi.URLParam(r, "name"), &name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id ResourceId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetResource(w, r, namespace, name, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// MoveResource operation middleware
+func (siw *ServerInterfaceWrapper) MoveResource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace ResourceNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, chi.URLParam(r, "namespace"), &namespace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name ResourceName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, chi.URLParam(r, "name"), &name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id ResourceId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MoveResource(w, r, namespace, name, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListRoles operation middleware
+func (siw *ServerInterfaceWrapper) ListRoles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRolesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "system" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "system", r.URL.Query(), &params.System)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "system", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "display_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "display_name", r.URL.Query(), &params.DisplayName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "display_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "name_match" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "name_match", r.URL.Query(), &params.NameMatch)
 	if err != nil {
@@ -3505,1858 +4952,3628 @@ func (siw *ServerInterfaceWrapper) GetStatus(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// ListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhooksParams
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Optional query parameter "limit" -------------
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-type RequiredParamError struct {
-	ParamName string
-}
+	// ------------- Optional query parameter "offset" -------------
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhooks(w, r, params)
+	}))
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWebhook(w, r)
+	}))
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var err error
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "uuid", runtime.ParamLocationPath, chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhook(w, r, uuid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
-
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/access/", wrapper.GetPrincipalAccess)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/cross-account-requests/", wrapper.ListCrossAccountRequests)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/cross-account-requests/", wrapper.CreateCrossAccountRequests)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/cross-account-requests/{uuid}/", wrapper.GetCrossAccountRequest)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/cross-account-requests/{uuid}/", wrapper.PatchCrossAccountRequest)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/cross-account-requests/{uuid}/", wrapper.PutCrossAccountRequest)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/groups/", wrapper.ListGroups)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/groups/", wrapper.CreateGroup)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/groups/{uuid}/", wrapper.DeleteGroup)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/groups/{uuid}/", wrapper.GetGroup)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/groups/{uuid}/", wrapper.UpdateGroup)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/groups/{uuid}/principals/", wrapper.DeletePrincipalFromGroup)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/groups/{uuid}/principals/", wrapper.GetPrincipalsFromGroup)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/groups/{uuid}/principals/", wrapper.AddPrincipalToGroup)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/groups/{uuid}/roles/", wrapper.DeleteRoleFromGroup)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/groups/{uuid}/roles/", wrapper.ListRolesForGroup)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/groups/{uuid}/roles/", wrapper.AddRoleToGroup)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/permissions/", wrapper.ListPermissions)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/permissions/options/", wrapper.ListPermissionOptions)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/policies/", wrapper.ListPolicies)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/policies/", wrapper.CreatePolicies)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/policies/{uuid}/", wrapper.DeletePolicy)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/policies/{uuid}/", wrapper.GetPolicy)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/policies/{uuid}/", wrapper.UpdatePolicy)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/principals/", wrapper.ListPrincipals)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/roles/", wrapper.ListRoles)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/roles/", wrapper.CreateRole)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/roles/{uuid}/", wrapper.DeleteRole)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/roles/{uuid}/", wrapper.GetRole)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/roles/{uuid}/", wrapper.PatchRole)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/roles/{uuid}/", wrapper.UpdateRole)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/roles/{uuid}/access/", wrapper.GetRoleAccess)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/status/", wrapper.GetStatus)
-	})
 
-	return r
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetPrincipalAccessRequestObject struct {
-	Params GetPrincipalAccessParams
-}
+// GetWebhook operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GetPrincipalAccessResponseObject interface {
-	VisitGetPrincipalAccessResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetPrincipalAccess200JSONResponse AccessPagination
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
 
-func (response GetPrincipalAccess200JSONResponse) VisitGetPrincipalAccessResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "uuid", runtime.ParamLocationPath, chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-type GetPrincipalAccess401Response struct {
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhook(w, r, uuid)
+	}))
 
-func (response GetPrincipalAccess401Response) VisitGetPrincipalAccessResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetPrincipalAccess404JSONResponse Error
+// ListWorkspaces operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkspaces(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetPrincipalAccess404JSONResponse) VisitGetPrincipalAccessResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-type GetPrincipalAccess500JSONResponse Error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWorkspacesParams
 
-func (response GetPrincipalAccess500JSONResponse) VisitGetPrincipalAccessResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Optional query parameter "limit" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-type ListCrossAccountRequestsRequestObject struct {
-	Params ListCrossAccountRequestsParams
-}
+	// ------------- Optional query parameter "offset" -------------
 
-type ListCrossAccountRequestsResponseObject interface {
-	VisitListCrossAccountRequestsResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-type ListCrossAccountRequests200JSONResponse CrossAccountRequestPagination
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWorkspaces(w, r, params)
+	}))
 
-func (response ListCrossAccountRequests200JSONResponse) VisitListCrossAccountRequestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type ListCrossAccountRequests401Response struct {
-}
+// CreateWorkspace operation middleware
+func (siw *ServerInterfaceWrapper) CreateWorkspace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response ListCrossAccountRequests401Response) VisitListCrossAccountRequestsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-type ListCrossAccountRequests403JSONResponse Error403
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWorkspace(w, r)
+	}))
 
-func (response ListCrossAccountRequests403JSONResponse) VisitListCrossAccountRequestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type ListCrossAccountRequests500JSONResponse Error
+// DeleteWorkspace operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWorkspace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response ListCrossAccountRequests500JSONResponse) VisitListCrossAccountRequestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id WorkspaceId
 
-type CreateCrossAccountRequestsRequestObject struct {
-	Body *CreateCrossAccountRequestsJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type CreateCrossAccountRequestsResponseObject interface {
-	VisitCreateCrossAccountRequestsResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-type CreateCrossAccountRequests201JSONResponse CrossAccountRequestOut
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWorkspace(w, r, id)
+	}))
 
-func (response CreateCrossAccountRequests201JSONResponse) VisitCreateCrossAccountRequestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CreateCrossAccountRequests401Response struct {
-}
+// GetWorkspace operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkspace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response CreateCrossAccountRequests401Response) VisitCreateCrossAccountRequestsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	var err error
 
-type CreateCrossAccountRequests403JSONResponse Error403
+	// ------------- Path parameter "id" -------------
+	var id WorkspaceId
 
-func (response CreateCrossAccountRequests403JSONResponse) VisitCreateCrossAccountRequestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-type CreateCrossAccountRequests500JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWorkspace(w, r, id)
+	}))
 
-func (response CreateCrossAccountRequests500JSONResponse) VisitCreateCrossAccountRequestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetCrossAccountRequestRequestObject struct {
-	Uuid   openapi_types.UUID `json:"uuid"`
-	Params GetCrossAccountRequestParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetCrossAccountRequestResponseObject interface {
-	VisitGetCrossAccountRequestResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetCrossAccountRequest200JSONResponse CrossAccountRequestDetail
+// UpdateWorkspace operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWorkspace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetCrossAccountRequest200JSONResponse) VisitGetCrossAccountRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id WorkspaceId
 
-type GetCrossAccountRequest401Response struct {
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetCrossAccountRequest401Response) VisitGetCrossAccountRequestResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-type GetCrossAccountRequest403JSONResponse Error403
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateWorkspace(w, r, id)
+	}))
 
-func (response GetCrossAccountRequest403JSONResponse) VisitGetCrossAccountRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetCrossAccountRequest404JSONResponse Error
+// ListWorkspaceAncestors operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkspaceAncestors(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetCrossAccountRequest404JSONResponse) VisitGetCrossAccountRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id WorkspaceId
 
-type GetCrossAccountRequest500JSONResponse Error
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetCrossAccountRequest500JSONResponse) VisitGetCrossAccountRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWorkspaceAncestors(w, r, id)
+	}))
 
-type PatchCrossAccountRequestRequestObject struct {
-	Uuid openapi_types.UUID `json:"uuid"`
-	Body *PatchCrossAccountRequestJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PatchCrossAccountRequestResponseObject interface {
-	VisitPatchCrossAccountRequestResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type PatchCrossAccountRequest200JSONResponse CrossAccountRequestDetail
-
-func (response PatchCrossAccountRequest200JSONResponse) VisitPatchCrossAccountRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ListWorkspaceChildren operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkspaceChildren(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type PatchCrossAccountRequest201JSONResponse CrossAccountRequestOut
+	// ------------- Path parameter "id" -------------
+	var id WorkspaceId
 
-func (response PatchCrossAccountRequest201JSONResponse) VisitPatchCrossAccountRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, Basic_authScopes, []string{})
 
-type PatchCrossAccountRequest401Response struct {
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWorkspaceChildrenParams
 
-func (response PatchCrossAccountRequest401Response) VisitPatchCrossAccountRequestResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type PatchCrossAccountRequest403JSONResponse Error403
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response PatchCrossAccountRequest403JSONResponse) VisitPatchCrossAccountRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	// ------------- Optional query parameter "offset" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-type PatchCrossAccountRequest404JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWorkspaceChildren(w, r, id, params)
+	}))
 
-func (response PatchCrossAccountRequest404JSONResponse) VisitPatchCrossAccountRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type PatchCrossAccountRequest500JSONResponse Error
-
-func (response PatchCrossAccountRequest500JSONResponse) VisitPatchCrossAccountRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
 }
 
-type PutCrossAccountRequestRequestObject struct {
-	Uuid openapi_types.UUID `json:"uuid"`
-	Body *PutCrossAccountRequestJSONRequestBody
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
 }
 
-type PutCrossAccountRequestResponseObject interface {
-	VisitPutCrossAccountRequestResponse(w http.ResponseWriter) error
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
 }
 
-type PutCrossAccountRequest200JSONResponse CrossAccountRequestDetail
-
-func (response PutCrossAccountRequest200JSONResponse) VisitPutCrossAccountRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
 }
 
-type PutCrossAccountRequest201JSONResponse CrossAccountRequestOut
-
-func (response PutCrossAccountRequest201JSONResponse) VisitPutCrossAccountRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
 }
 
-type PutCrossAccountRequest401Response struct {
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
 }
 
-func (response PutCrossAccountRequest401Response) VisitPutCrossAccountRequestResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+type RequiredParamError struct {
+	ParamName string
 }
 
-type PutCrossAccountRequest403JSONResponse Error403
-
-func (response PutCrossAccountRequest403JSONResponse) VisitPutCrossAccountRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
 }
 
-type PutCrossAccountRequest404JSONResponse Error
-
-func (response PutCrossAccountRequest404JSONResponse) VisitPutCrossAccountRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
 }
 
-type PutCrossAccountRequest500JSONResponse Error
-
-func (response PutCrossAccountRequest500JSONResponse) VisitPutCrossAccountRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
 }
 
-type ListGroupsRequestObject struct {
-	Params ListGroupsParams
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
 }
 
-type ListGroupsResponseObject interface {
-	VisitListGroupsResponse(w http.ResponseWriter) error
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
 }
 
-type ListGroups200JSONResponse GroupPagination
-
-func (response ListGroups200JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
 }
 
-type ListGroups401Response struct {
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
 }
 
-func (response ListGroups401Response) VisitListGroupsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
 }
 
-type ListGroups403JSONResponse Error403
-
-func (response ListGroups403JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
 }
 
-type ListGroups500JSONResponse Error
-
-func (response ListGroups500JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
 }
 
-type CreateGroupRequestObject struct {
-	Body *CreateGroupJSONRequestBody
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type CreateGroupResponseObject interface {
-	VisitCreateGroupResponse(w http.ResponseWriter) error
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
 }
 
-type CreateGroup201JSONResponse GroupOut
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
 
-func (response CreateGroup201JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/access/", wrapper.GetPrincipalAccess)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/access/check/", wrapper.CheckAccess)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/access/check/bulk/", wrapper.CheckAccessBulk)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/access/explain/", wrapper.ExplainAccess)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/access/resources/", wrapper.LookupAccessibleResources)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/access/subjects/", wrapper.LookupAccessSubjects)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit-log/", wrapper.ListAuditLog)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/cross-account-requests/", wrapper.ListCrossAccountRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/cross-account-requests/", wrapper.CreateCrossAccountRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/cross-account-requests/{uuid}/", wrapper.GetCrossAccountRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/cross-account-requests/{uuid}/", wrapper.PatchCrossAccountRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/cross-account-requests/{uuid}/", wrapper.PutCrossAccountRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/export/", wrapper.ExportRbac)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/groups/", wrapper.ListGroups)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/groups/", wrapper.CreateGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/groups/{uuid}/", wrapper.DeleteGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/groups/{uuid}/", wrapper.GetGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/groups/{uuid}/", wrapper.UpdateGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/groups/{uuid}/principals/", wrapper.DeletePrincipalFromGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/groups/{uuid}/principals/", wrapper.GetPrincipalsFromGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/groups/{uuid}/principals/", wrapper.AddPrincipalToGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/groups/{uuid}/roles/", wrapper.DeleteRoleFromGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/groups/{uuid}/roles/", wrapper.ListRolesForGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/groups/{uuid}/roles/", wrapper.AddRoleToGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/import/", wrapper.ImportRbacExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/organizations/{org_id}/", wrapper.DeprovisionOrganization)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/organizations/{org_id}/", wrapper.ProvisionOrganization)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/permissions/", wrapper.ListPermissions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/permissions/options/", wrapper.ListPermissionOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/policies/", wrapper.ListPolicies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/policies/", wrapper.CreatePolicies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/policies/{uuid}/", wrapper.DeletePolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/policies/{uuid}/", wrapper.GetPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/policies/{uuid}/", wrapper.UpdatePolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/principals/", wrapper.ListPrincipals)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/resources/", wrapper.CreateResource)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/resources/", wrapper.UpsertResources)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/resources/{namespace}/{name}/{id}/", wrapper.DeleteResource)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/resources/{namespace}/{name}/{id}/", wrapper.GetResource)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/resources/{namespace}/{name}/{id}/", wrapper.MoveResource)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/roles/", wrapper.ListRoles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/roles/", wrapper.CreateRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/roles/{uuid}/", wrapper.DeleteRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/roles/{uuid}/", wrapper.GetRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/roles/{uuid}/", wrapper.PatchRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/roles/{uuid}/", wrapper.UpdateRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/roles/{uuid}/access/", wrapper.GetRoleAccess)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/status/", wrapper.GetStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/", wrapper.ListWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/", wrapper.CreateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/webhooks/{uuid}/", wrapper.DeleteWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/{uuid}/", wrapper.GetWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workspaces/", wrapper.ListWorkspaces)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/workspaces/", wrapper.CreateWorkspace)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/workspaces/{id}/", wrapper.DeleteWorkspace)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workspaces/{id}/", wrapper.GetWorkspace)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/workspaces/{id}/", wrapper.UpdateWorkspace)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workspaces/{id}/ancestors/", wrapper.ListWorkspaceAncestors)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workspaces/{id}/children/", wrapper.ListWorkspaceChildren)
+	})
+
+	return r
+}
+
+type GetPrincipalAccessRequestObject struct {
+	Params GetPrincipalAccessParams
+}
+
+type GetPrincipalAccessResponseObject interface {
+	VisitGetPrincipalAccessResponse(w http.ResponseWriter) error
+}
+
+type GetPrincipalAccess200JSONResponse AccessPagination
+
+func (response GetPrincipalAccess200JSONResponse) VisitGetPrincipalAccessResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateGroup401Response struct {
+type GetPrincipalAccess401Response struct {
 }
 
-func (response CreateGroup401Response) VisitCreateGroupResponse(w http.ResponseWriter) error {
+func (response GetPrincipalAccess401Response) VisitGetPrincipalAccessResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type CreateGroup403JSONResponse Error403
+type GetPrincipalAccess404JSONResponse Error
 
-func (response CreateGroup403JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+func (response GetPrincipalAccess404JSONResponse) VisitGetPrincipalAccessResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateGroup500JSONResponse Error
+type GetPrincipalAccess500JSONResponse Error
 
-func (response CreateGroup500JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+func (response GetPrincipalAccess500JSONResponse) VisitGetPrincipalAccessResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteGroupRequestObject struct {
-	Uuid openapi_types.UUID `json:"uuid"`
+type CheckAccessRequestObject struct {
+	Body *CheckAccessJSONRequestBody
 }
 
-type DeleteGroupResponseObject interface {
-	VisitDeleteGroupResponse(w http.ResponseWriter) error
+type CheckAccessResponseObject interface {
+	VisitCheckAccessResponse(w http.ResponseWriter) error
 }
 
-type DeleteGroup204Response struct {
+type CheckAccess200JSONResponse CheckResult
+
+func (response CheckAccess200JSONResponse) VisitCheckAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response DeleteGroup204Response) VisitDeleteGroupResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type CheckAccess400JSONResponse Error
+
+func (response CheckAccess400JSONResponse) VisitCheckAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteGroup401Response struct {
+type CheckAccess401Response struct {
 }
 
-func (response DeleteGroup401Response) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+func (response CheckAccess401Response) VisitCheckAccessResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type DeleteGroup403JSONResponse Error403
+type CheckAccess500JSONResponse Error
 
-func (response DeleteGroup403JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+func (response CheckAccess500JSONResponse) VisitCheckAccessResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteGroup404AsteriskResponse struct {
-	Body          io.Reader
-	ContentType   string
-	ContentLength int64
+type CheckAccessBulkRequestObject struct {
+	Body *CheckAccessBulkJSONRequestBody
 }
 
-func (response DeleteGroup404AsteriskResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", response.ContentType)
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
-	}
-	w.WriteHeader(404)
-
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
-	}
-	_, err := io.Copy(w, response.Body)
-	return err
+type CheckAccessBulkResponseObject interface {
+	VisitCheckAccessBulkResponse(w http.ResponseWriter) error
 }
 
-type DeleteGroup500AsteriskResponse struct {
-	Body          io.Reader
-	ContentType   string
-	ContentLength int64
+type CheckAccessBulk200JSONResponse CheckResultList
+
+func (response CheckAccessBulk200JSONResponse) VisitCheckAccessBulkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response DeleteGroup500AsteriskResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", response.ContentType)
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
-	}
-	w.WriteHeader(500)
+type CheckAccessBulk400JSONResponse Error
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
-	}
-	_, err := io.Copy(w, response.Body)
-	return err
+func (response CheckAccessBulk400JSONResponse) VisitCheckAccessBulkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetGroupRequestObject struct {
-	Uuid openapi_types.UUID `json:"uuid"`
+type CheckAccessBulk401Response struct {
 }
 
-type GetGroupResponseObject interface {
-	VisitGetGroupResponse(w http.ResponseWriter) error
+func (response CheckAccessBulk401Response) VisitCheckAccessBulkResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-type GetGroup200JSONResponse GroupWithPrincipalsAndRoles
+type CheckAccessBulk500JSONResponse Error
 
-func (response GetGroup200JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+func (response CheckAccessBulk500JSONResponse) VisitCheckAccessBulkResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetGroup401Response struct {
+type ExplainAccessRequestObject struct {
+	Params ExplainAccessParams
 }
 
-func (response GetGroup401Response) VisitGetGroupResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+type ExplainAccessResponseObject interface {
+	VisitExplainAccessResponse(w http.ResponseWriter) error
 }
 
-type GetGroup403JSONResponse Error403
+type ExplainAccess200JSONResponse Explanation
 
-func (response GetGroup403JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+func (response ExplainAccess200JSONResponse) VisitExplainAccessResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetGroup404JSONResponse Error
+type ExplainAccess400JSONResponse Error
 
-func (response GetGroup404JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+func (response ExplainAccess400JSONResponse) VisitExplainAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExplainAccess401Response struct {
+}
+
+func (response ExplainAccess401Response) VisitExplainAccessResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ExplainAccess404JSONResponse Error
+
+func (response ExplainAccess404JSONResponse) VisitExplainAccessResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetGroup500JSONResponse Error
+type ExplainAccess500JSONResponse Error
 
-func (response GetGroup500JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+func (response ExplainAccess500JSONResponse) VisitExplainAccessResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateGroupRequestObject struct {
-	Uuid openapi_types.UUID `json:"uuid"`
-	Body *UpdateGroupJSONRequestBody
+type LookupAccessibleResourcesRequestObject struct {
+	Params LookupAccessibleResourcesParams
 }
 
-type UpdateGroupResponseObject interface {
-	VisitUpdateGroupResponse(w http.ResponseWriter) error
+type LookupAccessibleResourcesResponseObject interface {
+	VisitLookupAccessibleResourcesResponse(w http.ResponseWriter) error
 }
 
-type UpdateGroup200JSONResponse GroupOut
+type LookupAccessibleResources200JSONResponse ResourceIdPagination
 
-func (response UpdateGroup200JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+func (response LookupAccessibleResources200JSONResponse) VisitLookupAccessibleResourcesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateGroup401Response struct {
-}
-
-func (response UpdateGroup401Response) VisitUpdateGroupResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
-
-type UpdateGroup403JSONResponse Error403
+type LookupAccessibleResources400JSONResponse Error
 
-func (response UpdateGroup403JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+func (response LookupAccessibleResources400JSONResponse) VisitLookupAccessibleResourcesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateGroup404AsteriskResponse struct {
-	Body          io.Reader
-	ContentType   string
-	ContentLength int64
+type LookupAccessibleResources401Response struct {
 }
 
-func (response UpdateGroup404AsteriskResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", response.ContentType)
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
-	}
-	w.WriteHeader(404)
-
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
-	}
-	_, err := io.Copy(w, response.Body)
-	return err
+func (response LookupAccessibleResources401Response) VisitLookupAccessibleResourcesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-type UpdateGroup500AsteriskResponse struct {
-	Body          io.Reader
-	ContentType   string
-	ContentLength int64
-}
+type LookupAccessibleResources500JSONResponse Error
 
-func (response UpdateGroup500AsteriskResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", response.ContentType)
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
-	}
+func (response LookupAccessibleResources500JSONResponse) VisitLookupAccessibleResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
-	}
-	_, err := io.Copy(w, response.Body)
-	return err
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeletePrincipalFromGroupRequestObject struct {
-	Uuid   openapi_types.UUID `json:"uuid"`
-	Params DeletePrincipalFromGroupParams
+type LookupAccessSubjectsRequestObject struct {
+	Params LookupAccessSubjectsParams
 }
 
-type DeletePrincipalFromGroupResponseObject interface {
-	VisitDeletePrincipalFromGroupResponse(w http.ResponseWriter) error
+type LookupAccessSubjectsResponseObject interface {
+	VisitLookupAccessSubjectsResponse(w http.ResponseWriter) error
 }
 
-type DeletePrincipalFromGroup204Response struct {
-}
+type LookupAccessSubjects200JSONResponse AccessSubjectList
 
-func (response DeletePrincipalFromGroup204Response) VisitDeletePrincipalFromGroupResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+func (response LookupAccessSubjects200JSONResponse) VisitLookupAccessSubjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-type DeletePrincipalFromGroup400Response struct {
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response DeletePrincipalFromGroup400Response) VisitDeletePrincipalFromGroupResponse(w http.ResponseWriter) error {
+type LookupAccessSubjects400JSONResponse Error
+
+func (response LookupAccessSubjects400JSONResponse) VisitLookupAccessSubjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeletePrincipalFromGroup401Response struct {
+type LookupAccessSubjects401Response struct {
 }
 
-func (response DeletePrincipalFromGroup401Response) VisitDeletePrincipalFromGroupResponse(w http.ResponseWriter) error {
+func (response LookupAccessSubjects401Response) VisitLookupAccessSubjectsResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type DeletePrincipalFromGroup403JSONResponse Error403
-
-func (response DeletePrincipalFromGroup403JSONResponse) VisitDeletePrincipalFromGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type DeletePrincipalFromGroup404JSONResponse ErrorNotFound
+type LookupAccessSubjects404JSONResponse Error
 
-func (response DeletePrincipalFromGroup404JSONResponse) VisitDeletePrincipalFromGroupResponse(w http.ResponseWriter) error {
+func (response LookupAccessSubjects404JSONResponse) VisitLookupAccessSubjectsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeletePrincipalFromGroup500JSONResponse Error
+type LookupAccessSubjects500JSONResponse Error
 
-func (response DeletePrincipalFromGroup500JSONResponse) VisitDeletePrincipalFromGroupResponse(w http.ResponseWriter) error {
+func (response LookupAccessSubjects500JSONResponse) VisitLookupAccessSubjectsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPrincipalsFromGroupRequestObject struct {
-	Uuid   openapi_types.UUID `json:"uuid"`
-	Params GetPrincipalsFromGroupParams
+type ListAuditLogRequestObject struct {
+	Params ListAuditLogParams
 }
 
-type GetPrincipalsFromGroupResponseObject interface {
-	VisitGetPrincipalsFromGroupResponse(w http.ResponseWriter) error
+type ListAuditLogResponseObject interface {
+	VisitListAuditLogResponse(w http.ResponseWriter) error
 }
 
-type GetPrincipalsFromGroup200JSONResponse PrincipalPagination
+type ListAuditLog200JSONResponse AuditLogPagination
 
-func (response GetPrincipalsFromGroup200JSONResponse) VisitGetPrincipalsFromGroupResponse(w http.ResponseWriter) error {
+func (response ListAuditLog200JSONResponse) VisitListAuditLogResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPrincipalsFromGroup400Response struct {
-}
+type ListAuditLog400JSONResponse Error
 
-func (response GetPrincipalsFromGroup400Response) VisitGetPrincipalsFromGroupResponse(w http.ResponseWriter) error {
+func (response ListAuditLog400JSONResponse) VisitListAuditLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPrincipalsFromGroup401Response struct {
+type ListAuditLog401Response struct {
 }
 
-func (response GetPrincipalsFromGroup401Response) VisitGetPrincipalsFromGroupResponse(w http.ResponseWriter) error {
+func (response ListAuditLog401Response) VisitListAuditLogResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetPrincipalsFromGroup404JSONResponse Error
+type ListAuditLog403JSONResponse Error403
 
-func (response GetPrincipalsFromGroup404JSONResponse) VisitGetPrincipalsFromGroupResponse(w http.ResponseWriter) error {
+func (response ListAuditLog403JSONResponse) VisitListAuditLogResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPrincipalsFromGroup500JSONResponse Error
+type ListAuditLog500JSONResponse Error
 
-func (response GetPrincipalsFromGroup500JSONResponse) VisitGetPrincipalsFromGroupResponse(w http.ResponseWriter) error {
+func (response ListAuditLog500JSONResponse) VisitListAuditLogResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AddPrincipalToGroupRequestObject struct {
-	Uuid openapi_types.UUID `json:"uuid"`
-	Body *AddPrincipalToGroupJSONRequestBody
+type ListCrossAccountRequestsRequestObject struct {
+	Params ListCrossAccountRequestsParams
 }
 
-type AddPrincipalToGroupResponseObject interface {
-	VisitAddPrincipalToGroupResponse(w http.ResponseWriter) error
+type ListCrossAccountRequestsResponseObject interface {
+	VisitListCrossAccountRequestsResponse(w http.ResponseWriter) error
 }
 
-type AddPrincipalToGroup200JSONResponse GroupWithPrincipalsAndRoles
+type ListCrossAccountRequests200JSONResponse CrossAccountRequestPagination
 
-func (response AddPrincipalToGroup200JSONResponse) VisitAddPrincipalToGroupResponse(w http.ResponseWriter) error {
+func (response ListCrossAccountRequests200JSONResponse) VisitListCrossAccountRequestsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AddPrincipalToGroup400Response struct {
-}
-
-func (response AddPrincipalToGroup400Response) VisitAddPrincipalToGroupResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
-
-type AddPrincipalToGroup401Response struct {
+type ListCrossAccountRequests401Response struct {
 }
 
-func (response AddPrincipalToGroup401Response) VisitAddPrincipalToGroupResponse(w http.ResponseWriter) error {
+func (response ListCrossAccountRequests401Response) VisitListCrossAccountRequestsResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AddPrincipalToGroup403JSONResponse Error403
+type ListCrossAccountRequests403JSONResponse Error403
 
-func (response AddPrincipalToGroup403JSONResponse) VisitAddPrincipalToGroupResponse(w http.ResponseWriter) error {
+func (response ListCrossAccountRequests403JSONResponse) VisitListCrossAccountRequestsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AddPrincipalToGroup404JSONResponse ErrorNotFound
-
-func (response AddPrincipalToGroup404JSONResponse) VisitAddPrincipalToGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type AddPrincipalToGroup500JSONResponse Error
+type ListCrossAccountRequests500JSONResponse Error
 
-func (response AddPrincipalToGroup500JSONResponse) VisitAddPrincipalToGroupResponse(w http.ResponseWriter) error {
+func (response ListCrossAccountRequests500JSONResponse) VisitListCrossAccountRequestsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRoleFromGroupRequestObject struct {
-	Uuid   openapi_types.UUID `json:"uuid"`
-	Params DeleteRoleFromGroupParams
-}
-
-type DeleteRoleFromGroupResponseObject interface {
-	VisitDeleteRoleFromGroupResponse(w http.ResponseWriter) error
+type CreateCrossAccountRequestsRequestObject struct {
+	Body *CreateCrossAccountRequestsJSONRequestBody
 }
 
-type DeleteRoleFromGroup204Response struct {
+type CreateCrossAccountRequestsResponseObject interface {
+	VisitCreateCrossAccountRequestsResponse(w http.ResponseWriter) error
 }
 
-func (response DeleteRoleFromGroup204Response) VisitDeleteRoleFromGroupResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+type CreateCrossAccountRequests201JSONResponse CrossAccountRequestOut
 
-type DeleteRoleFromGroup400Response struct {
-}
+func (response CreateCrossAccountRequests201JSONResponse) VisitCreateCrossAccountRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
 
-func (response DeleteRoleFromGroup400Response) VisitDeleteRoleFromGroupResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRoleFromGroup401Response struct {
+type CreateCrossAccountRequests401Response struct {
 }
 
-func (response DeleteRoleFromGroup401Response) VisitDeleteRoleFromGroupResponse(w http.ResponseWriter) error {
+func (response CreateCrossAccountRequests401Response) VisitCreateCrossAccountRequestsResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type DeleteRoleFromGroup403JSONResponse Error403
+type CreateCrossAccountRequests403JSONResponse Error403
 
-func (response DeleteRoleFromGroup403JSONResponse) VisitDeleteRoleFromGroupResponse(w http.ResponseWriter) error {
+func (response CreateCrossAccountRequests403JSONResponse) VisitCreateCrossAccountRequestsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRoleFromGroup404JSONResponse Error
+type CreateCrossAccountRequests500JSONResponse Error
 
-func (response DeleteRoleFromGroup404JSONResponse) VisitDeleteRoleFromGroupResponse(w http.ResponseWriter) error {
+func (response CreateCrossAccountRequests500JSONResponse) VisitCreateCrossAccountRequestsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRoleFromGroup500JSONResponse Error
-
-func (response DeleteRoleFromGroup500JSONResponse) VisitDeleteRoleFromGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ListRolesForGroupRequestObject struct {
+type GetCrossAccountRequestRequestObject struct {
 	Uuid   openapi_types.UUID `json:"uuid"`
-	Params ListRolesForGroupParams
+	Params GetCrossAccountRequestParams
 }
 
-type ListRolesForGroupResponseObject interface {
-	VisitListRolesForGroupResponse(w http.ResponseWriter) error
+type GetCrossAccountRequestResponseObject interface {
+	VisitGetCrossAccountRequestResponse(w http.ResponseWriter) error
 }
 
-type ListRolesForGroup200JSONResponse GroupRolesPagination
+type GetCrossAccountRequest200JSONResponse CrossAccountRequestDetail
 
-func (response ListRolesForGroup200JSONResponse) VisitListRolesForGroupResponse(w http.ResponseWriter) error {
+func (response GetCrossAccountRequest200JSONResponse) VisitGetCrossAccountRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRolesForGroup401Response struct {
+type GetCrossAccountRequest401Response struct {
 }
 
-func (response ListRolesForGroup401Response) VisitListRolesForGroupResponse(w http.ResponseWriter) error {
+func (response GetCrossAccountRequest401Response) VisitGetCrossAccountRequestResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ListRolesForGroup403JSONResponse Error403
+type GetCrossAccountRequest403JSONResponse Error403
 
-func (response ListRolesForGroup403JSONResponse) VisitListRolesForGroupResponse(w http.ResponseWriter) error {
+func (response GetCrossAccountRequest403JSONResponse) VisitGetCrossAccountRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRolesForGroup500JSONResponse Error
+type GetCrossAccountRequest404JSONResponse Error
 
-func (response ListRolesForGroup500JSONResponse) VisitListRolesForGroupResponse(w http.ResponseWriter) error {
+func (response GetCrossAccountRequest404JSONResponse) VisitGetCrossAccountRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCrossAccountRequest500JSONResponse Error
+
+func (response GetCrossAccountRequest500JSONResponse) VisitGetCrossAccountRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AddRoleToGroupRequestObject struct {
+type PatchCrossAccountRequestRequestObject struct {
 	Uuid openapi_types.UUID `json:"uuid"`
-	Body *AddRoleToGroupJSONRequestBody
+	Body *PatchCrossAccountRequestJSONRequestBody
 }
 
-type AddRoleToGroupResponseObject interface {
-	VisitAddRoleToGroupResponse(w http.ResponseWriter) error
+type PatchCrossAccountRequestResponseObject interface {
+	VisitPatchCrossAccountRequestResponse(w http.ResponseWriter) error
 }
 
-type AddRoleToGroup200JSONResponse struct {
-	Data []RoleOut `json:"data"`
-}
+type PatchCrossAccountRequest200JSONResponse CrossAccountRequestDetail
 
-func (response AddRoleToGroup200JSONResponse) VisitAddRoleToGroupResponse(w http.ResponseWriter) error {
+func (response PatchCrossAccountRequest200JSONResponse) VisitPatchCrossAccountRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AddRoleToGroup400Response struct {
-}
+type PatchCrossAccountRequest201JSONResponse CrossAccountRequestOut
 
-func (response AddRoleToGroup400Response) VisitAddRoleToGroupResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+func (response PatchCrossAccountRequest201JSONResponse) VisitPatchCrossAccountRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AddRoleToGroup401Response struct {
+type PatchCrossAccountRequest401Response struct {
 }
 
-func (response AddRoleToGroup401Response) VisitAddRoleToGroupResponse(w http.ResponseWriter) error {
+func (response PatchCrossAccountRequest401Response) VisitPatchCrossAccountRequestResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AddRoleToGroup403JSONResponse Error403
+type PatchCrossAccountRequest403JSONResponse Error403
 
-func (response AddRoleToGroup403JSONResponse) VisitAddRoleToGroupResponse(w http.ResponseWriter) error {
+func (response PatchCrossAccountRequest403JSONResponse) VisitPatchCrossAccountRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AddRoleToGroup404JSONResponse Error
+type PatchCrossAccountRequest404JSONResponse Error
 
-func (response AddRoleToGroup404JSONResponse) VisitAddRoleToGroupResponse(w http.ResponseWriter) error {
+func (response PatchCrossAccountRequest404JSONResponse) VisitPatchCrossAccountRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AddRoleToGroup500JSONResponse Error
+type PatchCrossAccountRequest500JSONResponse Error
 
-func (response AddRoleToGroup500JSONResponse) VisitAddRoleToGroupResponse(w http.ResponseWriter) error {
+func (response PatchCrossAccountRequest500JSONResponse) VisitPatchCrossAccountRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPermissionsRequestObject struct {
-	Params ListPermissionsParams
+type PutCrossAccountRequestRequestObject struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+	Body *PutCrossAccountRequestJSONRequestBody
 }
 
-type ListPermissionsResponseObject interface {
-	VisitListPermissionsResponse(w http.ResponseWriter) error
+type PutCrossAccountRequestResponseObject interface {
+	VisitPutCrossAccountRequestResponse(w http.ResponseWriter) error
 }
 
-type ListPermissions200JSONResponse PermissionPagination
+type PutCrossAccountRequest200JSONResponse CrossAccountRequestDetail
 
-func (response ListPermissions200JSONResponse) VisitListPermissionsResponse(w http.ResponseWriter) error {
+func (response PutCrossAccountRequest200JSONResponse) VisitPutCrossAccountRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPermissions401Response struct {
+type PutCrossAccountRequest201JSONResponse CrossAccountRequestOut
+
+func (response PutCrossAccountRequest201JSONResponse) VisitPutCrossAccountRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response ListPermissions401Response) VisitListPermissionsResponse(w http.ResponseWriter) error {
+type PutCrossAccountRequest401Response struct {
+}
+
+func (response PutCrossAccountRequest401Response) VisitPutCrossAccountRequestResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ListPermissions403JSONResponse Error403
+type PutCrossAccountRequest403JSONResponse Error403
 
-func (response ListPermissions403JSONResponse) VisitListPermissionsResponse(w http.ResponseWriter) error {
+func (response PutCrossAccountRequest403JSONResponse) VisitPutCrossAccountRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPermissions500JSONResponse Error
+type PutCrossAccountRequest404JSONResponse Error
 
-func (response ListPermissions500JSONResponse) VisitListPermissionsResponse(w http.ResponseWriter) error {
+func (response PutCrossAccountRequest404JSONResponse) VisitPutCrossAccountRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCrossAccountRequest500JSONResponse Error
+
+func (response PutCrossAccountRequest500JSONResponse) VisitPutCrossAccountRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPermissionOptionsRequestObject struct {
-	Params ListPermissionOptionsParams
+type ExportRbacRequestObject struct {
 }
 
-type ListPermissionOptionsResponseObject interface {
-	VisitListPermissionOptionsResponse(w http.ResponseWriter) error
+type ExportRbacResponseObject interface {
+	VisitExportRbacResponse(w http.ResponseWriter) error
 }
 
-type ListPermissionOptions200JSONResponse PermissionOptionsPagination
+type ExportRbac200JSONResponse RbacExport
 
-func (response ListPermissionOptions200JSONResponse) VisitListPermissionOptionsResponse(w http.ResponseWriter) error {
+func (response ExportRbac200JSONResponse) VisitExportRbacResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPermissionOptions401Response struct {
+type ExportRbac401Response struct {
 }
 
-func (response ListPermissionOptions401Response) VisitListPermissionOptionsResponse(w http.ResponseWriter) error {
+func (response ExportRbac401Response) VisitExportRbacResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ListPermissionOptions403JSONResponse Error403
+type ExportRbac403JSONResponse Error403
 
-func (response ListPermissionOptions403JSONResponse) VisitListPermissionOptionsResponse(w http.ResponseWriter) error {
+func (response ExportRbac403JSONResponse) VisitExportRbacResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPermissionOptions500JSONResponse Error
+type ExportRbac500JSONResponse Error
 
-func (response ListPermissionOptions500JSONResponse) VisitListPermissionOptionsResponse(w http.ResponseWriter) error {
+func (response ExportRbac500JSONResponse) VisitExportRbacResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPoliciesRequestObject struct {
-	Params ListPoliciesParams
+type ListGroupsRequestObject struct {
+	Params ListGroupsParams
 }
 
-type ListPoliciesResponseObject interface {
-	VisitListPoliciesResponse(w http.ResponseWriter) error
+type ListGroupsResponseObject interface {
+	VisitListGroupsResponse(w http.ResponseWriter) error
 }
 
-type ListPolicies200JSONResponse PolicyPagination
+type ListGroups200JSONResponse GroupPagination
 
-func (response ListPolicies200JSONResponse) VisitListPoliciesResponse(w http.ResponseWriter) error {
+func (response ListGroups200JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPolicies401Response struct {
+type ListGroups401Response struct {
 }
 
-func (response ListPolicies401Response) VisitListPoliciesResponse(w http.ResponseWriter) error {
+func (response ListGroups401Response) VisitListGroupsResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ListPolicies500JSONResponse Error
+type ListGroups403JSONResponse Error403
 
-func (response ListPolicies500JSONResponse) VisitListPoliciesResponse(w http.ResponseWriter) error {
+func (response ListGroups403JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreatePoliciesRequestObject struct {
-	Body *CreatePoliciesJSONRequestBody
-}
-
-type CreatePoliciesResponseObject interface {
-	VisitCreatePoliciesResponse(w http.ResponseWriter) error
-}
-
-type CreatePolicies201JSONResponse PolicyExtended
+type ListGroups500JSONResponse Error
 
-func (response CreatePolicies201JSONResponse) VisitCreatePoliciesResponse(w http.ResponseWriter) error {
+func (response ListGroups500JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreatePolicies401Response struct {
+type CreateGroupRequestObject struct {
+	Body *CreateGroupJSONRequestBody
 }
 
-func (response CreatePolicies401Response) VisitCreatePoliciesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+type CreateGroupResponseObject interface {
+	VisitCreateGroupResponse(w http.ResponseWriter) error
 }
 
-type CreatePolicies500JSONResponse Error
+type CreateGroup201JSONResponse GroupOut
 
-func (response CreatePolicies500JSONResponse) VisitCreatePoliciesResponse(w http.ResponseWriter) error {
+func (response CreateGroup201JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeletePolicyRequestObject struct {
-	Uuid openapi_types.UUID `json:"uuid"`
-}
-
-type DeletePolicyResponseObject interface {
-	VisitDeletePolicyResponse(w http.ResponseWriter) error
-}
-
-type DeletePolicy204Response struct {
-}
-
-func (response DeletePolicy204Response) VisitDeletePolicyResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
-
-type DeletePolicy401Response struct {
+type CreateGroup401Response struct {
 }
 
-func (response DeletePolicy401Response) VisitDeletePolicyResponse(w http.ResponseWriter) error {
+func (response CreateGroup401Response) VisitCreateGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type DeletePolicy404JSONResponse Error
+type CreateGroup403JSONResponse Error403
 
-func (response DeletePolicy404JSONResponse) VisitDeletePolicyResponse(w http.ResponseWriter) error {
+func (response CreateGroup403JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeletePolicy500JSONResponse Error
+type CreateGroup500JSONResponse Error
 
-func (response DeletePolicy500JSONResponse) VisitDeletePolicyResponse(w http.ResponseWriter) error {
+func (response CreateGroup500JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPolicyRequestObject struct {
+type DeleteGroupRequestObject struct {
 	Uuid openapi_types.UUID `json:"uuid"`
 }
 
-type GetPolicyResponseObject interface {
-	VisitGetPolicyResponse(w http.ResponseWriter) error
+type DeleteGroupResponseObject interface {
+	VisitDeleteGroupResponse(w http.ResponseWriter) error
 }
 
-type GetPolicy200JSONResponse PolicyExtended
-
-func (response GetPolicy200JSONResponse) VisitGetPolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type DeleteGroup204Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response DeleteGroup204Response) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type GetPolicy401Response struct {
+type DeleteGroup401Response struct {
 }
 
-func (response GetPolicy401Response) VisitGetPolicyResponse(w http.ResponseWriter) error {
+func (response DeleteGroup401Response) VisitDeleteGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetPolicy404JSONResponse Error
+type DeleteGroup403JSONResponse Error403
 
-func (response GetPolicy404JSONResponse) VisitGetPolicyResponse(w http.ResponseWriter) error {
+func (response DeleteGroup403JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPolicy500JSONResponse Error
-
-func (response GetPolicy500JSONResponse) VisitGetPolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+type DeleteGroup404AsteriskResponse struct {
+	Body          io.Reader
+	ContentType   string
+	ContentLength int64
 }
 
-type UpdatePolicyRequestObject struct {
-	Uuid openapi_types.UUID `json:"uuid"`
-	Body *UpdatePolicyJSONRequestBody
-}
+func (response DeleteGroup404AsteriskResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
 
-type UpdatePolicyResponseObject interface {
-	VisitUpdatePolicyResponse(w http.ResponseWriter) error
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type UpdatePolicy200JSONResponse PolicyExtended
+type DeleteGroup500AsteriskResponse struct {
+	Body          io.Reader
+	ContentType   string
+	ContentLength int64
+}
 
-func (response UpdatePolicy200JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
+func (response DeleteGroup500AsteriskResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(500)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetGroupRequestObject struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+type GetGroupResponseObject interface {
+	VisitGetGroupResponse(w http.ResponseWriter) error
+}
+
+type GetGroup200JSONResponse GroupWithPrincipalsAndRoles
+
+func (response GetGroup200JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdatePolicy401Response struct {
+type GetGroup401Response struct {
 }
 
-func (response UpdatePolicy401Response) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
+func (response GetGroup401Response) VisitGetGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type UpdatePolicy404JSONResponse Error
+type GetGroup403JSONResponse Error403
 
-func (response UpdatePolicy404JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
+func (response GetGroup403JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroup404JSONResponse Error
+
+func (response GetGroup404JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdatePolicy500JSONResponse Error
+type GetGroup500JSONResponse Error
 
-func (response UpdatePolicy500JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
+func (response GetGroup500JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPrincipalsRequestObject struct {
-	Params ListPrincipalsParams
+type UpdateGroupRequestObject struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+	Body *UpdateGroupJSONRequestBody
 }
 
-type ListPrincipalsResponseObject interface {
-	VisitListPrincipalsResponse(w http.ResponseWriter) error
+type UpdateGroupResponseObject interface {
+	VisitUpdateGroupResponse(w http.ResponseWriter) error
 }
 
-type ListPrincipals200JSONResponse PrincipalPagination
+type UpdateGroup200JSONResponse GroupOut
 
-func (response ListPrincipals200JSONResponse) VisitListPrincipalsResponse(w http.ResponseWriter) error {
+func (response UpdateGroup200JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPrincipals401Response struct {
+type UpdateGroup401Response struct {
 }
 
-func (response ListPrincipals401Response) VisitListPrincipalsResponse(w http.ResponseWriter) error {
+func (response UpdateGroup401Response) VisitUpdateGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ListPrincipals403JSONResponse Error403
+type UpdateGroup403JSONResponse Error403
 
-func (response ListPrincipals403JSONResponse) VisitListPrincipalsResponse(w http.ResponseWriter) error {
+func (response UpdateGroup403JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPrincipals500JSONResponse Error
+type UpdateGroup404AsteriskResponse struct {
+	Body          io.Reader
+	ContentType   string
+	ContentLength int64
+}
 
-func (response ListPrincipals500JSONResponse) VisitListPrincipalsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response UpdateGroup404AsteriskResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type UpdateGroup500AsteriskResponse struct {
+	Body          io.Reader
+	ContentType   string
+	ContentLength int64
+}
+
+func (response UpdateGroup500AsteriskResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
 	w.WriteHeader(500)
 
-	return json.NewEncoder(w).Encode(response)
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type ListRolesRequestObject struct {
-	Params ListRolesParams
+type DeletePrincipalFromGroupRequestObject struct {
+	Uuid   openapi_types.UUID `json:"uuid"`
+	Params DeletePrincipalFromGroupParams
 }
 
-type ListRolesResponseObject interface {
-	VisitListRolesResponse(w http.ResponseWriter) error
+type DeletePrincipalFromGroupResponseObject interface {
+	VisitDeletePrincipalFromGroupResponse(w http.ResponseWriter) error
 }
 
-type ListRoles200JSONResponse RolePaginationDynamic
+type DeletePrincipalFromGroup204Response struct {
+}
 
-func (response ListRoles200JSONResponse) VisitListRolesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response DeletePrincipalFromGroup204Response) VisitDeletePrincipalFromGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
 
-	return json.NewEncoder(w).Encode(response)
+type DeletePrincipalFromGroup400Response struct {
 }
 
-type ListRoles401Response struct {
+func (response DeletePrincipalFromGroup400Response) VisitDeletePrincipalFromGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
 }
 
-func (response ListRoles401Response) VisitListRolesResponse(w http.ResponseWriter) error {
+type DeletePrincipalFromGroup401Response struct {
+}
+
+func (response DeletePrincipalFromGroup401Response) VisitDeletePrincipalFromGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ListRoles403JSONResponse Error403
+type DeletePrincipalFromGroup403JSONResponse Error403
 
-func (response ListRoles403JSONResponse) VisitListRolesResponse(w http.ResponseWriter) error {
+func (response DeletePrincipalFromGroup403JSONResponse) VisitDeletePrincipalFromGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRoles500JSONResponse Error
+type DeletePrincipalFromGroup404JSONResponse ErrorNotFound
 
-func (response ListRoles500JSONResponse) VisitListRolesResponse(w http.ResponseWriter) error {
+func (response DeletePrincipalFromGroup404JSONResponse) VisitDeletePrincipalFromGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePrincipalFromGroup500JSONResponse Error
+
+func (response DeletePrincipalFromGroup500JSONResponse) VisitDeletePrincipalFromGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRoleRequestObject struct {
-	Body *CreateRoleJSONRequestBody
+type GetPrincipalsFromGroupRequestObject struct {
+	Uuid   openapi_types.UUID `json:"uuid"`
+	Params GetPrincipalsFromGroupParams
 }
 
-type CreateRoleResponseObject interface {
-	VisitCreateRoleResponse(w http.ResponseWriter) error
+type GetPrincipalsFromGroupResponseObject interface {
+	VisitGetPrincipalsFromGroupResponse(w http.ResponseWriter) error
 }
 
-type CreateRole201JSONResponse RoleWithAccess
+type GetPrincipalsFromGroup200JSONResponse PrincipalPagination
 
-func (response CreateRole201JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+func (response GetPrincipalsFromGroup200JSONResponse) VisitGetPrincipalsFromGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRole401Response struct {
+type GetPrincipalsFromGroup400Response struct {
 }
 
-func (response CreateRole401Response) VisitCreateRoleResponse(w http.ResponseWriter) error {
+func (response GetPrincipalsFromGroup400Response) VisitGetPrincipalsFromGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetPrincipalsFromGroup401Response struct {
+}
+
+func (response GetPrincipalsFromGroup401Response) VisitGetPrincipalsFromGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type CreateRole403JSONResponse Error403
+type GetPrincipalsFromGroup404JSONResponse Error
 
-func (response CreateRole403JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+func (response GetPrincipalsFromGroup404JSONResponse) VisitGetPrincipalsFromGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRole500JSONResponse Error
+type GetPrincipalsFromGroup500JSONResponse Error
 
-func (response CreateRole500JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+func (response GetPrincipalsFromGroup500JSONResponse) VisitGetPrincipalsFromGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRoleRequestObject struct {
+type AddPrincipalToGroupRequestObject struct {
 	Uuid openapi_types.UUID `json:"uuid"`
+	Body *AddPrincipalToGroupJSONRequestBody
 }
 
-type DeleteRoleResponseObject interface {
-	VisitDeleteRoleResponse(w http.ResponseWriter) error
+type AddPrincipalToGroupResponseObject interface {
+	VisitAddPrincipalToGroupResponse(w http.ResponseWriter) error
 }
 
-type DeleteRole204Response struct {
+type AddPrincipalToGroup200JSONResponse GroupWithPrincipalsAndRoles
+
+func (response AddPrincipalToGroup200JSONResponse) VisitAddPrincipalToGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response DeleteRole204Response) VisitDeleteRoleResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
+type AddPrincipalToGroup400Response struct {
+}
+
+func (response AddPrincipalToGroup400Response) VisitAddPrincipalToGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
 	return nil
 }
 
-type DeleteRole401Response struct {
+type AddPrincipalToGroup401Response struct {
 }
 
-func (response DeleteRole401Response) VisitDeleteRoleResponse(w http.ResponseWriter) error {
+func (response AddPrincipalToGroup401Response) VisitAddPrincipalToGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type DeleteRole403JSONResponse Error403
+type AddPrincipalToGroup403JSONResponse Error403
 
-func (response DeleteRole403JSONResponse) VisitDeleteRoleResponse(w http.ResponseWriter) error {
+func (response AddPrincipalToGroup403JSONResponse) VisitAddPrincipalToGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRole404JSONResponse Error
+type AddPrincipalToGroup404JSONResponse ErrorNotFound
 
-func (response DeleteRole404JSONResponse) VisitDeleteRoleResponse(w http.ResponseWriter) error {
+func (response AddPrincipalToGroup404JSONResponse) VisitAddPrincipalToGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRole500JSONResponse Error
+type AddPrincipalToGroup500JSONResponse Error
 
-func (response DeleteRole500JSONResponse) VisitDeleteRoleResponse(w http.ResponseWriter) error {
+func (response AddPrincipalToGroup500JSONResponse) VisitAddPrincipalToGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRoleRequestObject struct {
+type DeleteRoleFromGroupRequestObject struct {
 	Uuid   openapi_types.UUID `json:"uuid"`
-	Params GetRoleParams
+	Params DeleteRoleFromGroupParams
 }
 
-type GetRoleResponseObject interface {
-	VisitGetRoleResponse(w http.ResponseWriter) error
+type DeleteRoleFromGroupResponseObject interface {
+	VisitDeleteRoleFromGroupResponse(w http.ResponseWriter) error
 }
 
-type GetRole200JSONResponse RoleWithAccess
+type DeleteRoleFromGroup204Response struct {
+}
 
-func (response GetRole200JSONResponse) VisitGetRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response DeleteRoleFromGroup204Response) VisitDeleteRoleFromGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
 
-	return json.NewEncoder(w).Encode(response)
+type DeleteRoleFromGroup400Response struct {
 }
 
-type GetRole401Response struct {
+func (response DeleteRoleFromGroup400Response) VisitDeleteRoleFromGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
 }
 
-func (response GetRole401Response) VisitGetRoleResponse(w http.ResponseWriter) error {
+type DeleteRoleFromGroup401Response struct {
+}
+
+func (response DeleteRoleFromGroup401Response) VisitDeleteRoleFromGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetRole403JSONResponse Error403
+type DeleteRoleFromGroup403JSONResponse Error403
 
-func (response GetRole403JSONResponse) VisitGetRoleResponse(w http.ResponseWriter) error {
+func (response DeleteRoleFromGroup403JSONResponse) VisitDeleteRoleFromGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRole404JSONResponse Error
+type DeleteRoleFromGroup404JSONResponse Error
 
-func (response GetRole404JSONResponse) VisitGetRoleResponse(w http.ResponseWriter) error {
+func (response DeleteRoleFromGroup404JSONResponse) VisitDeleteRoleFromGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRole500JSONResponse Error
+type DeleteRoleFromGroup500JSONResponse Error
 
-func (response GetRole500JSONResponse) VisitGetRoleResponse(w http.ResponseWriter) error {
+func (response DeleteRoleFromGroup500JSONResponse) VisitDeleteRoleFromGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchRoleRequestObject struct {
+type ListRolesForGroupRequestObject struct {
+	Uuid   openapi_types.UUID `json:"uuid"`
+	Params ListRolesForGroupParams
+}
+
+type ListRolesForGroupResponseObject interface {
+	VisitListRolesForGroupResponse(w http.ResponseWriter) error
+}
+
+type ListRolesForGroup200JSONResponse GroupRolesPagination
+
+func (response ListRolesForGroup200JSONResponse) VisitListRolesForGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRolesForGroup401Response struct {
+}
+
+func (response ListRolesForGroup401Response) VisitListRolesForGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListRolesForGroup403JSONResponse Error403
+
+func (response ListRolesForGroup403JSONResponse) VisitListRolesForGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRolesForGroup500JSONResponse Error
+
+func (response ListRolesForGroup500JSONResponse) VisitListRolesForGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddRoleToGroupRequestObject struct {
 	Uuid openapi_types.UUID `json:"uuid"`
-	Body *PatchRoleJSONRequestBody
+	Body *AddRoleToGroupJSONRequestBody
 }
 
-type PatchRoleResponseObject interface {
-	VisitPatchRoleResponse(w http.ResponseWriter) error
+type AddRoleToGroupResponseObject interface {
+	VisitAddRoleToGroupResponse(w http.ResponseWriter) error
 }
 
-type PatchRole200JSONResponse RoleWithAccess
+type AddRoleToGroup200JSONResponse struct {
+	Data []RoleOut `json:"data"`
+}
 
-func (response PatchRole200JSONResponse) VisitPatchRoleResponse(w http.ResponseWriter) error {
+func (response AddRoleToGroup200JSONResponse) VisitAddRoleToGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchRole401Response struct {
+type AddRoleToGroup400Response struct {
 }
 
-func (response PatchRole401Response) VisitPatchRoleResponse(w http.ResponseWriter) error {
+func (response AddRoleToGroup400Response) VisitAddRoleToGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AddRoleToGroup401Response struct {
+}
+
+func (response AddRoleToGroup401Response) VisitAddRoleToGroupResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type PatchRole403JSONResponse Error403
+type AddRoleToGroup403JSONResponse Error403
 
-func (response PatchRole403JSONResponse) VisitPatchRoleResponse(w http.ResponseWriter) error {
+func (response AddRoleToGroup403JSONResponse) VisitAddRoleToGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchRole404JSONResponse Error
+type AddRoleToGroup404JSONResponse Error
 
-func (response PatchRole404JSONResponse) VisitPatchRoleResponse(w http.ResponseWriter) error {
+func (response AddRoleToGroup404JSONResponse) VisitAddRoleToGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchRole500JSONResponse Error
+type AddRoleToGroup500JSONResponse Error
 
-func (response PatchRole500JSONResponse) VisitPatchRoleResponse(w http.ResponseWriter) error {
+func (response AddRoleToGroup500JSONResponse) VisitAddRoleToGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateRoleRequestObject struct {
-	Uuid openapi_types.UUID `json:"uuid"`
-	Body *UpdateRoleJSONRequestBody
+type ImportRbacExportRequestObject struct {
+	Params ImportRbacExportParams
+	Body   *ImportRbacExportJSONRequestBody
 }
 
-type UpdateRoleResponseObject interface {
-	VisitUpdateRoleResponse(w http.ResponseWriter) error
+type ImportRbacExportResponseObject interface {
+	VisitImportRbacExportResponse(w http.ResponseWriter) error
 }
 
-type UpdateRole200Response struct {
-}
+type ImportRbacExport200JSONResponse ImportReport
 
-func (response UpdateRole200Response) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+func (response ImportRbacExport200JSONResponse) VisitImportRbacExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportRbacExport400JSONResponse Error
+
+func (response ImportRbacExport400JSONResponse) VisitImportRbacExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportRbacExport401Response struct {
+}
+
+func (response ImportRbacExport401Response) VisitImportRbacExportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
 	return nil
 }
 
-type UpdateRole401Response struct {
+type ImportRbacExport403JSONResponse Error403
+
+func (response ImportRbacExport403JSONResponse) VisitImportRbacExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response UpdateRole401Response) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+type ImportRbacExport500JSONResponse Error
+
+func (response ImportRbacExport500JSONResponse) VisitImportRbacExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeprovisionOrganizationRequestObject struct {
+	OrgId OrgId `json:"org_id"`
+}
+
+type DeprovisionOrganizationResponseObject interface {
+	VisitDeprovisionOrganizationResponse(w http.ResponseWriter) error
+}
+
+type DeprovisionOrganization204Response struct {
+}
+
+func (response DeprovisionOrganization204Response) VisitDeprovisionOrganizationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeprovisionOrganization401Response struct {
+}
+
+func (response DeprovisionOrganization401Response) VisitDeprovisionOrganizationResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type UpdateRole403JSONResponse Error403
+type DeprovisionOrganization403JSONResponse Error403
 
-func (response UpdateRole403JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+func (response DeprovisionOrganization403JSONResponse) VisitDeprovisionOrganizationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateRole404JSONResponse Error
+type DeprovisionOrganization404JSONResponse Error
 
-func (response UpdateRole404JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+func (response DeprovisionOrganization404JSONResponse) VisitDeprovisionOrganizationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateRole500JSONResponse Error
+type DeprovisionOrganization409JSONResponse Error
 
-func (response UpdateRole500JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+func (response DeprovisionOrganization409JSONResponse) VisitDeprovisionOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeprovisionOrganization500JSONResponse Error
+
+func (response DeprovisionOrganization500JSONResponse) VisitDeprovisionOrganizationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRoleAccessRequestObject struct {
-	Uuid   openapi_types.UUID `json:"uuid"`
-	Params GetRoleAccessParams
+type ProvisionOrganizationRequestObject struct {
+	OrgId OrgId `json:"org_id"`
+	Body  *ProvisionOrganizationJSONRequestBody
 }
 
-type GetRoleAccessResponseObject interface {
-	VisitGetRoleAccessResponse(w http.ResponseWriter) error
+type ProvisionOrganizationResponseObject interface {
+	VisitProvisionOrganizationResponse(w http.ResponseWriter) error
 }
 
-type GetRoleAccess200JSONResponse AccessPagination
+type ProvisionOrganization200JSONResponse Organization
 
-func (response GetRoleAccess200JSONResponse) VisitGetRoleAccessResponse(w http.ResponseWriter) error {
+func (response ProvisionOrganization200JSONResponse) VisitProvisionOrganizationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRoleAccess401Response struct {
+type ProvisionOrganization401Response struct {
 }
 
-func (response GetRoleAccess401Response) VisitGetRoleAccessResponse(w http.ResponseWriter) error {
+func (response ProvisionOrganization401Response) VisitProvisionOrganizationResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetRoleAccess403JSONResponse Error403
+type ProvisionOrganization403JSONResponse Error403
 
-func (response GetRoleAccess403JSONResponse) VisitGetRoleAccessResponse(w http.ResponseWriter) error {
+func (response ProvisionOrganization403JSONResponse) VisitProvisionOrganizationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRoleAccess404JSONResponse Error
+type ProvisionOrganization500JSONResponse Error
 
-func (response GetRoleAccess404JSONResponse) VisitGetRoleAccessResponse(w http.ResponseWriter) error {
+func (response ProvisionOrganization500JSONResponse) VisitProvisionOrganizationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRoleAccess500JSONResponse Error
+type ListPermissionsRequestObject struct {
+	Params ListPermissionsParams
+}
 
-func (response GetRoleAccess500JSONResponse) VisitGetRoleAccessResponse(w http.ResponseWriter) error {
+type ListPermissionsResponseObject interface {
+	VisitListPermissionsResponse(w http.ResponseWriter) error
+}
+
+type ListPermissions200JSONResponse PermissionPagination
+
+func (response ListPermissions200JSONResponse) VisitListPermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPermissions401Response struct {
+}
+
+func (response ListPermissions401Response) VisitListPermissionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListPermissions403JSONResponse Error403
+
+func (response ListPermissions403JSONResponse) VisitListPermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPermissions500JSONResponse Error
+
+func (response ListPermissions500JSONResponse) VisitListPermissionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetStatusRequestObject struct {
+type ListPermissionOptionsRequestObject struct {
+	Params ListPermissionOptionsParams
 }
 
-type GetStatusResponseObject interface {
-	VisitGetStatusResponse(w http.ResponseWriter) error
+type ListPermissionOptionsResponseObject interface {
+	VisitListPermissionOptionsResponse(w http.ResponseWriter) error
 }
 
-type GetStatus200JSONResponse Status
+type ListPermissionOptions200JSONResponse PermissionOptionsPagination
 
-func (response GetStatus200JSONResponse) VisitGetStatusResponse(w http.ResponseWriter) error {
+func (response ListPermissionOptions200JSONResponse) VisitListPermissionOptionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetStatus500JSONResponse Error
+type ListPermissionOptions401Response struct {
+}
 
-func (response GetStatus500JSONResponse) VisitGetStatusResponse(w http.ResponseWriter) error {
+func (response ListPermissionOptions401Response) VisitListPermissionOptionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListPermissionOptions403JSONResponse Error403
+
+func (response ListPermissionOptions403JSONResponse) VisitListPermissionOptionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
+type ListPermissionOptions500JSONResponse Error
+
+func (response ListPermissionOptions500JSONResponse) VisitListPermissionOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPoliciesRequestObject struct {
+	Params ListPoliciesParams
+}
+
+type ListPoliciesResponseObject interface {
+	VisitListPoliciesResponse(w http.ResponseWriter) error
+}
+
+type ListPolicies200JSONResponse PolicyPagination
+
+func (response ListPolicies200JSONResponse) VisitListPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPolicies401Response struct {
+}
+
+func (response ListPolicies401Response) VisitListPoliciesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListPolicies500JSONResponse Error
+
+func (response ListPolicies500JSONResponse) VisitListPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePoliciesRequestObject struct {
+	Body *CreatePoliciesJSONRequestBody
+}
+
+type CreatePoliciesResponseObject interface {
+	VisitCreatePoliciesResponse(w http.ResponseWriter) error
+}
+
+type CreatePolicies201JSONResponse PolicyExtended
+
+func (response CreatePolicies201JSONResponse) VisitCreatePoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePolicies401Response struct {
+}
+
+func (response CreatePolicies401Response) VisitCreatePoliciesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreatePolicies500JSONResponse Error
+
+func (response CreatePolicies500JSONResponse) VisitCreatePoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePolicyRequestObject struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+type DeletePolicyResponseObject interface {
+	VisitDeletePolicyResponse(w http.ResponseWriter) error
+}
+
+type DeletePolicy204Response struct {
+}
+
+func (response DeletePolicy204Response) VisitDeletePolicyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePolicy401Response struct {
+}
+
+func (response DeletePolicy401Response) VisitDeletePolicyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeletePolicy404JSONResponse Error
+
+func (response DeletePolicy404JSONResponse) VisitDeletePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePolicy500JSONResponse Error
+
+func (response DeletePolicy500JSONResponse) VisitDeletePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPolicyRequestObject struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+type GetPolicyResponseObject interface {
+	VisitGetPolicyResponse(w http.ResponseWriter) error
+}
+
+type GetPolicy200JSONResponse PolicyExtended
+
+func (response GetPolicy200JSONResponse) VisitGetPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPolicy401Response struct {
+}
+
+func (response GetPolicy401Response) VisitGetPolicyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetPolicy404JSONResponse Error
+
+func (response GetPolicy404JSONResponse) VisitGetPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPolicy500JSONResponse Error
+
+func (response GetPolicy500JSONResponse) VisitGetPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePolicyRequestObject struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+	Body *UpdatePolicyJSONRequestBody
+}
+
+type UpdatePolicyResponseObject interface {
+	VisitUpdatePolicyResponse(w http.ResponseWriter) error
+}
+
+type UpdatePolicy200JSONResponse PolicyExtended
+
+func (response UpdatePolicy200JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePolicy401Response struct {
+}
+
+func (response UpdatePolicy401Response) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdatePolicy404JSONResponse Error
+
+func (response UpdatePolicy404JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePolicy500JSONResponse Error
+
+func (response UpdatePolicy500JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPrincipalsRequestObject struct {
+	Params ListPrincipalsParams
+}
+
+type ListPrincipalsResponseObject interface {
+	VisitListPrincipalsResponse(w http.ResponseWriter) error
+}
+
+type ListPrincipals200JSONResponse PrincipalPagination
+
+func (response ListPrincipals200JSONResponse) VisitListPrincipalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPrincipals401Response struct {
+}
+
+func (response ListPrincipals401Response) VisitListPrincipalsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListPrincipals403JSONResponse Error403
+
+func (response ListPrincipals403JSONResponse) VisitListPrincipalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPrincipals500JSONResponse Error
+
+func (response ListPrincipals500JSONResponse) VisitListPrincipalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateResourceRequestObject struct {
+	Body *CreateResourceJSONRequestBody
+}
+
+type CreateResourceResponseObject interface {
+	VisitCreateResourceResponse(w http.ResponseWriter) error
+}
+
+type CreateResource201JSONResponse Resource
+
+func (response CreateResource201JSONResponse) VisitCreateResourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateResource400JSONResponse Error
+
+func (response CreateResource400JSONResponse) VisitCreateResourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateResource401Response struct {
+}
+
+func (response CreateResource401Response) VisitCreateResourceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateResource403JSONResponse Error403
+
+func (response CreateResource403JSONResponse) VisitCreateResourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateResource409JSONResponse Error
+
+func (response CreateResource409JSONResponse) VisitCreateResourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateResource500JSONResponse Error
+
+func (response CreateResource500JSONResponse) VisitCreateResourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpsertResourcesRequestObject struct {
+	Body *UpsertResourcesJSONRequestBody
+}
+
+type UpsertResourcesResponseObject interface {
+	VisitUpsertResourcesResponse(w http.ResponseWriter) error
+}
+
+type UpsertResources200JSONResponse ResourceList
+
+func (response UpsertResources200JSONResponse) VisitUpsertResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpsertResources400JSONResponse Error
+
+func (response UpsertResources400JSONResponse) VisitUpsertResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpsertResources401Response struct {
+}
+
+func (response UpsertResources401Response) VisitUpsertResourcesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpsertResources403JSONResponse Error403
+
+func (response UpsertResources403JSONResponse) VisitUpsertResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpsertResources500JSONResponse Error
+
+func (response UpsertResources500JSONResponse) VisitUpsertResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteResourceRequestObject struct {
+	Namespace ResourceNamespace `json:"namespace"`
+	Name      ResourceName      `json:"name"`
+	Id        ResourceId        `json:"id"`
+}
+
+type DeleteResourceResponseObject interface {
+	VisitDeleteResourceResponse(w http.ResponseWriter) error
+}
+
+type DeleteResource204Response struct {
+}
+
+func (response DeleteResource204Response) VisitDeleteResourceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteResource401Response struct {
+}
+
+func (response DeleteResource401Response) VisitDeleteResourceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteResource403JSONResponse Error403
+
+func (response DeleteResource403JSONResponse) VisitDeleteResourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteResource404JSONResponse Error
+
+func (response DeleteResource404JSONResponse) VisitDeleteResourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteResource500JSONResponse Error
+
+func (response DeleteResource500JSONResponse) VisitDeleteResourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetResourceRequestObject struct {
+	Namespace ResourceNamespace `json:"namespace"`
+	Name      ResourceName      `json:"name"`
+	Id        ResourceId        `json:"id"`
+}
+
+type GetResourceResponseObject interface {
+	VisitGetResourceResponse(w http.ResponseWriter) error
+}
+
+type GetResource200JSONResponse Resource
+
+func (response GetResource200JSONResponse) VisitGetResourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetResource401Response struct {
+}
+
+func (response GetResource401Response) VisitGetResourceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetResource403JSONResponse Error403
+
+func (response GetResource403JSONResponse) VisitGetResourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetResource404JSONResponse Error
+
+func (response GetResource404JSONResponse) VisitGetResourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetResource500JSONResponse Error
+
+func (response GetResource500JSONResponse) VisitGetResourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveResourceRequestObject struct {
+	Namespace ResourceNamespace `json:"namespace"`
+	Name      ResourceName      `json:"name"`
+	Id        ResourceId        `json:"id"`
+	Body      *MoveResourceJSONRequestBody
+}
+
+type MoveResourceResponseObject interface {
+	VisitMoveResourceResponse(w http.ResponseWriter) error
+}
+
+type MoveResource200JSONResponse Resource
+
+func (response MoveResource200JSONResponse) VisitMoveResourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveResource400JSONResponse Error
+
+func (response MoveResource400JSONResponse) VisitMoveResourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveResource401Response struct {
+}
+
+func (response MoveResource401Response) VisitMoveResourceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type MoveResource403JSONResponse Error403
+
+func (response MoveResource403JSONResponse) VisitMoveResourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveResource404JSONResponse Error
+
+func (response MoveResource404JSONResponse) VisitMoveResourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveResource500JSONResponse Error
+
+func (response MoveResource500JSONResponse) VisitMoveResourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRolesRequestObject struct {
+	Params ListRolesParams
+}
+
+type ListRolesResponseObject interface {
+	VisitListRolesResponse(w http.ResponseWriter) error
+}
+
+type ListRoles200JSONResponse RolePaginationDynamic
+
+func (response ListRoles200JSONResponse) VisitListRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoles401Response struct {
+}
+
+func (response ListRoles401Response) VisitListRolesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListRoles403JSONResponse Error403
+
+func (response ListRoles403JSONResponse) VisitListRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoles500JSONResponse Error
+
+func (response ListRoles500JSONResponse) VisitListRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRoleRequestObject struct {
+	Body *CreateRoleJSONRequestBody
+}
+
+type CreateRoleResponseObject interface {
+	VisitCreateRoleResponse(w http.ResponseWriter) error
+}
+
+type CreateRole201JSONResponse RoleWithAccess
+
+func (response CreateRole201JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRole401Response struct {
+}
+
+func (response CreateRole401Response) VisitCreateRoleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateRole403JSONResponse Error403
+
+func (response CreateRole403JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRole404JSONResponse Error
+
+func (response CreateRole404JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRole500JSONResponse Error
+
+func (response CreateRole500JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRoleRequestObject struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+type DeleteRoleResponseObject interface {
+	VisitDeleteRoleResponse(w http.ResponseWriter) error
+}
+
+type DeleteRole204Response struct {
+}
+
+func (response DeleteRole204Response) VisitDeleteRoleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteRole401Response struct {
+}
+
+func (response DeleteRole401Response) VisitDeleteRoleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteRole403JSONResponse Error403
+
+func (response DeleteRole403JSONResponse) VisitDeleteRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRole404JSONResponse Error
+
+func (response DeleteRole404JSONResponse) VisitDeleteRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRole500JSONResponse Error
+
+func (response DeleteRole500JSONResponse) VisitDeleteRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoleRequestObject struct {
+	Uuid   openapi_types.UUID `json:"uuid"`
+	Params GetRoleParams
+}
+
+type GetRoleResponseObject interface {
+	VisitGetRoleResponse(w http.ResponseWriter) error
+}
+
+type GetRole200JSONResponse RoleWithAccess
+
+func (response GetRole200JSONResponse) VisitGetRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRole401Response struct {
+}
+
+func (response GetRole401Response) VisitGetRoleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetRole403JSONResponse Error403
+
+func (response GetRole403JSONResponse) VisitGetRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRole404JSONResponse Error
+
+func (response GetRole404JSONResponse) VisitGetRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRole500JSONResponse Error
+
+func (response GetRole500JSONResponse) VisitGetRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchRoleRequestObject struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+	Body *PatchRoleJSONRequestBody
+}
+
+type PatchRoleResponseObject interface {
+	VisitPatchRoleResponse(w http.ResponseWriter) error
+}
+
+type PatchRole200JSONResponse RoleWithAccess
+
+func (response PatchRole200JSONResponse) VisitPatchRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchRole401Response struct {
+}
+
+func (response PatchRole401Response) VisitPatchRoleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PatchRole403JSONResponse Error403
+
+func (response PatchRole403JSONResponse) VisitPatchRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchRole404JSONResponse Error
+
+func (response PatchRole404JSONResponse) VisitPatchRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchRole500JSONResponse Error
+
+func (response PatchRole500JSONResponse) VisitPatchRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRoleRequestObject struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+	Body *UpdateRoleJSONRequestBody
+}
+
+type UpdateRoleResponseObject interface {
+	VisitUpdateRoleResponse(w http.ResponseWriter) error
+}
+
+type UpdateRole200Response struct {
+}
+
+func (response UpdateRole200Response) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type UpdateRole401Response struct {
+}
+
+func (response UpdateRole401Response) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateRole403JSONResponse Error403
+
+func (response UpdateRole403JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRole404JSONResponse Error
+
+func (response UpdateRole404JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRole500JSONResponse Error
+
+func (response UpdateRole500JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoleAccessRequestObject struct {
+	Uuid   openapi_types.UUID `json:"uuid"`
+	Params GetRoleAccessParams
+}
+
+type GetRoleAccessResponseObject interface {
+	VisitGetRoleAccessResponse(w http.ResponseWriter) error
+}
+
+type GetRoleAccess200JSONResponse AccessPagination
+
+func (response GetRoleAccess200JSONResponse) VisitGetRoleAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoleAccess401Response struct {
+}
+
+func (response GetRoleAccess401Response) VisitGetRoleAccessResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetRoleAccess403JSONResponse Error403
+
+func (response GetRoleAccess403JSONResponse) VisitGetRoleAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoleAccess404JSONResponse Error
+
+func (response GetRoleAccess404JSONResponse) VisitGetRoleAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoleAccess500JSONResponse Error
+
+func (response GetRoleAccess500JSONResponse) VisitGetRoleAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatusRequestObject struct {
+}
+
+type GetStatusResponseObject interface {
+	VisitGetStatusResponse(w http.ResponseWriter) error
+}
+
+type GetStatus200JSONResponse Status
+
+func (response GetStatus200JSONResponse) VisitGetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatus500JSONResponse Error
+
+func (response GetStatus500JSONResponse) VisitGetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooksRequestObject struct {
+	Params ListWebhooksParams
+}
+
+type ListWebhooksResponseObject interface {
+	VisitListWebhooksResponse(w http.ResponseWriter) error
+}
+
+type ListWebhooks200JSONResponse WebhookPagination
+
+func (response ListWebhooks200JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks401Response struct {
+}
+
+func (response ListWebhooks401Response) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListWebhooks403JSONResponse Error403
+
+func (response ListWebhooks403JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks500JSONResponse Error
+
+func (response ListWebhooks500JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookRequestObject struct {
+	Body *CreateWebhookJSONRequestBody
+}
+
+type CreateWebhookResponseObject interface {
+	VisitCreateWebhookResponse(w http.ResponseWriter) error
+}
+
+type CreateWebhook201JSONResponse Webhook
+
+func (response CreateWebhook201JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook400JSONResponse Error
+
+func (response CreateWebhook400JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook401Response struct {
+}
+
+func (response CreateWebhook401Response) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateWebhook403JSONResponse Error403
+
+func (response CreateWebhook403JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook500JSONResponse Error
+
+func (response CreateWebhook500JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookRequestObject struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+type DeleteWebhookResponseObject interface {
+	VisitDeleteWebhookResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhook204Response struct {
+}
+
+func (response DeleteWebhook204Response) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWebhook401Response struct {
+}
+
+func (response DeleteWebhook401Response) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteWebhook403JSONResponse Error403
+
+func (response DeleteWebhook403JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook404JSONResponse Error
+
+func (response DeleteWebhook404JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook500JSONResponse Error
+
+func (response DeleteWebhook500JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookRequestObject struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+type GetWebhookResponseObject interface {
+	VisitGetWebhookResponse(w http.ResponseWriter) error
+}
+
+type GetWebhook200JSONResponse Webhook
+
+func (response GetWebhook200JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhook401Response struct {
+}
+
+func (response GetWebhook401Response) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetWebhook403JSONResponse Error403
+
+func (response GetWebhook403JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhook404JSONResponse Error
+
+func (response GetWebhook404JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhook500JSONResponse Error
+
+func (response GetWebhook500JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspacesRequestObject struct {
+	Params ListWorkspacesParams
+}
+
+type ListWorkspacesResponseObject interface {
+	VisitListWorkspacesResponse(w http.ResponseWriter) error
+}
+
+type ListWorkspaces200JSONResponse WorkspacePagination
+
+func (response ListWorkspaces200JSONResponse) VisitListWorkspacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspaces401Response struct {
+}
+
+func (response ListWorkspaces401Response) VisitListWorkspacesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListWorkspaces403JSONResponse Error403
+
+func (response ListWorkspaces403JSONResponse) VisitListWorkspacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspaces500JSONResponse Error
+
+func (response ListWorkspaces500JSONResponse) VisitListWorkspacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkspaceRequestObject struct {
+	Body *CreateWorkspaceJSONRequestBody
+}
+
+type CreateWorkspaceResponseObject interface {
+	VisitCreateWorkspaceResponse(w http.ResponseWriter) error
+}
+
+type CreateWorkspace201JSONResponse Workspace
+
+func (response CreateWorkspace201JSONResponse) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkspace400JSONResponse Error
+
+func (response CreateWorkspace400JSONResponse) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkspace401Response struct {
+}
+
+func (response CreateWorkspace401Response) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateWorkspace403JSONResponse Error403
+
+func (response CreateWorkspace403JSONResponse) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkspace500JSONResponse Error
+
+func (response CreateWorkspace500JSONResponse) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWorkspaceRequestObject struct {
+	Id WorkspaceId `json:"id"`
+}
+
+type DeleteWorkspaceResponseObject interface {
+	VisitDeleteWorkspaceResponse(w http.ResponseWriter) error
+}
+
+type DeleteWorkspace204Response struct {
+}
+
+func (response DeleteWorkspace204Response) VisitDeleteWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWorkspace401Response struct {
+}
+
+func (response DeleteWorkspace401Response) VisitDeleteWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteWorkspace403JSONResponse Error403
+
+func (response DeleteWorkspace403JSONResponse) VisitDeleteWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWorkspace404JSONResponse Error
+
+func (response DeleteWorkspace404JSONResponse) VisitDeleteWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWorkspace409JSONResponse Error
+
+func (response DeleteWorkspace409JSONResponse) VisitDeleteWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWorkspace500JSONResponse Error
+
+func (response DeleteWorkspace500JSONResponse) VisitDeleteWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkspaceRequestObject struct {
+	Id WorkspaceId `json:"id"`
+}
+
+type GetWorkspaceResponseObject interface {
+	VisitGetWorkspaceResponse(w http.ResponseWriter) error
+}
+
+type GetWorkspace200JSONResponse Workspace
+
+func (response GetWorkspace200JSONResponse) VisitGetWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkspace401Response struct {
+}
+
+func (response GetWorkspace401Response) VisitGetWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetWorkspace403JSONResponse Error403
+
+func (response GetWorkspace403JSONResponse) VisitGetWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkspace404JSONResponse Error
+
+func (response GetWorkspace404JSONResponse) VisitGetWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkspace500JSONResponse Error
+
+func (response GetWorkspace500JSONResponse) VisitGetWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkspaceRequestObject struct {
+	Id   WorkspaceId `json:"id"`
+	Body *UpdateWorkspaceJSONRequestBody
+}
+
+type UpdateWorkspaceResponseObject interface {
+	VisitUpdateWorkspaceResponse(w http.ResponseWriter) error
+}
+
+type UpdateWorkspace200JSONResponse Workspace
+
+func (response UpdateWorkspace200JSONResponse) VisitUpdateWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkspace400JSONResponse Error
+
+func (response UpdateWorkspace400JSONResponse) VisitUpdateWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkspace401Response struct {
+}
+
+func (response UpdateWorkspace401Response) VisitUpdateWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateWorkspace403JSONResponse Error403
+
+func (response UpdateWorkspace403JSONResponse) VisitUpdateWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkspace404JSONResponse Error
+
+func (response UpdateWorkspace404JSONResponse) VisitUpdateWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkspace500JSONResponse Error
+
+func (response UpdateWorkspace500JSONResponse) VisitUpdateWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspaceAncestorsRequestObject struct {
+	Id WorkspaceId `json:"id"`
+}
+
+type ListWorkspaceAncestorsResponseObject interface {
+	VisitListWorkspaceAncestorsResponse(w http.ResponseWriter) error
+}
+
+type ListWorkspaceAncestors200JSONResponse WorkspacePagination
+
+func (response ListWorkspaceAncestors200JSONResponse) VisitListWorkspaceAncestorsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspaceAncestors401Response struct {
+}
+
+func (response ListWorkspaceAncestors401Response) VisitListWorkspaceAncestorsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListWorkspaceAncestors403JSONResponse Error403
+
+func (response ListWorkspaceAncestors403JSONResponse) VisitListWorkspaceAncestorsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspaceAncestors404JSONResponse Error
+
+func (response ListWorkspaceAncestors404JSONResponse) VisitListWorkspaceAncestorsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspaceAncestors500JSONResponse Error
+
+func (response ListWorkspaceAncestors500JSONResponse) VisitListWorkspaceAncestorsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspaceChildrenRequestObject struct {
+	Id     WorkspaceId `json:"id"`
+	Params ListWorkspaceChildrenParams
+}
+
+type ListWorkspaceChildrenResponseObject interface {
+	VisitListWorkspaceChildrenResponse(w http.ResponseWriter) error
+}
+
+type ListWorkspaceChildren200JSONResponse WorkspacePagination
+
+func (response ListWorkspaceChildren200JSONResponse) VisitListWorkspaceChildrenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspaceChildren401Response struct {
+}
+
+func (response ListWorkspaceChildren401Response) VisitListWorkspaceChildrenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListWorkspaceChildren403JSONResponse Error403
+
+func (response ListWorkspaceChildren403JSONResponse) VisitListWorkspaceChildrenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspaceChildren404JSONResponse Error
+
+func (response ListWorkspaceChildren404JSONResponse) VisitListWorkspaceChildrenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspaceChildren500JSONResponse Error
+
+func (response ListWorkspaceChildren500JSONResponse) VisitListWorkspaceChildrenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
 	// Get the permitted access for a principal in the tenant (defaults to principal from the identity header)
 	// (GET /access/)
 	GetPrincipalAccess(ctx context.Context, request GetPrincipalAccessRequestObject) (GetPrincipalAccessResponseObject, error)
+	// Check whether a principal has a permission on a resource (defaults to principal from the identity header)
+	// (POST /access/check/)
+	CheckAccess(ctx context.Context, request CheckAccessRequestObject) (CheckAccessResponseObject, error)
+	// Check a principal's permissions on many resources at once (defaults to principal from the identity header)
+	// (POST /access/check/bulk/)
+	CheckAccessBulk(ctx context.Context, request CheckAccessBulkRequestObject) (CheckAccessBulkResponseObject, error)
+	// Explain why a principal has or lacks a permission on a resource (defaults to principal from the identity header)
+	// (GET /access/explain/)
+	ExplainAccess(ctx context.Context, request ExplainAccessRequestObject) (ExplainAccessResponseObject, error)
+	// List the resources of a type a principal has a permission on (defaults to principal from the identity header)
+	// (GET /access/resources/)
+	LookupAccessibleResources(ctx context.Context, request LookupAccessibleResourcesRequestObject) (LookupAccessibleResourcesResponseObject, error)
+	// List who has a permission on a resource in the tenant
+	// (GET /access/subjects/)
+	LookupAccessSubjects(ctx context.Context, request LookupAccessSubjectsRequestObject) (LookupAccessSubjectsResponseObject, error)
+	// List the tenant's audit log, newest first
+	// (GET /audit-log/)
+	ListAuditLog(ctx context.Context, request ListAuditLogRequestObject) (ListAuditLogResponseObject, error)
 	// List the cross account requests for a user or account
 	// (GET /cross-account-requests/)
 	ListCrossAccountRequests(ctx context.Context, request ListCrossAccountRequestsRequestObject) (ListCrossAccountRequestsResponseObject, error)
@@ -5372,6 +8589,9 @@ type StrictServerInterface interface {
 	// Update a cross account request
 	// (PUT /cross-account-requests/{uuid}/)
 	PutCrossAccountRequest(ctx context.Context, request PutCrossAccountRequestRequestObject) (PutCrossAccountRequestResponseObject, error)
+	// Export the tenant's roles, groups, principals, policies and workspaces
+	// (GET /export/)
+	ExportRbac(ctx context.Context, request ExportRbacRequestObject) (ExportRbacResponseObject, error)
 	// List the groups for a tenant
 	// (GET /groups/)
 	ListGroups(ctx context.Context, request ListGroupsRequestObject) (ListGroupsResponseObject, error)
@@ -5405,6 +8625,15 @@ type StrictServerInterface interface {
 	// Add a role to a group in the tenant
 	// (POST /groups/{uuid}/roles/)
 	AddRoleToGroup(ctx context.Context, request AddRoleToGroupRequestObject) (AddRoleToGroupResponseObject, error)
+	// Import roles, groups, principals and policies from an RBAC v1 export
+	// (POST /import/)
+	ImportRbacExport(ctx context.Context, request ImportRbacExportRequestObject) (ImportRbacExportResponseObject, error)
+	// Deprovision a tenant
+	// (DELETE /organizations/{org_id}/)
+	DeprovisionOrganization(ctx context.Context, request DeprovisionOrganizationRequestObject) (DeprovisionOrganizationResponseObject, error)
+	// Provision a tenant
+	// (PUT /organizations/{org_id}/)
+	ProvisionOrganization(ctx context.Context, request ProvisionOrganizationRequestObject) (ProvisionOrganizationResponseObject, error)
 	// List the permissions for a tenant
 	// (GET /permissions/)
 	ListPermissions(ctx context.Context, request ListPermissionsRequestObject) (ListPermissionsResponseObject, error)
@@ -5429,6 +8658,21 @@ type StrictServerInterface interface {
 	// List the principals for a tenant
 	// (GET /principals/)
 	ListPrincipals(ctx context.Context, request ListPrincipalsRequestObject) (ListPrincipalsResponseObject, error)
+	// Register a resource in a workspace
+	// (POST /resources/)
+	CreateResource(ctx context.Context, request CreateResourceRequestObject) (CreateResourceResponseObject, error)
+	// Register or move many resources at once
+	// (PUT /resources/)
+	UpsertResources(ctx context.Context, request UpsertResourcesRequestObject) (UpsertResourcesResponseObject, error)
+	// Unregister a resource
+	// (DELETE /resources/{namespace}/{name}/{id}/)
+	DeleteResource(ctx context.Context, request DeleteResourceRequestObject) (DeleteResourceResponseObject, error)
+	// Get the workspace a resource is registered in
+	// (GET /resources/{namespace}/{name}/{id}/)
+	GetResource(ctx context.Context, request GetResourceRequestObject) (GetResourceResponseObject, error)
+	// Move a resource to another workspace
+	// (PUT /resources/{namespace}/{name}/{id}/)
+	MoveResource(ctx context.Context, request MoveResourceRequestObject) (MoveResourceResponseObject, error)
 	// List the roles for a tenant
 	// (GET /roles/)
 	ListRoles(ctx context.Context, request ListRolesRequestObject) (ListRolesResponseObject, error)
@@ -5453,56 +8697,778 @@ type StrictServerInterface interface {
 	// Obtain server status
 	// (GET /status/)
 	GetStatus(ctx context.Context, request GetStatusRequestObject) (GetStatusResponseObject, error)
+	// List the webhooks registered by the tenant
+	// (GET /webhooks/)
+	ListWebhooks(ctx context.Context, request ListWebhooksRequestObject) (ListWebhooksResponseObject, error)
+	// Register a webhook for the tenant
+	// (POST /webhooks/)
+	CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error)
+	// Delete a webhook registered by the tenant
+	// (DELETE /webhooks/{uuid}/)
+	DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error)
+	// Get a webhook registered by the tenant
+	// (GET /webhooks/{uuid}/)
+	GetWebhook(ctx context.Context, request GetWebhookRequestObject) (GetWebhookResponseObject, error)
+	// List the workspaces in the tenant
+	// (GET /workspaces/)
+	ListWorkspaces(ctx context.Context, request ListWorkspacesRequestObject) (ListWorkspacesResponseObject, error)
+	// Create a workspace in the tenant
+	// (POST /workspaces/)
+	CreateWorkspace(ctx context.Context, request CreateWorkspaceRequestObject) (CreateWorkspaceResponseObject, error)
+	// Delete a workspace in the tenant
+	// (DELETE /workspaces/{id}/)
+	DeleteWorkspace(ctx context.Context, request DeleteWorkspaceRequestObject) (DeleteWorkspaceResponseObject, error)
+	// Get a workspace in the tenant
+	// (GET /workspaces/{id}/)
+	GetWorkspace(ctx context.Context, request GetWorkspaceRequestObject) (GetWorkspaceResponseObject, error)
+	// Rename or move a workspace in the tenant
+	// (PUT /workspaces/{id}/)
+	UpdateWorkspace(ctx context.Context, request UpdateWorkspaceRequestObject) (UpdateWorkspaceResponseObject, error)
+	// List the ancestors of a workspace, nearest first
+	// (GET /workspaces/{id}/ancestors/)
+	ListWorkspaceAncestors(ctx context.Context, request ListWorkspaceAncestorsRequestObject) (ListWorkspaceAncestorsResponseObject, error)
+	// List the workspaces directly beneath a workspace
+	// (GET /workspaces/{id}/children/)
+	ListWorkspaceChildren(ctx context.Context, request ListWorkspaceChildrenRequestObject) (ListWorkspaceChildrenResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHttpHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHttpMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetPrincipalAccess operation middleware
+func (sh *strictHandler) GetPrincipalAccess(w http.ResponseWriter, r *http.Request, params GetPrincipalAccessParams) {
+	var request GetPrincipalAccessRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPrincipalAccess(ctx, request.(GetPrincipalAccessRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPrincipalAccess")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPrincipalAccessResponseObject); ok {
+		if err := validResponse.VisitGetPrincipalAccessResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CheckAccess operation middleware
+func (sh *strictHandler) CheckAccess(w http.ResponseWriter, r *http.Request) {
+	var request CheckAccessRequestObject
+
+	var body CheckAccessJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CheckAccess(ctx, request.(CheckAccessRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CheckAccess")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CheckAccessResponseObject); ok {
+		if err := validResponse.VisitCheckAccessResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CheckAccessBulk operation middleware
+func (sh *strictHandler) CheckAccessBulk(w http.ResponseWriter, r *http.Request) {
+	var request CheckAccessBulkRequestObject
+
+	var body CheckAccessBulkJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CheckAccessBulk(ctx, request.(CheckAccessBulkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CheckAccessBulk")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CheckAccessBulkResponseObject); ok {
+		if err := validResponse.VisitCheckAccessBulkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExplainAccess operation middleware
+func (sh *strictHandler) ExplainAccess(w http.ResponseWriter, r *http.Request, params ExplainAccessParams) {
+	var request ExplainAccessRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExplainAccess(ctx, request.(ExplainAccessRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExplainAccess")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExplainAccessResponseObject); ok {
+		if err := validResponse.VisitExplainAccessResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// LookupAccessibleResources operation middleware
+func (sh *strictHandler) LookupAccessibleResources(w http.ResponseWriter, r *http.Request, params LookupAccessibleResourcesParams) {
+	var request LookupAccessibleResourcesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.LookupAccessibleResources(ctx, request.(LookupAccessibleResourcesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LookupAccessibleResources")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LookupAccessibleResourcesResponseObject); ok {
+		if err := validResponse.VisitLookupAccessibleResourcesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// LookupAccessSubjects operation middleware
+func (sh *strictHandler) LookupAccessSubjects(w http.ResponseWriter, r *http.Request, params LookupAccessSubjectsParams) {
+	var request LookupAccessSubjectsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.LookupAccessSubjects(ctx, request.(LookupAccessSubjectsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LookupAccessSubjects")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LookupAccessSubjectsResponseObject); ok {
+		if err := validResponse.VisitLookupAccessSubjectsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAuditLog operation middleware
+func (sh *strictHandler) ListAuditLog(w http.ResponseWriter, r *http.Request, params ListAuditLogParams) {
+	var request ListAuditLogRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAuditLog(ctx, request.(ListAuditLogRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAuditLog")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAuditLogResponseObject); ok {
+		if err := validResponse.VisitListAuditLogResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListCrossAccountRequests operation middleware
+func (sh *strictHandler) ListCrossAccountRequests(w http.ResponseWriter, r *http.Request, params ListCrossAccountRequestsParams) {
+	var request ListCrossAccountRequestsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCrossAccountRequests(ctx, request.(ListCrossAccountRequestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCrossAccountRequests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCrossAccountRequestsResponseObject); ok {
+		if err := validResponse.VisitListCrossAccountRequestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateCrossAccountRequests operation middleware
+func (sh *strictHandler) CreateCrossAccountRequests(w http.ResponseWriter, r *http.Request) {
+	var request CreateCrossAccountRequestsRequestObject
+
+	var body CreateCrossAccountRequestsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCrossAccountRequests(ctx, request.(CreateCrossAccountRequestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCrossAccountRequests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCrossAccountRequestsResponseObject); ok {
+		if err := validResponse.VisitCreateCrossAccountRequestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCrossAccountRequest operation middleware
+func (sh *strictHandler) GetCrossAccountRequest(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params GetCrossAccountRequestParams) {
+	var request GetCrossAccountRequestRequestObject
+
+	request.Uuid = uuid
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCrossAccountRequest(ctx, request.(GetCrossAccountRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCrossAccountRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCrossAccountRequestResponseObject); ok {
+		if err := validResponse.VisitGetCrossAccountRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchCrossAccountRequest operation middleware
+func (sh *strictHandler) PatchCrossAccountRequest(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request PatchCrossAccountRequestRequestObject
+
+	request.Uuid = uuid
+
+	var body PatchCrossAccountRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchCrossAccountRequest(ctx, request.(PatchCrossAccountRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchCrossAccountRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchCrossAccountRequestResponseObject); ok {
+		if err := validResponse.VisitPatchCrossAccountRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutCrossAccountRequest operation middleware
+func (sh *strictHandler) PutCrossAccountRequest(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request PutCrossAccountRequestRequestObject
+
+	request.Uuid = uuid
+
+	var body PutCrossAccountRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutCrossAccountRequest(ctx, request.(PutCrossAccountRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutCrossAccountRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutCrossAccountRequestResponseObject); ok {
+		if err := validResponse.VisitPutCrossAccountRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExportRbac operation middleware
+func (sh *strictHandler) ExportRbac(w http.ResponseWriter, r *http.Request) {
+	var request ExportRbacRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportRbac(ctx, request.(ExportRbacRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportRbac")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportRbacResponseObject); ok {
+		if err := validResponse.VisitExportRbacResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListGroups operation middleware
+func (sh *strictHandler) ListGroups(w http.ResponseWriter, r *http.Request, params ListGroupsParams) {
+	var request ListGroupsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListGroups(ctx, request.(ListGroupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListGroups")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListGroupsResponseObject); ok {
+		if err := validResponse.VisitListGroupsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateGroup operation middleware
+func (sh *strictHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
+	var request CreateGroupRequestObject
+
+	var body CreateGroupJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateGroup(ctx, request.(CreateGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateGroupResponseObject); ok {
+		if err := validResponse.VisitCreateGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteGroup operation middleware
+func (sh *strictHandler) DeleteGroup(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request DeleteGroupRequestObject
+
+	request.Uuid = uuid
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteGroup(ctx, request.(DeleteGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteGroupResponseObject); ok {
+		if err := validResponse.VisitDeleteGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHttpHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHttpMiddlewareFunc
+// GetGroup operation middleware
+func (sh *strictHandler) GetGroup(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request GetGroupRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	request.Uuid = uuid
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGroup(ctx, request.(GetGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetGroupResponseObject); ok {
+		if err := validResponse.VisitGetGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+// UpdateGroup operation middleware
+func (sh *strictHandler) UpdateGroup(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request UpdateGroupRequestObject
+
+	request.Uuid = uuid
+
+	var body UpdateGroupJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateGroup(ctx, request.(UpdateGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateGroupResponseObject); ok {
+		if err := validResponse.VisitUpdateGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// DeletePrincipalFromGroup operation middleware
+func (sh *strictHandler) DeletePrincipalFromGroup(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params DeletePrincipalFromGroupParams) {
+	var request DeletePrincipalFromGroupRequestObject
+
+	request.Uuid = uuid
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePrincipalFromGroup(ctx, request.(DeletePrincipalFromGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePrincipalFromGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeletePrincipalFromGroupResponseObject); ok {
+		if err := validResponse.VisitDeletePrincipalFromGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// GetPrincipalsFromGroup operation middleware
+func (sh *strictHandler) GetPrincipalsFromGroup(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params GetPrincipalsFromGroupParams) {
+	var request GetPrincipalsFromGroupRequestObject
+
+	request.Uuid = uuid
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPrincipalsFromGroup(ctx, request.(GetPrincipalsFromGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPrincipalsFromGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPrincipalsFromGroupResponseObject); ok {
+		if err := validResponse.VisitGetPrincipalsFromGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddPrincipalToGroup operation middleware
+func (sh *strictHandler) AddPrincipalToGroup(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request AddPrincipalToGroupRequestObject
+
+	request.Uuid = uuid
+
+	var body AddPrincipalToGroupJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddPrincipalToGroup(ctx, request.(AddPrincipalToGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddPrincipalToGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddPrincipalToGroupResponseObject); ok {
+		if err := validResponse.VisitAddPrincipalToGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteRoleFromGroup operation middleware
+func (sh *strictHandler) DeleteRoleFromGroup(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params DeleteRoleFromGroupParams) {
+	var request DeleteRoleFromGroupRequestObject
+
+	request.Uuid = uuid
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteRoleFromGroup(ctx, request.(DeleteRoleFromGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteRoleFromGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteRoleFromGroupResponseObject); ok {
+		if err := validResponse.VisitDeleteRoleFromGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListRolesForGroup operation middleware
+func (sh *strictHandler) ListRolesForGroup(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params ListRolesForGroupParams) {
+	var request ListRolesForGroupRequestObject
+
+	request.Uuid = uuid
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRolesForGroup(ctx, request.(ListRolesForGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRolesForGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListRolesForGroupResponseObject); ok {
+		if err := validResponse.VisitListRolesForGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddRoleToGroup operation middleware
+func (sh *strictHandler) AddRoleToGroup(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request AddRoleToGroupRequestObject
+
+	request.Uuid = uuid
+
+	var body AddRoleToGroupJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddRoleToGroup(ctx, request.(AddRoleToGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddRoleToGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddRoleToGroupResponseObject); ok {
+		if err := validResponse.VisitAddRoleToGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetPrincipalAccess operation middleware
-func (sh *strictHandler) GetPrincipalAccess(w http.ResponseWriter, r *http.Request, params GetPrincipalAccessParams) {
-	var request GetPrincipalAccessRequestObject
+// ImportRbacExport operation middleware
+func (sh *strictHandler) ImportRbacExport(w http.ResponseWriter, r *http.Request, params ImportRbacExportParams) {
+	var request ImportRbacExportRequestObject
 
 	request.Params = params
 
+	var body ImportRbacExportJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPrincipalAccess(ctx, request.(GetPrincipalAccessRequestObject))
+		return sh.ssi.ImportRbacExport(ctx, request.(ImportRbacExportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPrincipalAccess")
+		handler = middleware(handler, "ImportRbacExport")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPrincipalAccessResponseObject); ok {
-		if err := validResponse.VisitGetPrincipalAccessResponse(w); err != nil {
+	} else if validResponse, ok := response.(ImportRbacExportResponseObject); ok {
+		if err := validResponse.VisitImportRbacExportResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5510,25 +9476,25 @@ func (sh *strictHandler) GetPrincipalAccess(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// ListCrossAccountRequests operation middleware
-func (sh *strictHandler) ListCrossAccountRequests(w http.ResponseWriter, r *http.Request, params ListCrossAccountRequestsParams) {
-	var request ListCrossAccountRequestsRequestObject
+// DeprovisionOrganization operation middleware
+func (sh *strictHandler) DeprovisionOrganization(w http.ResponseWriter, r *http.Request, orgId OrgId) {
+	var request DeprovisionOrganizationRequestObject
 
-	request.Params = params
+	request.OrgId = orgId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListCrossAccountRequests(ctx, request.(ListCrossAccountRequestsRequestObject))
+		return sh.ssi.DeprovisionOrganization(ctx, request.(DeprovisionOrganizationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListCrossAccountRequests")
+		handler = middleware(handler, "DeprovisionOrganization")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListCrossAccountRequestsResponseObject); ok {
-		if err := validResponse.VisitListCrossAccountRequestsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeprovisionOrganizationResponseObject); ok {
+		if err := validResponse.VisitDeprovisionOrganizationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5536,11 +9502,13 @@ func (sh *strictHandler) ListCrossAccountRequests(w http.ResponseWriter, r *http
 	}
 }
 
-// CreateCrossAccountRequests operation middleware
-func (sh *strictHandler) CreateCrossAccountRequests(w http.ResponseWriter, r *http.Request) {
-	var request CreateCrossAccountRequestsRequestObject
+// ProvisionOrganization operation middleware
+func (sh *strictHandler) ProvisionOrganization(w http.ResponseWriter, r *http.Request, orgId OrgId) {
+	var request ProvisionOrganizationRequestObject
 
-	var body CreateCrossAccountRequestsJSONRequestBody
+	request.OrgId = orgId
+
+	var body ProvisionOrganizationJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5548,18 +9516,18 @@ func (sh *strictHandler) CreateCrossAccountRequests(w http.ResponseWriter, r *ht
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateCrossAccountRequests(ctx, request.(CreateCrossAccountRequestsRequestObject))
+		return sh.ssi.ProvisionOrganization(ctx, request.(ProvisionOrganizationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateCrossAccountRequests")
+		handler = middleware(handler, "ProvisionOrganization")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateCrossAccountRequestsResponseObject); ok {
-		if err := validResponse.VisitCreateCrossAccountRequestsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ProvisionOrganizationResponseObject); ok {
+		if err := validResponse.VisitProvisionOrganizationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5567,26 +9535,25 @@ func (sh *strictHandler) CreateCrossAccountRequests(w http.ResponseWriter, r *ht
 	}
 }
 
-// GetCrossAccountRequest operation middleware
-func (sh *strictHandler) GetCrossAccountRequest(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params GetCrossAccountRequestParams) {
-	var request GetCrossAccountRequestRequestObject
+// ListPermissions operation middleware
+func (sh *strictHandler) ListPermissions(w http.ResponseWriter, r *http.Request, params ListPermissionsParams) {
+	var request ListPermissionsRequestObject
 
-	request.Uuid = uuid
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCrossAccountRequest(ctx, request.(GetCrossAccountRequestRequestObject))
+		return sh.ssi.ListPermissions(ctx, request.(ListPermissionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCrossAccountRequest")
+		handler = middleware(handler, "ListPermissions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCrossAccountRequestResponseObject); ok {
-		if err := validResponse.VisitGetCrossAccountRequestResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListPermissionsResponseObject); ok {
+		if err := validResponse.VisitListPermissionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5594,32 +9561,51 @@ func (sh *strictHandler) GetCrossAccountRequest(w http.ResponseWriter, r *http.R
 	}
 }
 
-// PatchCrossAccountRequest operation middleware
-func (sh *strictHandler) PatchCrossAccountRequest(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
-	var request PatchCrossAccountRequestRequestObject
+// ListPermissionOptions operation middleware
+func (sh *strictHandler) ListPermissionOptions(w http.ResponseWriter, r *http.Request, params ListPermissionOptionsParams) {
+	var request ListPermissionOptionsRequestObject
 
-	request.Uuid = uuid
+	request.Params = params
 
-	var body PatchCrossAccountRequestJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPermissionOptions(ctx, request.(ListPermissionOptionsRequestObject))
 	}
-	request.Body = &body
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPermissionOptions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPermissionOptionsResponseObject); ok {
+		if err := validResponse.VisitListPermissionOptionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListPolicies operation middleware
+func (sh *strictHandler) ListPolicies(w http.ResponseWriter, r *http.Request, params ListPoliciesParams) {
+	var request ListPoliciesRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchCrossAccountRequest(ctx, request.(PatchCrossAccountRequestRequestObject))
+		return sh.ssi.ListPolicies(ctx, request.(ListPoliciesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchCrossAccountRequest")
+		handler = middleware(handler, "ListPolicies")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchCrossAccountRequestResponseObject); ok {
-		if err := validResponse.VisitPatchCrossAccountRequestResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListPoliciesResponseObject); ok {
+		if err := validResponse.VisitListPoliciesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5627,13 +9613,11 @@ func (sh *strictHandler) PatchCrossAccountRequest(w http.ResponseWriter, r *http
 	}
 }
 
-// PutCrossAccountRequest operation middleware
-func (sh *strictHandler) PutCrossAccountRequest(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
-	var request PutCrossAccountRequestRequestObject
-
-	request.Uuid = uuid
+// CreatePolicies operation middleware
+func (sh *strictHandler) CreatePolicies(w http.ResponseWriter, r *http.Request) {
+	var request CreatePoliciesRequestObject
 
-	var body PutCrossAccountRequestJSONRequestBody
+	var body CreatePoliciesJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5641,18 +9625,18 @@ func (sh *strictHandler) PutCrossAccountRequest(w http.ResponseWriter, r *http.R
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PutCrossAccountRequest(ctx, request.(PutCrossAccountRequestRequestObject))
+		return sh.ssi.CreatePolicies(ctx, request.(CreatePoliciesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutCrossAccountRequest")
+		handler = middleware(handler, "CreatePolicies")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PutCrossAccountRequestResponseObject); ok {
-		if err := validResponse.VisitPutCrossAccountRequestResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreatePoliciesResponseObject); ok {
+		if err := validResponse.VisitCreatePoliciesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5660,25 +9644,25 @@ func (sh *strictHandler) PutCrossAccountRequest(w http.ResponseWriter, r *http.R
 	}
 }
 
-// ListGroups operation middleware
-func (sh *strictHandler) ListGroups(w http.ResponseWriter, r *http.Request, params ListGroupsParams) {
-	var request ListGroupsRequestObject
+// DeletePolicy operation middleware
+func (sh *strictHandler) DeletePolicy(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request DeletePolicyRequestObject
 
-	request.Params = params
+	request.Uuid = uuid
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListGroups(ctx, request.(ListGroupsRequestObject))
+		return sh.ssi.DeletePolicy(ctx, request.(DeletePolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListGroups")
+		handler = middleware(handler, "DeletePolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListGroupsResponseObject); ok {
-		if err := validResponse.VisitListGroupsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeletePolicyResponseObject); ok {
+		if err := validResponse.VisitDeletePolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5686,30 +9670,25 @@ func (sh *strictHandler) ListGroups(w http.ResponseWriter, r *http.Request, para
 	}
 }
 
-// CreateGroup operation middleware
-func (sh *strictHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
-	var request CreateGroupRequestObject
+// GetPolicy operation middleware
+func (sh *strictHandler) GetPolicy(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request GetPolicyRequestObject
 
-	var body CreateGroupJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Uuid = uuid
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateGroup(ctx, request.(CreateGroupRequestObject))
+		return sh.ssi.GetPolicy(ctx, request.(GetPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateGroup")
+		handler = middleware(handler, "GetPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateGroupResponseObject); ok {
-		if err := validResponse.VisitCreateGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPolicyResponseObject); ok {
+		if err := validResponse.VisitGetPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5717,25 +9696,32 @@ func (sh *strictHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteGroup operation middleware
-func (sh *strictHandler) DeleteGroup(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
-	var request DeleteGroupRequestObject
+// UpdatePolicy operation middleware
+func (sh *strictHandler) UpdatePolicy(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request UpdatePolicyRequestObject
 
 	request.Uuid = uuid
 
+	var body UpdatePolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteGroup(ctx, request.(DeleteGroupRequestObject))
+		return sh.ssi.UpdatePolicy(ctx, request.(UpdatePolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteGroup")
+		handler = middleware(handler, "UpdatePolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteGroupResponseObject); ok {
-		if err := validResponse.VisitDeleteGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdatePolicyResponseObject); ok {
+		if err := validResponse.VisitUpdatePolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5743,25 +9729,25 @@ func (sh *strictHandler) DeleteGroup(w http.ResponseWriter, r *http.Request, uui
 	}
 }
 
-// GetGroup operation middleware
-func (sh *strictHandler) GetGroup(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
-	var request GetGroupRequestObject
+// ListPrincipals operation middleware
+func (sh *strictHandler) ListPrincipals(w http.ResponseWriter, r *http.Request, params ListPrincipalsParams) {
+	var request ListPrincipalsRequestObject
 
-	request.Uuid = uuid
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetGroup(ctx, request.(GetGroupRequestObject))
+		return sh.ssi.ListPrincipals(ctx, request.(ListPrincipalsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetGroup")
+		handler = middleware(handler, "ListPrincipals")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetGroupResponseObject); ok {
-		if err := validResponse.VisitGetGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListPrincipalsResponseObject); ok {
+		if err := validResponse.VisitListPrincipalsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5769,13 +9755,11 @@ func (sh *strictHandler) GetGroup(w http.ResponseWriter, r *http.Request, uuid o
 	}
 }
 
-// UpdateGroup operation middleware
-func (sh *strictHandler) UpdateGroup(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
-	var request UpdateGroupRequestObject
-
-	request.Uuid = uuid
+// CreateResource operation middleware
+func (sh *strictHandler) CreateResource(w http.ResponseWriter, r *http.Request) {
+	var request CreateResourceRequestObject
 
-	var body UpdateGroupJSONRequestBody
+	var body CreateResourceJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5783,18 +9767,18 @@ func (sh *strictHandler) UpdateGroup(w http.ResponseWriter, r *http.Request, uui
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateGroup(ctx, request.(UpdateGroupRequestObject))
+		return sh.ssi.CreateResource(ctx, request.(CreateResourceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateGroup")
+		handler = middleware(handler, "CreateResource")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateGroupResponseObject); ok {
-		if err := validResponse.VisitUpdateGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateResourceResponseObject); ok {
+		if err := validResponse.VisitCreateResourceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5802,26 +9786,30 @@ func (sh *strictHandler) UpdateGroup(w http.ResponseWriter, r *http.Request, uui
 	}
 }
 
-// DeletePrincipalFromGroup operation middleware
-func (sh *strictHandler) DeletePrincipalFromGroup(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params DeletePrincipalFromGroupParams) {
-	var request DeletePrincipalFromGroupRequestObject
+// UpsertResources operation middleware
+func (sh *strictHandler) UpsertResources(w http.ResponseWriter, r *http.Request) {
+	var request UpsertResourcesRequestObject
 
-	request.Uuid = uuid
-	request.Params = params
+	var body UpsertResourcesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeletePrincipalFromGroup(ctx, request.(DeletePrincipalFromGroupRequestObject))
+		return sh.ssi.UpsertResources(ctx, request.(UpsertResourcesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeletePrincipalFromGroup")
+		handler = middleware(handler, "UpsertResources")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeletePrincipalFromGroupResponseObject); ok {
-		if err := validResponse.VisitDeletePrincipalFromGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpsertResourcesResponseObject); ok {
+		if err := validResponse.VisitUpsertResourcesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5829,26 +9817,27 @@ func (sh *strictHandler) DeletePrincipalFromGroup(w http.ResponseWriter, r *http
 	}
 }
 
-// GetPrincipalsFromGroup operation middleware
-func (sh *strictHandler) GetPrincipalsFromGroup(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params GetPrincipalsFromGroupParams) {
-	var request GetPrincipalsFromGroupRequestObject
+// DeleteResource operation middleware
+func (sh *strictHandler) DeleteResource(w http.ResponseWriter, r *http.Request, namespace ResourceNamespace, name ResourceName, id ResourceId) {
+	var request DeleteResourceRequestObject
 
-	request.Uuid = uuid
-	request.Params = params
+	request.Namespace = namespace
+	request.Name = name
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPrincipalsFromGroup(ctx, request.(GetPrincipalsFromGroupRequestObject))
+		return sh.ssi.DeleteResource(ctx, request.(DeleteResourceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPrincipalsFromGroup")
+		handler = middleware(handler, "DeleteResource")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPrincipalsFromGroupResponseObject); ok {
-		if err := validResponse.VisitGetPrincipalsFromGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteResourceResponseObject); ok {
+		if err := validResponse.VisitDeleteResourceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5856,32 +9845,27 @@ func (sh *strictHandler) GetPrincipalsFromGroup(w http.ResponseWriter, r *http.R
 	}
 }
 
-// AddPrincipalToGroup operation middleware
-func (sh *strictHandler) AddPrincipalToGroup(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
-	var request AddPrincipalToGroupRequestObject
-
-	request.Uuid = uuid
+// GetResource operation middleware
+func (sh *strictHandler) GetResource(w http.ResponseWriter, r *http.Request, namespace ResourceNamespace, name ResourceName, id ResourceId) {
+	var request GetResourceRequestObject
 
-	var body AddPrincipalToGroupJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Namespace = namespace
+	request.Name = name
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AddPrincipalToGroup(ctx, request.(AddPrincipalToGroupRequestObject))
+		return sh.ssi.GetResource(ctx, request.(GetResourceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddPrincipalToGroup")
+		handler = middleware(handler, "GetResource")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AddPrincipalToGroupResponseObject); ok {
-		if err := validResponse.VisitAddPrincipalToGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetResourceResponseObject); ok {
+		if err := validResponse.VisitGetResourceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5889,26 +9873,34 @@ func (sh *strictHandler) AddPrincipalToGroup(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// DeleteRoleFromGroup operation middleware
-func (sh *strictHandler) DeleteRoleFromGroup(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params DeleteRoleFromGroupParams) {
-	var request DeleteRoleFromGroupRequestObject
+// MoveResource operation middleware
+func (sh *strictHandler) MoveResource(w http.ResponseWriter, r *http.Request, namespace ResourceNamespace, name ResourceName, id ResourceId) {
+	var request MoveResourceRequestObject
 
-	request.Uuid = uuid
-	request.Params = params
+	request.Namespace = namespace
+	request.Name = name
+	request.Id = id
+
+	var body MoveResourceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteRoleFromGroup(ctx, request.(DeleteRoleFromGroupRequestObject))
+		return sh.ssi.MoveResource(ctx, request.(MoveResourceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteRoleFromGroup")
+		handler = middleware(handler, "MoveResource")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteRoleFromGroupResponseObject); ok {
-		if err := validResponse.VisitDeleteRoleFromGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(MoveResourceResponseObject); ok {
+		if err := validResponse.VisitMoveResourceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5916,26 +9908,25 @@ func (sh *strictHandler) DeleteRoleFromGroup(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// ListRolesForGroup operation middleware
-func (sh *strictHandler) ListRolesForGroup(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params ListRolesForGroupParams) {
-	var request ListRolesForGroupRequestObject
+// ListRoles operation middleware
+func (sh *strictHandler) ListRoles(w http.ResponseWriter, r *http.Request, params ListRolesParams) {
+	var request ListRolesRequestObject
 
-	request.Uuid = uuid
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListRolesForGroup(ctx, request.(ListRolesForGroupRequestObject))
+		return sh.ssi.ListRoles(ctx, request.(ListRolesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListRolesForGroup")
+		handler = middleware(handler, "ListRoles")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListRolesForGroupResponseObject); ok {
-		if err := validResponse.VisitListRolesForGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListRolesResponseObject); ok {
+		if err := validResponse.VisitListRolesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5943,13 +9934,11 @@ func (sh *strictHandler) ListRolesForGroup(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// AddRoleToGroup operation middleware
-func (sh *strictHandler) AddRoleToGroup(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
-	var request AddRoleToGroupRequestObject
-
-	request.Uuid = uuid
+// CreateRole operation middleware
+func (sh *strictHandler) CreateRole(w http.ResponseWriter, r *http.Request) {
+	var request CreateRoleRequestObject
 
-	var body AddRoleToGroupJSONRequestBody
+	var body CreateRoleJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5957,18 +9946,18 @@ func (sh *strictHandler) AddRoleToGroup(w http.ResponseWriter, r *http.Request,
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AddRoleToGroup(ctx, request.(AddRoleToGroupRequestObject))
+		return sh.ssi.CreateRole(ctx, request.(CreateRoleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddRoleToGroup")
+		handler = middleware(handler, "CreateRole")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AddRoleToGroupResponseObject); ok {
-		if err := validResponse.VisitAddRoleToGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateRoleResponseObject); ok {
+		if err := validResponse.VisitCreateRoleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5976,25 +9965,25 @@ func (sh *strictHandler) AddRoleToGroup(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// ListPermissions operation middleware
-func (sh *strictHandler) ListPermissions(w http.ResponseWriter, r *http.Request, params ListPermissionsParams) {
-	var request ListPermissionsRequestObject
+// DeleteRole operation middleware
+func (sh *strictHandler) DeleteRole(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request DeleteRoleRequestObject
 
-	request.Params = params
+	request.Uuid = uuid
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListPermissions(ctx, request.(ListPermissionsRequestObject))
+		return sh.ssi.DeleteRole(ctx, request.(DeleteRoleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListPermissions")
+		handler = middleware(handler, "DeleteRole")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListPermissionsResponseObject); ok {
-		if err := validResponse.VisitListPermissionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteRoleResponseObject); ok {
+		if err := validResponse.VisitDeleteRoleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6002,25 +9991,26 @@ func (sh *strictHandler) ListPermissions(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// ListPermissionOptions operation middleware
-func (sh *strictHandler) ListPermissionOptions(w http.ResponseWriter, r *http.Request, params ListPermissionOptionsParams) {
-	var request ListPermissionOptionsRequestObject
+// GetRole operation middleware
+func (sh *strictHandler) GetRole(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params GetRoleParams) {
+	var request GetRoleRequestObject
 
+	request.Uuid = uuid
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListPermissionOptions(ctx, request.(ListPermissionOptionsRequestObject))
+		return sh.ssi.GetRole(ctx, request.(GetRoleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListPermissionOptions")
+		handler = middleware(handler, "GetRole")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListPermissionOptionsResponseObject); ok {
-		if err := validResponse.VisitListPermissionOptionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRoleResponseObject); ok {
+		if err := validResponse.VisitGetRoleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6028,25 +10018,32 @@ func (sh *strictHandler) ListPermissionOptions(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// ListPolicies operation middleware
-func (sh *strictHandler) ListPolicies(w http.ResponseWriter, r *http.Request, params ListPoliciesParams) {
-	var request ListPoliciesRequestObject
+// PatchRole operation middleware
+func (sh *strictHandler) PatchRole(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request PatchRoleRequestObject
 
-	request.Params = params
+	request.Uuid = uuid
+
+	var body PatchRoleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListPolicies(ctx, request.(ListPoliciesRequestObject))
+		return sh.ssi.PatchRole(ctx, request.(PatchRoleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListPolicies")
+		handler = middleware(handler, "PatchRole")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListPoliciesResponseObject); ok {
-		if err := validResponse.VisitListPoliciesResponse(w); err != nil {
+	} else if validResponse, ok := response.(PatchRoleResponseObject); ok {
+		if err := validResponse.VisitPatchRoleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6054,11 +10051,13 @@ func (sh *strictHandler) ListPolicies(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// CreatePolicies operation middleware
-func (sh *strictHandler) CreatePolicies(w http.ResponseWriter, r *http.Request) {
-	var request CreatePoliciesRequestObject
+// UpdateRole operation middleware
+func (sh *strictHandler) UpdateRole(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request UpdateRoleRequestObject
 
-	var body CreatePoliciesJSONRequestBody
+	request.Uuid = uuid
+
+	var body UpdateRoleJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6066,18 +10065,18 @@ func (sh *strictHandler) CreatePolicies(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreatePolicies(ctx, request.(CreatePoliciesRequestObject))
+		return sh.ssi.UpdateRole(ctx, request.(UpdateRoleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreatePolicies")
+		handler = middleware(handler, "UpdateRole")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreatePoliciesResponseObject); ok {
-		if err := validResponse.VisitCreatePoliciesResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateRoleResponseObject); ok {
+		if err := validResponse.VisitUpdateRoleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6085,25 +10084,26 @@ func (sh *strictHandler) CreatePolicies(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// DeletePolicy operation middleware
-func (sh *strictHandler) DeletePolicy(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
-	var request DeletePolicyRequestObject
+// GetRoleAccess operation middleware
+func (sh *strictHandler) GetRoleAccess(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params GetRoleAccessParams) {
+	var request GetRoleAccessRequestObject
 
 	request.Uuid = uuid
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeletePolicy(ctx, request.(DeletePolicyRequestObject))
+		return sh.ssi.GetRoleAccess(ctx, request.(GetRoleAccessRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeletePolicy")
+		handler = middleware(handler, "GetRoleAccess")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeletePolicyResponseObject); ok {
-		if err := validResponse.VisitDeletePolicyResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRoleAccessResponseObject); ok {
+		if err := validResponse.VisitGetRoleAccessResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6111,25 +10111,23 @@ func (sh *strictHandler) DeletePolicy(w http.ResponseWriter, r *http.Request, uu
 	}
 }
 
-// GetPolicy operation middleware
-func (sh *strictHandler) GetPolicy(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
-	var request GetPolicyRequestObject
-
-	request.Uuid = uuid
+// GetStatus operation middleware
+func (sh *strictHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
+	var request GetStatusRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPolicy(ctx, request.(GetPolicyRequestObject))
+		return sh.ssi.GetStatus(ctx, request.(GetStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPolicy")
+		handler = middleware(handler, "GetStatus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPolicyResponseObject); ok {
-		if err := validResponse.VisitGetPolicyResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStatusResponseObject); ok {
+		if err := validResponse.VisitGetStatusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6137,13 +10135,37 @@ func (sh *strictHandler) GetPolicy(w http.ResponseWriter, r *http.Request, uuid
 	}
 }
 
-// UpdatePolicy operation middleware
-func (sh *strictHandler) UpdatePolicy(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
-	var request UpdatePolicyRequestObject
+// ListWebhooks operation middleware
+func (sh *strictHandler) ListWebhooks(w http.ResponseWriter, r *http.Request, params ListWebhooksParams) {
+	var request ListWebhooksRequestObject
 
-	request.Uuid = uuid
+	request.Params = params
 
-	var body UpdatePolicyJSONRequestBody
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWebhooks(ctx, request.(ListWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWebhooks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWebhooksResponseObject); ok {
+		if err := validResponse.VisitListWebhooksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateWebhook operation middleware
+func (sh *strictHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	var request CreateWebhookRequestObject
+
+	var body CreateWebhookJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6151,18 +10173,18 @@ func (sh *strictHandler) UpdatePolicy(w http.ResponseWriter, r *http.Request, uu
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdatePolicy(ctx, request.(UpdatePolicyRequestObject))
+		return sh.ssi.CreateWebhook(ctx, request.(CreateWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdatePolicy")
+		handler = middleware(handler, "CreateWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdatePolicyResponseObject); ok {
-		if err := validResponse.VisitUpdatePolicyResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateWebhookResponseObject); ok {
+		if err := validResponse.VisitCreateWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6170,25 +10192,25 @@ func (sh *strictHandler) UpdatePolicy(w http.ResponseWriter, r *http.Request, uu
 	}
 }
 
-// ListPrincipals operation middleware
-func (sh *strictHandler) ListPrincipals(w http.ResponseWriter, r *http.Request, params ListPrincipalsParams) {
-	var request ListPrincipalsRequestObject
+// DeleteWebhook operation middleware
+func (sh *strictHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request DeleteWebhookRequestObject
 
-	request.Params = params
+	request.Uuid = uuid
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListPrincipals(ctx, request.(ListPrincipalsRequestObject))
+		return sh.ssi.DeleteWebhook(ctx, request.(DeleteWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListPrincipals")
+		handler = middleware(handler, "DeleteWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListPrincipalsResponseObject); ok {
-		if err := validResponse.VisitListPrincipalsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteWebhookResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6196,25 +10218,25 @@ func (sh *strictHandler) ListPrincipals(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// ListRoles operation middleware
-func (sh *strictHandler) ListRoles(w http.ResponseWriter, r *http.Request, params ListRolesParams) {
-	var request ListRolesRequestObject
+// GetWebhook operation middleware
+func (sh *strictHandler) GetWebhook(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request GetWebhookRequestObject
 
-	request.Params = params
+	request.Uuid = uuid
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListRoles(ctx, request.(ListRolesRequestObject))
+		return sh.ssi.GetWebhook(ctx, request.(GetWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListRoles")
+		handler = middleware(handler, "GetWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListRolesResponseObject); ok {
-		if err := validResponse.VisitListRolesResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWebhookResponseObject); ok {
+		if err := validResponse.VisitGetWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6222,30 +10244,25 @@ func (sh *strictHandler) ListRoles(w http.ResponseWriter, r *http.Request, param
 	}
 }
 
-// CreateRole operation middleware
-func (sh *strictHandler) CreateRole(w http.ResponseWriter, r *http.Request) {
-	var request CreateRoleRequestObject
+// ListWorkspaces operation middleware
+func (sh *strictHandler) ListWorkspaces(w http.ResponseWriter, r *http.Request, params ListWorkspacesParams) {
+	var request ListWorkspacesRequestObject
 
-	var body CreateRoleJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateRole(ctx, request.(CreateRoleRequestObject))
+		return sh.ssi.ListWorkspaces(ctx, request.(ListWorkspacesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateRole")
+		handler = middleware(handler, "ListWorkspaces")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateRoleResponseObject); ok {
-		if err := validResponse.VisitCreateRoleResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListWorkspacesResponseObject); ok {
+		if err := validResponse.VisitListWorkspacesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6253,25 +10270,30 @@ func (sh *strictHandler) CreateRole(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteRole operation middleware
-func (sh *strictHandler) DeleteRole(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
-	var request DeleteRoleRequestObject
+// CreateWorkspace operation middleware
+func (sh *strictHandler) CreateWorkspace(w http.ResponseWriter, r *http.Request) {
+	var request CreateWorkspaceRequestObject
 
-	request.Uuid = uuid
+	var body CreateWorkspaceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteRole(ctx, request.(DeleteRoleRequestObject))
+		return sh.ssi.CreateWorkspace(ctx, request.(CreateWorkspaceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteRole")
+		handler = middleware(handler, "CreateWorkspace")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteRoleResponseObject); ok {
-		if err := validResponse.VisitDeleteRoleResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateWorkspaceResponseObject); ok {
+		if err := validResponse.VisitCreateWorkspaceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6279,26 +10301,25 @@ func (sh *strictHandler) DeleteRole(w http.ResponseWriter, r *http.Request, uuid
 	}
 }
 
-// GetRole operation middleware
-func (sh *strictHandler) GetRole(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params GetRoleParams) {
-	var request GetRoleRequestObject
+// DeleteWorkspace operation middleware
+func (sh *strictHandler) DeleteWorkspace(w http.ResponseWriter, r *http.Request, id WorkspaceId) {
+	var request DeleteWorkspaceRequestObject
 
-	request.Uuid = uuid
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRole(ctx, request.(GetRoleRequestObject))
+		return sh.ssi.DeleteWorkspace(ctx, request.(DeleteWorkspaceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRole")
+		handler = middleware(handler, "DeleteWorkspace")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRoleResponseObject); ok {
-		if err := validResponse.VisitGetRoleResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteWorkspaceResponseObject); ok {
+		if err := validResponse.VisitDeleteWorkspaceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6306,32 +10327,25 @@ func (sh *strictHandler) GetRole(w http.ResponseWriter, r *http.Request, uuid op
 	}
 }
 
-// PatchRole operation middleware
-func (sh *strictHandler) PatchRole(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
-	var request PatchRoleRequestObject
-
-	request.Uuid = uuid
+// GetWorkspace operation middleware
+func (sh *strictHandler) GetWorkspace(w http.ResponseWriter, r *http.Request, id WorkspaceId) {
+	var request GetWorkspaceRequestObject
 
-	var body PatchRoleJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchRole(ctx, request.(PatchRoleRequestObject))
+		return sh.ssi.GetWorkspace(ctx, request.(GetWorkspaceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchRole")
+		handler = middleware(handler, "GetWorkspace")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchRoleResponseObject); ok {
-		if err := validResponse.VisitPatchRoleResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWorkspaceResponseObject); ok {
+		if err := validResponse.VisitGetWorkspaceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6339,13 +10353,13 @@ func (sh *strictHandler) PatchRole(w http.ResponseWriter, r *http.Request, uuid
 	}
 }
 
-// UpdateRole operation middleware
-func (sh *strictHandler) UpdateRole(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
-	var request UpdateRoleRequestObject
+// UpdateWorkspace operation middleware
+func (sh *strictHandler) UpdateWorkspace(w http.ResponseWriter, r *http.Request, id WorkspaceId) {
+	var request UpdateWorkspaceRequestObject
 
-	request.Uuid = uuid
+	request.Id = id
 
-	var body UpdateRoleJSONRequestBody
+	var body UpdateWorkspaceJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6353,18 +10367,18 @@ func (sh *strictHandler) UpdateRole(w http.ResponseWriter, r *http.Request, uuid
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateRole(ctx, request.(UpdateRoleRequestObject))
+		return sh.ssi.UpdateWorkspace(ctx, request.(UpdateWorkspaceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateRole")
+		handler = middleware(handler, "UpdateWorkspace")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateRoleResponseObject); ok {
-		if err := validResponse.VisitUpdateRoleResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateWorkspaceResponseObject); ok {
+		if err := validResponse.VisitUpdateWorkspaceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6372,26 +10386,25 @@ func (sh *strictHandler) UpdateRole(w http.ResponseWriter, r *http.Request, uuid
 	}
 }
 
-// GetRoleAccess operation middleware
-func (sh *strictHandler) GetRoleAccess(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params GetRoleAccessParams) {
-	var request GetRoleAccessRequestObject
+// ListWorkspaceAncestors operation middleware
+func (sh *strictHandler) ListWorkspaceAncestors(w http.ResponseWriter, r *http.Request, id WorkspaceId) {
+	var request ListWorkspaceAncestorsRequestObject
 
-	request.Uuid = uuid
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRoleAccess(ctx, request.(GetRoleAccessRequestObject))
+		return sh.ssi.ListWorkspaceAncestors(ctx, request.(ListWorkspaceAncestorsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRoleAccess")
+		handler = middleware(handler, "ListWorkspaceAncestors")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRoleAccessResponseObject); ok {
-		if err := validResponse.VisitGetRoleAccessResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListWorkspaceAncestorsResponseObject); ok {
+		if err := validResponse.VisitListWorkspaceAncestorsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6399,23 +10412,26 @@ func (sh *strictHandler) GetRoleAccess(w http.ResponseWriter, r *http.Request, u
 	}
 }
 
-// GetStatus operation middleware
-func (sh *strictHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
-	var request GetStatusRequestObject
+// ListWorkspaceChildren operation middleware
+func (sh *strictHandler) ListWorkspaceChildren(w http.ResponseWriter, r *http.Request, id WorkspaceId, params ListWorkspaceChildrenParams) {
+	var request ListWorkspaceChildrenRequestObject
+
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetStatus(ctx, request.(GetStatusRequestObject))
+		return sh.ssi.ListWorkspaceChildren(ctx, request.(ListWorkspaceChildrenRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetStatus")
+		handler = middleware(handler, "ListWorkspaceChildren")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetStatusResponseObject); ok {
-		if err := validResponse.VisitGetStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListWorkspaceChildrenResponseObject); ok {
+		if err := validResponse.VisitListWorkspaceChildrenResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6426,119 +10442,216 @@ func (sh *strictHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9+W/jttbov0LoPSDTC3lLnFkCFL3ppC3y0GVeJ/mK+7WBLy3RNu/IoktSmfFX5H//",
-	"wENKoiTKlrwkdpNf2onF5fDwbDwL+ZcXsPmCxSSWwrv4y1tgjudEEg5//cBZsvgZz8n3NJKEq59CIgJO",
-	"F5Ky2LvwPqTN0YRxNIFWNJ4iTgRLeEDQeImmahAU4zlBiVAfhYQ2AYslprFAgmAezLqe71E15p8J4UvP",
-	"91QP78KD7iP4w/dEMCNzrOCQy4X6qsfyHh58Dezt7fXV9sAmCQ0NsGpARL7gQKI5luvAVB0LYE4Yn2Pp",
-	"XXjmSxXsbdG7MWIboFTB9pNa9XtO1dR4HYhiQQI6WSoo5IxojAFIpj80wjFi4/+QQJ4IDT3jKKRiEeEl",
-	"7PMqgEcwZAFsEidz7+J3RbqS4sjzPdgu786F7f+vhvyRzqlcuxQSkUCmK8FzlsQSsQkKscSIE5nwmIR1",
-	"oEYwgw1lSCY4iaR3Mej73hx/oXMF9aDfV3/S2PyZgUxjSaaE5zD/MpkI0hJoBn1SoOtg1a3cwNrA9Z3A",
-	"fQzYYiv6FWqAOtjgoxs0DweB2hO134YC8l8WnMYBXeDITQUfl0KS+VZQwwhoEuFpLezQxMVhY8YigmPv",
-	"QcHCyZ8JEfJbFlKSC90P6QKuY/WbYmkSw/bjxSKiAVaw9v4jGHzOZ/i/nEy8C+//9HKx3tNfRa8yMMxf",
-	"Wnv6GUmGcBjC/7RU9DSslJPQu5A8IanM/ZVFZNdgmjEdEKovTYB7SBEPSL0MAiK0huNsQbg0yF4QPqdC",
-	"UA0g+YLni0htUcCE7MxxjKdkTmJ58Y8LTrBDgKtpNVVckQmNqQISBqaSzMW6pf5a6atGNFNgzvHSS0lE",
-	"r+x3G2D33HcPvlntBzylMZZmbTiKfpl4F7+vhuhHKqTV78EvI0xJksbrM1hftyYYM2dUrRy8B1hJGMK6",
-	"cARkUd3AAnHYOwjtL9GV9d2xe5pXq/1cbUGFF9qeng3Dybuz1x08Hg87w9Oztx08CN51wneDSXA+7Adv",
-	"+qcOpf/ge+85E+JSy6tftQSori3gBEtSnrM/eNfpDzqng5vBm4uz04vTt//t+bmhEWJJOpKCbq8sgcTh",
-	"SDXYdMhcXo0quMDh2wAH552zs9ekMxwGrzvvxoPXncE5Dt4OT0+H4XBsD+o2iHxPSMzltkAKiWUiigMs",
-	"SByqORxzSsynRI5S/VHoNjg9G56v6MT4tFGHml3/dnmZz9qMR120U2VUMsc0KkImiZD/5CScYdkN2Ny1",
-	"qAnlQo6qXPH/cOwkqAg7m18x4jR2HRzuxMmtIPw63DVKEkF4hW7Vdm0B6xWRBs8sJptBqofICUEBvuEQ",
-	"t4Jch2tg3Q3F/UblTKli0Yz09E8Wu64nOet3Z78a0st/dvRqua0pQveFqFUEuTns2hYrbYlT8PcHvf6g",
-	"d9o/HTgtG4Da1vV5T7DCfsZuLVNU9vUiffX0jyKUi4ZIacoC5JbyTFFTg/9fEvkkFLOpCHscO3Fj8biV",
-	"YMxUCSx+UyvUiTQZzP4WfGbZSqknJbOU8GLB2T0JwamyAEz5XoDjgEQR/DskMSWh65TtRtvtQoH3t5BQ",
-	"JfLZSFTkrP5kxv8aDO/Z2q/uYMPjXbj6XGf7E0sd7S+OnvkBu/k5/kN+KHdJmZJE2QPjHtIh5zvOGXfw",
-	"t/p59VbLitn4M5NowpIYHK2NEDDsnzVRhWt0gQH2Ll2OGnU3K/oXS1DIUMwkmuF7gnJyQ5KpvxTLIDmj",
-	"AuFAUbd75eD2KXOE1Db2E+LpZya/V9tVdarCZyQkTwKZcAKuVTkj6A/YY+j0h4c4EQsWCyK64MTdENvF",
-	"mbUpj9gE5oNhujpI0IzIMlQXh/2ofcH1w2Y+aLF6T0rDwu/psCk+CgMP+8Pdbl1b19olsj4pUOt9Zs39",
-	"ayXwoGMGXCuTWi9nnbF4e3t9tbbRDZ0TIfF8QUKHjYvDOY1HWUTCCk5McCSIX/H3+94iwlJxeMteKSm9",
-	"T0X3qqiM1qoNm5ooRQMwHh6y7Xh6z3JGFVtY9a5ASyk6kLNwY1PAjq+s8+Xnw99VgylFUFaZuu+Gb4ev",
-	"J6eTThCQsDN8M5x0xm8mZ53hG3JKgvNBGA4GTYyxlcDmdmwG5wFEGBQYuyADZYhnWycu4zCzyg9M5mxD",
-	"ki7Ts0pXu0B3QfcZynEiv0QNFbKPaPxp/QKzAX6E5g++NycSN+/2k2oNxmt5qApA4JUssl8PL2jvftB7",
-	"lYbjxFe9b3RU/ev+H0m/f/oa8gG+HvQLXMhpnUe9+fjv2k8Qky8tJhi2n2DByT1lZYNz1SSnbScpbtVP",
-	"ZrNLB+jKMeesb41LY/nasqPynAY1dCEqXFL6eWC7uEA+xoHzKFpnRunQ5JiApZcIPAVrMj8QdFcfT6uz",
-	"X0AofG2keqS/2L3TGHqlzz3h49JEztGLWPsFVvtI6qGdGluhCHL4n16vrfEoNF0Ri2iw3May1yM0NO1r",
-	"G9fZ9rrDd18kiUPSIsxi1vUI6naaHo2aGqP70adZjssKVaqRch1vhMaaVed7+/aMkP7wLemcBYOwMzwj",
-	"k8743fC8M+i/PT09x+T8dDLczOH3yHZsc1QegBQo8sc2kiCzAS8aRGvFnMrZf/45X0oSzAJmkgUyvKfB",
-	"3KqH+YskPMbRyCiaSqDK8604ULn7XxVdfLcqI4HNnJ5fKkY4kPSeuBLu4DPj0xEc3d0tauLKHxVOnGlB",
-	"Qq253FyjcK0ozDr7BquFzXIdBHczXWGan2hM5y7S2MNchxRstA9GDVumqNoqnOhI+6uamVJyOk6klVvb",
-	"LpPQ9CuDVR7XDU8+aRGqT2S5Mk+yiz+Lbh4+r/CKGis3nU24kfyZQNo2jR1xRN+7x1FCHNGH12spUEFr",
-	"z5mOBWtmEdnGMFL9L9tFni5Vn7qIZLV5zQx1tlTuOGrGVIAAh08zS5TdZYanGbWGHYwR1B70fft3AeqG",
-	"jtQNnMHWUbLOJAqwxBGbNolhZ8pXWTYV1fud+QpkEq5U3pLEuBwgTLvf6G+ug+lmnm2wcPbkqzaEdbWM",
-	"8ZwGh0pfW/Oa/0KobQgVDgBipM2/ZogvJaE71pQNOgqa7cJhsUvJe2vNUiSuEin4eZFLZT1lQquX/blp",
-	"15pT9xJMSKHYxsKDZTkzxI7BxDAr+I3KWV4401x2ao/GAVgWH7Mge9mHS0f3hFe8qIMG/mHfC9jc1A9a",
-	"Fumbt+EpwWvNNXtmBaGtIB4hBWzOQjqh7iHPOv3hTf/Nxen5xXnjIUvLSyG2ZrpzJCaA7qyeNiv1Nedv",
-	"yOv+5F2/8zboB53hOBx03r7B/c7pYHj25vwdxmQQrHcIlc+lqlEVKiUwSZBwKpcfFQlqmMZY0GCEEzmr",
-	"pmrcgNuecBr2FliIz4yHiAoUExKSEH2ekRhp6tT1mFSgyw/XKNVe0TItDQRRrObJQZ9JudB8SOMJc0+t",
-	"BpswDmICfYsFCZHmGfSexZKzqOv5XkQDEgtge1OWePnDhx87Z92+53sJj8xc4qLXYwsS63Ngl/Fpz/QU",
-	"vayDYkUq8+RK15wehAw0Y3mDbl93U0PjBfUuvDP4yfcWWM4AwT2Noh64HV0Frmb8LDMIYU6QYFySENEY",
-	"YRHolDfEeEg4Gi8RjtH1FVIMq/CMJIMAi5LWYwypNNmJ8Dr0LrwfiMyO90bo+IU6+N9d2Lf0IBQxvxJf",
-	"qZnYWGKa7nuW5JSFQ7voRtEBFQpIu6oc/abIJWaFcalAIlF/k9BHOIqs2JBjbEBMVpiM/sUSNMdLhCPB",
-	"FJkijJTgwh1B1OIU+iIqpAIaQEAsRvMkknQRFVYn6upb7UBYufpyZVl5GZ23Mf0z0ayky8EnpYU58fqK",
-	"xdES4XtMIzyOdEIZWBzCRzgOEZ1YyJP4ExFowUlAQhIHBLF7otFHQxJLKpdoRnBI+Fd1q7UcdS2WViwl",
-	"BgqFSmIWEaFIFfwRXfQ944jGinFI1sjX4C/RSecEjcmEcR0oBMrUHVWTYIawuEDfQLfRePl1p7gvzopv",
-	"09ZZRl/eVjtmaOKBrprqSh4bkQCuyPLZFAaF2sspkWiMg0+1hdNaa7urvkmsdju0qr7zX0Iq0n/iKKoB",
-	"02Vz5Nzesy4IaNralOY/3AG6tJRSQJ/2+zsriq5U9Doqoy/RQn9PWZtNUobRSg6sqWF/UBWyt7FScYzT",
-	"/9FhhmF/uDPQdT6wA94s8VPNeL5DZNXOeBuTLwsSKASlbXxPJPM55kutCTSLKTErVStL3mBLINEY2unz",
-	"J3plqBOIO2804WzuFDFKzeOpUixpWfqdgqMXcCZEx3hPOybzfoVm/HaJzMx+G/VobLQRlhVVqI5TjioF",
-	"h0LcHxf5m1zHQKicEbVHSsicCHR95SPYsyCiJJYnAjE+VcqXpCjTElSp2DzFvU4gwZ918jKtyCrkyjeR",
-	"kE3WhUHlwdUjcTIfE95F/wVwBzhG41qdziaIhqKLbphqlAhNCxLHIZmjz1TO0Dfpmr428CvanSQc0Khh",
-	"ybGZkiJAVASnzjbIK+g2VZa1CGF8imj45IgwYNSq2OmodBXQ9uv/PKPBTJcNjIk6XZjqrBUWGnwfKVPJ",
-	"SbzKVtsRscJhgMVG4bfQ7Surzkx9WbHoLC1Faw94ZoGNl2hCSRTW2V7KYD7pnPiIdKdd27rKS3W6m1hX",
-	"Vj1VfXWp4xSdFQw41rxPu2N1uWhDIwQUWyY5DAo2sEnOdmshqAEdK7iORTKZ0EApjsKJSzK9IudyxKEY",
-	"MT/CoW5GasA0towSaKAh81itMUlcVyncgetZ56UWLYb3QKo1NkN+tdFynwTpvifI0VDtoOYt111GJR4a",
-	"7BNk8JA6mCe9Gc24gsfpTV7OvTwqztF4r1/IIbCOJmZlSzihXMcjK2z4v5KEhg+2KV/xQbnGXOOHur6q",
-	"l676nJ2qqAWG3KXUl6HdpPUum7UO1Rcb/cVGf2ob9ZHNHnPRj9PkWWXgHJWUnhJp7tN71k6gTTUAxDWC",
-	"metKSRnMUn+osfl7qcXf0+5gNtFBASqk5igYtYvesyQKMxkxXqKby5/Sr4yDboWzUU533MjLDH7wi8Nt",
-	"iZrbeiGJl9kMFTfQB33l6/Hoo7tHszV1UN9FVXCxCTCRG3Pr7M2DFV4vpvCLkN21kNXycAsxmzj84d8z",
-	"XpWNCfBlO9HrhMohJhP5IiR7qy55epGTL3LyRU5uISc1t2zlkdCZqTuPIuavOjijiJAo+9hxwzXNrZcW",
-	"GrYuvn3QoJN9G3718HyZ57iUI8rqZK9P9OrLNMXe7jJRWs6NPs8It3NwqIA7qzCak/mYcEixSR0cYUhC",
-	"NMdxYrLaXFCTL0GUhGS0KfSpz+T29vpKWCCr30looNYXMC0iFpIs19mJwvIzHU2LyX1PyKW+nZbxuWOL",
-	"fzRgKrtCP27hwm4XXUuFUSsDDI0TiQIsSIfGgsSCQgljs+VAHr36t9jPoq6o+mtOYwxG1QxL9JnxT0K7",
-	"qfLZ1VppHCoRab39gaOoh+NldqVVipk6+obxQntKd55SFHm+h+PlNmFAQ+x7ysQydN46SGj6WcG/0gVQ",
-	"xUKAJutXZsJC1zDAoxVqq3SuYOqXTRP4M+cr4yhmcafye85qroU56gBWvH+xAZzal1ECsvjjagiLZQm7",
-	"Bs88DGLgMn+tBqjJQyH7dHaWr/RqGNXVFsDRRnGNjj20qK2RSFpHy6yIyZh5uv5oXTj2B+tFlF0f90wF",
-	"VHWB8MGK9NE4B//xYq35VRwtjkrWIeO4oqkZ4AcVPdWCQR0b6gnYOplYsdGQRETfgVsk6yv4PSXrBu6V",
-	"aUqMZsQ9elQKhDysko/mCw1HeFREpmFedQT/R+8f+z9172aSNcSrKcwm3jzB1yl/68L4mxDpnn1++zQb",
-	"au5NdJoQtsHw4og6xqhoU+Yw7vkic2hf1ib8oX34x+UWX28nmchEGzup/yh2koZQg3dcGsug9FlorMw1",
-	"3IwpqwZXfkdrA9srk/HfczY/OB52+Ash5wpVc65S/6M+YuU40Cf5ObsneeFOelXcKi+saFX72MhizPWp",
-	"gSjUIFlk3Xf48XGIruNFclyq1aDc2ofyUnesbbMXCo5B6/6qsYPLVWWtzdSdhHws1319BbXYSEDAqnTq",
-	"oLG3CjdIP4rMUCaOrikGZ6IuV/1M5UxhI8vxXO1VrCQx5oWr4L+3ylYhrdE3P2+Ti6oxaHHQeGnRy7/T",
-	"Xfv3Ro+EZwM1Dtw8WWVg5tQvomIfjn0LF62d+/m1iO3DFsb1HC2N0xnYU5FsnVbz0Xi5wPoGCowCHEG0",
-	"7/pmXZV7bTYucBnQ7J3/uF5q1w2SDomaBuAsIsBS4mBGIH169zr0+Z4GoyqqW2ontxP7Mgyzzb5hR3Bg",
-	"dKG48Ky74+n1w3HMOI57fxvz0hwILQql8YtxqZn4MgzLaSCbHyYhj7LBOVJR4bEfISGDQeehKG2rU0hb",
-	"Hh/17eM7PzoCkz+bU6PG/J4PjMd1UATafMIzYpbd48wKBPL8nvG23P9YPH890fe0UXUsgnRsGDJ9ii+J",
-	"JPqcVuNo4tNpbWkgmcVEpAhfxf0mGc59Uqy9o7PdgTC7Ywq25N+bHwGz/K7dVAo6obNvtNwCytLbrnuD",
-	"Nu+6DbCFF2x3D6vJPZpEeLoSjibpR9vhq3RhbwlnDVBVvvH34F0Qe73gbcu0whKT2FmGq5MK9x5MLr8p",
-	"6Iwi21ZYmid1fAk8sIp8CQeVyJPlolVQvM2RXm3u3+k0b95b2PYg/3RvXz5LT4CD5Z7niUGf/kE/tzz4",
-	"W4jdeVWR9Rq6hZKu8zDxwXqo/3DuJ8yjEBb9He1Nr4X3JpsELL6zKlrSG4rLVyVDua39zuVj31XcwtZ2",
-	"rSe760T13seKCltRu6byhm25qozBkNp557K2W5UatnYxhtranYIkxVGZ1qZTTqbg883B1tcIOVeklWFt",
-	"9DMn/lawXU9S/8GJskdOfO1W+EyjyHgMCMJxQdxBKRVG04iNcYRwFLHPGC6qzipLTiwiPvHRSWH/TxDj",
-	"6ERh8aR4hRIV6AQ8CifdNZV5emqxr/C1XTpjTn6mLk7H/XEQkIUUiMVKMqK5koK5p9UvE5pvld7YohaK",
-	"FIVgAQVyBLRC8b+5CxzGfCW+WoeN1EH7KLsO69QVlwrWwk6nlZdGNUNTk/4PVAIcVyv3VAvX5Uqbb+xe",
-	"Y7yuF3gbliNVmPoIa5Ks3w7uMFh+e8BR3GE9Wlw1FTXzNzQZTWMQ18UHEfIHDi7jEAWZN9YWhkp+gBJj",
-	"MQrJHMfhOgvSPFj9pHakWJCATpZGHMLttKJE2JIh472p4XfotfI8vONr/nWte7Yr2cZl1wHbZnwb+8Ho",
-	"hkcz9NYupICibZeydwtv7XLUDm+7ih1bdBvoT72sVcZTQfeffAMM8vWJMQnZ5NitqhebY3c2h1ECTR3Q",
-	"KUvpAAiJwqKwfrE+dml95O/8VNBeUpItrBMWKWzswYsFcZT6MPgHM/ExX49TvulmTXNwILabAbqow1/t",
-	"fTp1njeD3kO/1uOxBR1Q5QYHK03NbQ9Vh3V4SUmizsGtkbP2ZgWLc/dRNKihcF+cp7890RX2evLvvkgS",
-	"hyRsdbmCJp+jIpvsJgND+u6rDDKSKeiSxrcZmO6NQqGLbO+f/j4DQ4ftLzR4jlG27FqBnJLWiZ+6iwU2",
-	"o5fjvFqggbgpqqUXMmxQr9GcBlfU729GhsdYwd9MGWcre7za/Rfu2FMlfVMGAYVfrJ5/lDpbODvadbGH",
-	"kvRgXNepyZdd/RiYq1P1kTy1EE+EjsXiOOwxjsgc06iL3ieck1hGS1/3H2WdlSQxwV6dK0rsV6zUUD0Y",
-	"w6SV/qHfVdO1u394aIGlJDyuc1cVJ6t7dfYLDqTlsDLwwP2c6ksTV937UjlJXrNZrKHTWruLridlRFBh",
-	"MA1vGkPhp0L3hHIh8+tdwfc3JmhBg09qmoXeopVZtvZtBtbBNXuIXX2/9NV/v22SR38z0yRuaNrc/Alz",
-	"+tk9jhbhw5lB/9VFV9Zrqlmj2rsTGZcjmMWd4iICT0uDZtkrHaAjHIYcXs2dFMujNA4VOq1XOQI2H9M4",
-	"DTWXdizH/Io1APG2c5Uf7OvKG5XUo49EyuyRfF1hQadxwSEDQukiZxofpWg7hGr8l9rzl9rzGu9Zrq+P",
-	"LyBQgP2wHHpWzXmNuz9tYQy2vEL1kevdjtrLD7VBDR3w7ufx7HKTjYql2hR1Pf6d/s+pAGjN4nGYiz0T",
-	"mcsTVvJS6aaX9OMwHOlh3Lfap6vShegjGnt+/u9R+rYhNi/s+1vfgn8b0z8TktvZxqotWIhsrCjaqjJ4",
-	"pc2fLHqp8TSnsfDhCQU6ybITfCTxJyLQgpOAhATyF+6JTmulIYkllUs0Izgk/KvuTt+GuHEkab8SX1lZ",
-	"Hyk5+7q22FYW6gRnyvOMEIb+XXRjKmnhZaUs3/iQk71vCmlubgzsaV0WRnedjFwrpFMR5dq+NiWabaoz",
-	"92mlKW2bG2hXyxjPadDUTgNFfrSJozpb+ZDrByv2mdqttfFWaLQf925av1dd3q+mKOoJ4qxq6t+onF1q",
-	"rdUmzqqQfYx32KdwH1TgV1+jsZJw8zNF45CvoeYGkZO0Lu/pw73ADEd8e33OFs86/gwEVRfYyERxXey5",
-	"PeHu9e35Nge2fRsca6S1bVcc3Z33z5t3dNC8GeNkz347HtJuzzzHGC3XxnfN49jm+XNm8LnX2w/XMyWo",
-	"NNixI1NpAPMz58r05eiGfFmfyPJ82HI1N5jsh5w5G6ayOHjqiJ+MeN5MlWXANOGqysFHu1jt0IrTgDQk",
-	"2IjlsgPtU9mQW8Rg9mlxahy2j0JCCQ30TX1c5nR7dF4DZZealaQRhWduoubIaM6/OglkJct+TPNE9kbM",
-	"ZoY2Ti5B+D3hJu3lUHbhFx30KcKWo90s8w4GFiRIOJVLkHxjLGgwUjzmXfx+pwSHHkPLxYRH3oXXwwva",
-	"42Mc9O4HIFvMsGVe/SXdP4HwmCWlBzSM4Mxj4tUwQWWA7BFy09m8aLW+Y3p1hun3q2bR9QDntTUpuGnp",
-	"yNq+ONUtpmdq7qzvmW2X6ZkS5fqezif5rZFcT/I/3D38bwAAAP//ypE/dHLhAAA=",
+	"H4sIAAAAAAAC/+x9/XPbOJLov4LSvSrPXFG2ZDtf3pra8yTZWd9LJrnEc3l3uyktREISxhShAUA7mqn8",
+	"768aHyRIghQpS7I08S8zsUgCjUZ3o7vRH3/0QjZfsIQkUvQu/ugtMMdzIglXf/3EWbr4Gc/J32gsCYef",
+	"IiJCTheSsqR30XtvX0cTxtFEvUWTKeJEsJSHBI2XaAqDoATPCUoFPBRSvROyRGKaCCQI5uHsuBf0KIz5",
+	"W0r4shf04IveRU99PlJ/BD0RzsgcAxxyuYCneqze16+BBvaXX65e3R/YNKWRARYGROQLDiWaY7kKTPiw",
+	"AOaE8TmWvYueeVIF+77oXRuxLVAKsL2FVb/kFKbGq0AUCxLSyRKgkDOiMaZAMt+rl3CC2PhXEsojoaFn",
+	"HEVULGK8VPvcBPBIDVkAmyTpvHfxDyBdSXHcC3pqu3qffdh+x6dXUXUVV68QmyiQGZ/ihP6O1QMDxwLL",
+	"WQ4G49OR2ktOfkspJ1HvQvKUNGPyv2Apb+icypUoJDEJpcUgnrM0kQBchCVGnMiUJySqQ1GsZnBBicgE",
+	"p7HsXQwHQW+Ov9A5YGs4GMCfNDF/ZqiiiSRTwnOY300mgnQEmqlvLNB1sOq3/MC6wA28wH0wLNC8m5ZR",
+	"/DvZeRftpD+r78vT/qxouTgxgkECRI6nx2jGhBQKXTS5JYlkfHmifvNDZ/hzXfjEAoc1QKpHTZBm8HWD",
+	"Vk/ZDeSPIVvcS/4JGKCOxtRDP4n1cBgCb/WCTILkvyw4TUK6wLFfinxcCknm94JajYAmMZ7Wwq5e8Uno",
+	"MWMxwYkC5RPjNwrvzYxwZ1/bCCd81S8TIX9kESW5rvDe4u0qgd/gJCKJkh54sYhpqMTqya+Cqcf5BP+H",
+	"k0nvovdvJ7k2cqKfipPKwGr+EsrtYyQZwlGk/qcP88rCrKrwgcVk02CaMT0QwpM2wH21eFdIvQxDItS/",
+	"FpwtCJcG2QvC51QIqgEkX/B8EZPeRS9kQvbnOMFTMieJvPj3C06wR+8IepYYX5EJTSgAqQamkszFqqV+",
+	"qHzb+5pNgTnHy97Xr+7K/uEC7J/789fArPY9ntJEn7+wIXH8btK7+EczRG+okM53X4MywuAgar0+g/VV",
+	"a1Jj5vJB6zS9r+5K5Ky6d3rjK7x6PSNG+wR+FakaDVGBMJqT+ZhwxCYBokZoAzWNaRKBXKGiSFEOOTwj",
+	"5MV4/LT/7MngaX84JKT/PHwR9Qen56c4HD4bDAZPfMRRJK8qnPlzxByA5AxLNOU4kQJhjUMXmuwcuVDn",
+	"yMUdp5J4aZPFpETX5wTjp5Nx14XASCODp9KILVAzwmJBcTLijMna0ROjC+RDX2Wnpz7xcQS6jJAcS8Z9",
+	"4+TC2Yvt7HGOaSrQmKVJhGgSFE7xI4HYXQIaNUvUEU8BgCQkQjJe3I7GxZVI3T0+CjjVf/YKJJMzwEdN",
+	"xVUeIF/COI1INEoF4Up1qC79F0G4QPZNNOFsbqhK0xjRgjSOEQyi1BLL3BUMF/k46N3QxHNcfpoROSPc",
+	"zkKLEyGwsWKiZgsKUweAb/ODaz8Ud144mgZ81gtAuI0s8GBWqPeFR+cI1HktOkowJX88q7dIr2LgI5FK",
+	"aQH8IANkTjHpcCWhwIdVAgDxXCWCNWSyGc+3qDmRK8/o/Ih4C2+XgVdDBEauwxqiSJ1OOP7JyuzSClzk",
+	"uSJAvX+JXjnPPXtaFR36O9+7yn9QePf07DyavDh72sfj8Xn//PTseR8Pwxf96MVwEj45H4TPBqee/YJ1",
+	"pRGVb9j0dSL5sroqHErGqz9TMcpo1KeMNtHV6/lCamMinOFkSgTiRCtalrM//Hj5Et0OgZPmOFI6Msi1",
+	"kM3nOIlQTBOykviy+auHctAjnOtVVVBLoxaemqAHuMDVrcZRlGmf1+wncwZXv9ZeA9/8LJUhM5Rg5INI",
+	"7fE5wTROOfHKBIn5lMgOUgH2/Vp95OMgSeekgIkIS9JXv/oIcgGPO07+i/rIN/nvJBpJdkNqNA5ObqnS",
+	"N4AozNTojnCCQIuQJEFYWu1oqR6spBa9y3p1mU9H07672Tma8zXne+ajNMtee6DIFhj9PvqsQzlVwVAS",
+	"TOPBs7PJ8xeD/rOzZ2OtWo2fhKQ/PDsLn02evxhrPaLmkHZHmvq5qQS3eqrYuHY3DN1VQC/ytGG+lx9e",
+	"X16/7gW963e/vPx7L+i9ev3m9fVrLwtyEqvvxYwuPLBftMHFv2n9/j9AfF3gmIaradclzwIIPgy8nJHw",
+	"5sc0vrnqQIjqo3eKBcV9yVCNdSXJXJ3T+MuV/mg4GLSiSEWBeoyuCzCTdllqPhl829rybm/cGE19VGab",
+	"2bDxdcsdJbeC671DWKAIbGoChoG2I9Ua/WZY5s5rprUiBMUFVPT+Ai49+tI4nRa8cBMcC1L2lVwlSudX",
+	"C/i4oCF59SNSnyLJjeeS4HCGQpisF3TSRH5J6G8pQfYFa78uXAeSGjco/a60FPiJRiSRVC7RjOCIcDh0",
+	"EiaR9mV7tC2Fkg9EqPWuR70l/SyO2R2J/DqYwtNI4cl/kvoQCoqZwqh1OxSXfoeFxgmJEBYXpadUChJP",
+	"EGhp8MBsrfZGCESVD4POQdujMl667gzXZMOZrv3eWax2RFYkWqPQsOhx5IbG/gasEHcv2x2nAAJnQlxq",
+	"3/IH7TatghFyAspwScUfDF/0B8P+6fB6+Ozi7PTi9Pn/9oKWChpJopE99dYZMnfyVkTVKY6ehzh80j87",
+	"e0r65+fh0/6L8fBpf/gEh8/PT0/Po/NxL1itUguJubwvkEJimYriAAti3RM1SvPI+voLnw1Pz86fNHzE",
+	"+LTVB1/9u/7j8jKftaUcqI7ikQhkjmlchEwSIf+Dk2iG5XHI5r5FTSgX0uO/+k+ceAkqxt7XXzG/vuJR",
+	"I704AT/PVbRplICAr9AtbNc9YH1FpMEzS8h6kOohckL4Gqw9xC8C7nyaYd0MxX2icgb3F6Id6emfHHZd",
+	"TXLO797vakgvxg1fddxWi9BtIaqJINeHXWvEpS3xCv7B8GQwPDkdnA7rXNlFWz7/Ul1d/YznDUZbbsfX",
+	"ifTm6XcilEtWY3HKAuTO4WlRU4P/d6l8EIpZV4Ttxiextni8l2DMjpKvn3N8dHZ1eJEmw9mfgs8cXclG",
+	"TWWaEl4sOLslkTIWFwpTQS/ESUjiWP07IgklkS8iwo827XT5U0ioEvmsJSpyVn8w5X8Fhres7Vd3sOVt",
+	"StR8jeLGDpY+dJ803nO392O/z77xWoArbNUNMO4+GTmv7aVKib/h5+atlhW18WcGd49pEh1X5/Ij4Hxw",
+	"1uYoXHEWGGA/2+XAqJtZ0f+wFEVMeYdm+LYQNiEZ/AUsg+QMgjxCoG7/ynUEY4kjpNaxHxBPPzP5N9gu",
+	"z20fPEZC8jSUKdfuJXAN/VPtsfronz3EiViwRBBx3AtKyOyA7eLMWpW3Tj01zHHB+bmCyDJUl67F1e8N",
+	"w2beMNG8J6Vh1e9OIKbCR2Hg88H5hrfuyyLGNPkoiec+O8ThjER1N3Dg9VK+QE5SkUVkJIhgHlPCEbnF",
+	"cap0xAAJhmZYoIQhIclCeJ205XNmRbRNsjLoNotSCXSUDOOemKhXxj/phrTcKwCKI3sDYzFAImRuKvX+",
+	"BOCNtxfciufvZoQr/zZXsTwsIWtGSmVuZavPmYiVXF0LeiFLrG/Ve4eld6jt+ecSkO8S2XtN8c64lVtf",
+	"UjTsTu3tX1CJMgTcOER/bb3iHfzpIg9hKgcL4TZ+8lQQblzkKkwIFx3kq/zjOUZgoIvUbz3bdbXet7I+",
+	"axYZOM5zg7WuxuLaFxeGcEfj5YainDjBwse/n2bLaoSX2puMKyFKhSb5lU8eI89KWz7mBN+I2k3pzFaa",
+	"RFfEbLWMgsl3M0NGBpa+HOka1nSJnEcgdevjldrHNpXAVx9mwHXyr+jlrPIcQEbXypeu6ZwIiecLEvkI",
+	"GWKgRtklZvU+s0reixhLEP0dv7KE9tLq8U1pMdrEavmqSS9oAcbXnFYePqQlo4p7uHh8qQpFkBx9rrVd",
+	"6Iy3CjZn+M/VdIQiKE1+jxfnz8+fTk4n/TAkUf/82fmkP342OeufPyOnJHwyjKLhsI1l3ghs7tTI4NyD",
+	"GH0AYxNkAF6ZbOvEZRJlLpo9kzn3IUnv8Vihq02gu2AIGcrxIv9qvmBcfiDw3yrRj8Hj6jmMej+nRjdS",
+	"cX8EGZ+VUEGjOg4b9LI7lsYRGhP1c88n/hyXWysEuPDWhNMFvYgvRzytiY7lBFa/6Snd6K9GfLkv5hGU",
+	"OnjSgzjzxIs7cUMXi80upMy1BpHl9QUZZeQ7mMPj4NgXB1cFoyaUsRQx9IuTT8piEhjNnXG0YDENlwGi",
+	"kccEZbw+yqig2T95Rp4OJi8G/efhIOyfj6Nh//kzPOifDs/Pnj15gTEZhj6pbZMYrOlXTtJQ4awmjNja",
+	"wS4EGnqvSVjV3kAAXPpN0Ho1G9ZMANPKNDLbpC1mvU8KSyonXAEaoDsgQjenSNtHeMxSWUCaeohjTnC0",
+	"ROQLbRPJphBWGyhaOp8qtBHT5Ea0zzB4o15fNzFBp6rnmegXjSroKMspWx3FnsWhlx0vvrfLWmuHiTjB",
+	"8bw4jw5H8b7MmBwV0qDWy1NycvNh9srAtSsKvAj9XNoGr35m15lnFmfrLMdq4nheKTKAxiRmyVQgyYoE",
+	"XoMsWHKZyipAqXiKIhpP8IKe3A5PvrPGrPj+5K86B/+HwT/TweD0qaoe8MNwUFAZOa2LBWo//ovuEyTk",
+	"S4cJzrtPsICUApaK9pOcdp2kuFVvjRwouVwrFzRnA2dcmsin555DWA1dcFKWxEOex1ziwTH2Hia1Nr/O",
+	"YRoT483CU3Wa5Z6249X+0+LsF4q3ViYmj1qnAwS9W8LHpYm8oxexZsKUd2PLdLO5GqyWHP6HN8JW3IW2",
+	"XZHWQ+7hhtIjtPRD1b5c54jSH7z+IkkSkQ4BYmZdO7ANs1O5redkO8af5c8mu08jpUsih4PGmlXne/v8",
+	"jJDB+XPSPwuHUf/8jEz64xfnT/rDwfPT0yeYPDmdnK8XqrBjp0t7VO6BFCjyx30kQWadXLSIMxVzKme/",
+	"/sd8KUk4C5kJc87wrr/wIJ58kYQnOB7VJOLA7uURbOXP/6icxZ+bYqnZzKtYUzGCK/9b4vcVrM61rYmI",
+	"/Qg48U3o3h+UUdg+sTYwWC1slk8r3sx0hWnegjvbRxpbmGufwiRdL17LNy2q7hUI+WGMw9df/P45ndzT",
+	"/gDJhrLu0oofSzkjKFlnzOyAqA5a8JnWlAzKAjAkSXAij9HVBE3pLXjFtKNH38cKNE+FBNcYnjNd6Gx+",
+	"3AvaAdt4OdD5OM5WDgezb7zM4PWsOysYJZTleecs/UggsJddJ5bKCsMTqS+x0QJzAiiCMJoF5spVYwIb",
+	"WuMiBz8DpV0kXZmKtuWjf+i7vg3dOXW64lshAXIVvYvOduWTgtVCFi39n6tLaTYu4YMpKtRuAYaz1qOY",
+	"rGjXvapNBbsjvU6EUsi11LCvOkA+uZ69dhuQfeIlIhp1r+bUpiBEzUJIHhjYknzMF1dJcYQ8C/8+V5/O",
+	"4G4+/ZO2+fQ5PE4FtwpMWEpOx6l0qjN2KwpnvqvQTGlcPzz5pEWobsiyseTdMb4Tx3lSz4oqLubmhPyW",
+	"qhsRmnhvQyCqj3hiop+uvnEgy1IxET3W50IF06ZbhwplrCoYUBYisd9DXONfBQcd+D81Y59kXtCTvxb8",
+	"cj+Uighox2ju9fuhHMHICY6K3lP9V5hywfgPZPmfv0ef/vO3//308+DqV3Y3+a9WztW536WqxqxqPi/V",
+	"71k0MmAALfCUBDZ/30Ztgm2lnng937aIb4a5ob9CrUsH+qPPqwpOOZxdEDXNwq+JDh6gXoRTjmXVAWqh",
+	"ectuSVFQbiBX3w7VPlG/AE5l8oYqfZ46q8W4QSrQIsahwvMGivApYFnsAbK9z7T2Qrc+neYSvqlLs2p9",
+	"ZVznZs0DoDqqaFvRvjopOsY/uiXt8h5xigrqlgGBa2ibzi1Tnbc0xBLHbNrmpMr8cqrCZFnKvTZPFZlE",
+	"jX49bc36P7/Wz1rcdbdUuJWls6WYS0NYr5YJntNwX+lrE5bOI6G2J1TtfBvRpLCSRsSXCll61pQNOgrb",
+	"7cJ+sUspCtGZpUhcJVLI5vCsp0xo9bI/1987c+pWgmItFPdx/qpledPeD0HFMCuA4N68hHp72akvO/dA",
+	"s/iYZQ6WQFnQ0S3hlQCLYYvQkaAXsnnZhukNnz2PTgn2oV0QfktDIkYxwxHx10021oN5E83xYqFKkyco",
+	"FURF/5mP2yawZ5M6yyzO+t/6gdW8a+YOdMVZ+OFuRhJySyAHS/2m0nl0fCEnGXg5Ss4mp/hF+HwYDcbn",
+	"K9VYd0dg59yDcwf5/nMW0Qn1D3nWH5xfD55dnD65eNJ6yNLy8mDbbCZfDKXSKS526PItXOWlNZGdn8h4",
+	"xthN4z60QzMBU1h5QNrLATP7a/j0Ggb0ZXfx0t32TMqFuDg5gS/FsfkdbrhPTADXqvA2i/M1UKjBKS42",
+	"D7duQHC+xAqzKvXiOL/iGOEogvDfyu+czKEIiSonqB+qyzH9PpowyGjLikhLpt8Rf9Gl1kf+acxnIo+/",
+	"Fkg/yAbQ0e+2XjvRodtZVLIpRg3lZZAW6+jSLe2epRtj/a87KmcoTTgBNIeSRAh2zaQsOEUn/qJePzao",
+	"VWtWP+jwfN9y4bEI8jfVkKN8yJGtWG+XrD5AdgYATEemmxkAARiaCFRgc6rV+7HaC6oPzO5lj5y9y/Sp",
+	"2tFcXNg/DZz2z7rlet22hiq9BWg2xMdzmthitlWmFiTkvtZZ/5cs7aH197eXL1FEYnpLOCUCYU6QoNPE",
+	"bFVPzfCGJFM5610Mn5YXGfQUQt4l8TLrbbNpUVKWEEo0mKUVZUSDZHj4ICUDyH104g3cYq1vam/txqu4",
+	"NO/lVJ3C/3fV3kQpXskULTiLUlXGBEmOJxMatozHzL+rcTAHPR130OR41W+4oQsNcQ3Fmr3reWMzv2WG",
+	"uz0g8saAilZkruVWyqlcfoRRbT6eoOEIp3JW3YNrU+WARicLLMQd4xGo1AkhcMKC0m3OUd0ekAp0+f4K",
+	"WR9IvLQdzpRBD/PkqAfRpa05mkyYf2oYDK50VA2wH7EgkT2iX7JEchZDYEpMQ5IIRXiaAHuXP71/0z87",
+	"HhhtJxeTbEES7bU/Znx6Yr4UJ9kHgFoq87pjvjl7KiZd2y294fFAfwZD4wUF00L95HR1sRdu8O+p79Aw",
+	"42dFc/RRwbjUdzdYhLoaFGI8Ihw0Fpygq1eIJhrPtnQBbPwYC+JeS0LVy95PRGbhJJc2bcxtB/sPH/Yd",
+	"b4rq5fmd+B5mYmMJBRT0orIbt+zMP0bXQAeQ2JUUmqsiZUomrDAuFUik8DcojNBjx9FSqmMrxGR9MhHU",
+	"YJrjJcKxYMoWxbqnSF8QWBygL6ZCAtAKBLgDnKexpIu4sDpR16bPeadb68N1KpNX8fodS6BoyC2mMR7H",
+	"utaSbiOktUQ6cZAn8Q0BLZiEJCJJSBADc9hT1Pz7utU6kaAdllbsiKgoFEhV66bjJVKX4sfob6bZJBck",
+	"eynQ4C/RUf8IjcmEmQodijL1h/AKRKmJC/RX9dlovPyhX9wX31rsu95usuVtLdbAVwknn4PVK4eGSgCu",
+	"yEo9paqtFejdRKIxDm/qUK0/qWleSRLY7chR0vNfIirsP3Ec14DpO0Zybj9x+tW2fdt0iv36OehlUgqA",
+	"Ph0MNtZksdIh0NNp8RIt9HPL2mxiGUYfc8ondz4Y+poDwBHHOP1dx7FD9a1Nga5L5XngzWqiwYxPBoPt",
+	"z/hLQr4sVP4tsu8EPZHO55gv9UmgWQzErIS3HHmD3cr/iaNkoe8MdSriXt034fte0JN4CgeLOdx0fIQ9",
+	"CVUhJXUeLpiQK8twUaGDdsultsodbd2KWlpCSo4TEVtrWBaHteERJjKk0Cy3EjNRPFBVJaTsJM37pS43",
+	"tsG2J4qvIWq+BIVIWJpNqC81Q90asxaaJVRBdIrKmaNOQar5bgdcUCKgiBGhjAKsjhvJqhveWm7sAxcr",
+	"7IP6rVsaOhwJhfmwu3ToVJivdCt8PE7jIjPX8gqESG6TX0wIZgueEQ/MNCoGaiXj5J1wAiuQtAmgxOHO",
+	"uOmymZeoFAfPSw4PHYmCEQI2A06W2QoFwhBMuHlmIrpKXL2Z+CFNRC5LtSO62vEHlG/lQiZJpLRROMVV",
+	"JyCgIMLnSlPVnvHA+pzdnsNCfZ6nmRyjkjRd5ziunKCmJl47a7R1ZKNP1672uKoz4zrFRda7q+yEq+Ch",
+	"UUtoZsM2AJR2STJkKKoGjlJBzQ5Iaa5cup71a2B11NH1mnStbdhu07Rxq23WCH3ivnL4WtK3aV0ZoYbu",
+	"oAxpSStjHMU4vNmlepZH+NefKfmp5rjWAEYoBgLXpkjSOfkLWmBhDh8TaQ8w6deOBJoTia3fI4u/Zwm5",
+	"79lhSn0Uj443jN2kC71gOo5JtoY/wzFSleIxYzcoXTgKyITxbcn0mtoqa4l0L+A7luqdvWGltBKWSJro",
+	"wucFyteL0FWGbDqJD0j9zYMdPN48pDq/GtGlBA2PXL0Se3wQmaqGmTiCG4fkFsc0Oijj4426nnAWp5Rz",
+	"bATUCrt+w6eFKRTecFj85KbMz+hCHxrkywInUe5h005wLVLnx/byzMaumJeyy9tyS1HVPV5ffWGhCUDd",
+	"LTJ1O6THZtz+XSi2ht2wnS2ZLe7Z89EgbO+OncYC+zu0W1Zcubc4+oA9LF1u89hrbcq47YvVBS+SM87S",
+	"6QzdzWg4K3baBaYt+pzr4IeR/LdBdUHx27+JMeTd5KnKtkb5IkpLfbRiDtSKUYfS3YytciYXrohqDxdo",
+	"0d+P2bT+WHkNPKNLyULUgAlXlCxzbs1xREzUQyaNtdCiwsaGXyiI1ZtUmlqzVCLd90rdj8+ICUfwVUsO",
+	"sh7XLJUhm5NjBJF3TQcMCnGCQF9Wn6l1ophNj6tnBhXyEh6/YdPqWbG9y9qK8FIrAkRT48XkpvyLQqSJ",
+	"i665ruayIJ/ahZk3ApBd+DfPTZJo0zOzSU5HQgcAU4Ece8IHBw7lCkW+eVJNiTpOyjYPsnITtNfI6CFY",
+	"q0KBqUedx7UxXqgx7YNRz3EPIC1TZeixQEVRFkB0zX4yBex8ILj1EB7G5rGstkYkgeVhi4+dnWFX2nZR",
+	"fIA4RIR3OKPONgsfDOgFUaQQ+ElJIgsXJJJ5pODeGViZwZGBGKCE3BEhkS6o7Jxf8IY5vkLOhOibmiN9",
+	"25Gg/iz7cWktmqBLPJ+JjB9h6T09PB1HxYOeJMWgr4mq5wILynSD8RIRam6nQbAeCXT1yjTJCmOgoSOw",
+	"46baSrNGoAr5AiUgb1dZF0Gl/qwL8LLdlQt9L9uEdLVZF1aXFLAVKFEdGI7Rfyu4QR8Y1wYhsgmiEVil",
+	"DF5STfZAgQLTea7Pgb/aNf1g4AfWmqRcoVHDkmMza44xXpbBqT3Asm7Y60b31SKE8Smi0YMjwoBRGxNo",
+	"Cshvcv3a7FMtQMeEJMh2Wm4IKVXPRxDb6SVeyVOyIWJV0cssMRGKHYIRGztI580HnQbStq10d8CzkNHx",
+	"Ek0oiaO6YNFUEIgUNSqJEw6at909Xicc1OmNXN8p3pMkmTX//BzsVMFpbv3eUtdRB1smOQwK1giifHjl",
+	"Q63Iuxyxd1qIH0wTfKnSEhlHuaC2Golnx1V6Sk3AlSLVGp1hK7FX1an8UVieF2EHNW+1CMUabhNkVRjA",
+	"wzyJNdb072Nrwnn38qA4R+O9fiF7EZ6lYcQ1UK7ikQYd/g9IiP7qqvKVpBnfmCuc/dqd7peu+oLcHlHg",
+	"9HVuM9MVbvWVyd6POvqjjv7QOuqO1R7dEt6v8jQpOAclpSGmZmpLO327WSvrngAqETP05LaqEkg2gcvo",
+	"/CdW4z/R+WtsorMYqZCao9Sox+ilbaWoZMR4ia4v39qn+nZa20Y53XEjLzP41WUCvGm47SQiydK+X71E",
+	"UNAe0nn0eWe6pkKNl6pMJ0zJkB9zOwz936zwelSFH4XspoWslof3ELOpxx8OnpyKbNR3rt1Erxcqj5hM",
+	"5aOQ9LG0loV+s/xRTj7KyUc52VZOam65l0eCqM4ZTVH5IUuE5GkoRfH2UgUuCgkAqDhPnXvntNG2UTkg",
+	"eRDVXbGzXh0ALFlIESDBzENLmhokRBPQSBOm7FmS3FLOkjnQAeac3uqwEXhd4DkpTnyMftIhnJjD7GkS",
+	"ZaFxckYo9+SYNQSCKgj1P9FYD8agdogaBGaACC8NM4mqx4BpC6NrYG0vujpDbF1cmmffDordiVnd3qTX",
+	"AI2Wij+pBEZLNXnpuQDZtmOlfEaHQ83maabUQ2z8al8Ni0xgUfVqXzPNji/zV7wONXptg5d2b7/V1imV",
+	"hFPc5qOPIVu4c5QP9iyxpFyXAtxt6jP1ZGqxt7l6Nh3nhix87ia0UBN9auLkFfFZr6OqSznHSWpqY/mg",
+	"Jl9UhPFoXeitIxPKtQoHZPidmLqb6ioYMg5ZRLIQYy8K09KFeduex0FPyGUMP8BB5NniNwZMJdAVtn3Y",
+	"PUZXqoavU0cKjVOJQixInyaCJIKqTpvtlgOTqcLUYjuLekXhrzlctar0FGzq0mnfcT47rBUOwhDOcbUq",
+	"lbUdxyeQaW7D8S1m6uhbjRe5U/qrHcVxL+jhZHmfu3l7FG+nnlND2GXjzb35zrmRz/jQloR3y8W3WT/o",
+	"7urfkEkT46kOb5MpT+xliS0mn2ksjKOEJf3K7zmr+RbmqUlf4XOnBn53OLWDsQRk8cdmCIsl8jcNni7O",
+	"b+EyfzUDlNXzb4BkmzcQ6qzuHmqhNYCDDa0wZ+y+hVIYiaTP6EoOgtqqlTESP9lO3FvwweixPQtUD5zr",
+	"d5rk4O8uACLvGN/Bf+FY/ocV4pABvlchDVowgNlQT8COZeIELEQkJpJUyfqV+t2SdQuf59QSoxlxi27O",
+	"AiGf12WXajiigyIyDXOTX+zfT/59+66wzUyygng1hbnE680By+VvXWzNOkS6ZUf8NtUGaFeTtyW/TKIP",
+	"qi+4V4VwFYZH7/Ahhiq0ZY5F6mEO7WBehz/0xdph3VWt1pPMdWEXPWmwEz1JQ2jbWBwSoxqUfhMnVnZf",
+	"044pqwpX7lNuoXtlMv5vnM33joc9/kIVCImqgZDW/6hNrBwH2pKHVjB5pZFpQ0JoNk6nCuqtNMb8PEW2",
+	"tZACySHrgcePjyN0lYDkPawUS4VyZx/KS93wafszk4ZzD+DU/aCxg8tlcDqrqRu58nFc9/V9GMRaAkKt",
+	"SsfzGn0rp4hdyYyfVDd16EygnIm6Jg94uGniBF43exUrkcV5+Xvlv3eK3wO8vcD8fJ8AcY1Bh4PGy/wv",
+	"9C+7a/9CqWpnoidAQNeYJgIJgnk4q1tWNtBoO3XJNpiumzn1i6jYhmN/RU2FRud+9u0a1xbG9RwvjdNZ",
+	"sSeQbN2pBn3gFlj3scEohNpSkqGr61W9MmpD5BWXKZr9vOPqPZmEafZU2ws4hwiwlDicOa3zNnqGfrvW",
+	"YFxFdcfTye/EvvRW5dhjg9GH4vwVSuwVi12U6qy2N44Zj7n3p1EvjUHoUChNHpVLzcSXUVQOA1nfmFTx",
+	"Si3sSKDCQzchYa0mDmViGql2Nh/VR5s3HRWTfzNWo8b8lg3GwzIUFW0+oI2YRfd4owIVef6N8a7cvyue",
+	"vzJ1wlT9PZUjoYa0pRYhtuTOpshp4tNhbVn5s4RkkcpN3K+/It1KY3Y0CLNOdWpL/rW+CZjFd20mfdcL",
+	"XUTFIsbL0T2hdIfZJrT5p/cB1gFgG7Ca2CMIU2qEo0340f3wZRunjrQQKuGsBapKA+y/C2KrbSLvGVZY",
+	"YhI3yrA5qHDrl8nqeFgViOZqYTZO6vACeNQq8iXsVSBPXr29jOL7mPSwuX8max7WswFD/h6tswGCd1pz",
+	"X6dx9jfpCfCw3LdpMWjrX53PHQ1/nWDX0OZUG6GQIOJqA2A6OH1LdQ35pfo5qxQKX6oPcUFe6CrWUD9b",
+	"6j4HY8gtgBZur20VYshJgKFwzAmO4AKECJ3fR5C4oYsFTJlEiLgfhDiBnJZxETBOECe/Ksz9BZFbwpdS",
+	"5TGQWKgCQXr90KgbfGwo4ssRT5MA0mPUe1RkoNpsQN37AQm8FLqEt7YistI11Uy/q1KK4+pmDGoGZ/Ax",
+	"ySBVt1gslQou3UhPr6lGizBLuqd58rMqCaSO6kImp2SmFPqCcDcd3ZRFr4FJbflI0N9rrKYng0HQm+Mv",
+	"dA7KznCg/qSJ+TODlyaSTAnfXtxPc/KkbdBh0lJ1U5TdR/8Y6iJ1YOrnhmx3Xi1ak22m+yKs+R3B5kMd",
+	"e0VSB1dMWq9qX8S/3eG6NFMlurJMU+1QSlCRfJ3zQQ9nDgi3uYA4+UPXpy0HeJfFF/ivhCtuoXoQBey9",
+	"c4azFaWPkfurEedC0jjWQjVPiw3cHkTlbG2dkkY5YneJcxpEJJvcl4X9inhh65zs+o5Pr6JeO8duAQkF",
+	"+A4spDyDvNCD4mH0sPPBi+3PWNg5KgyV6gCTfZEGDkX7kjYKVF5bE0fnfwjTzjn/IjDVHHA8RzFNbszf",
+	"jMmcTZW4gZ9t+mDf5vJpbXBOk36ppZbtzUN5lvdnvC6wvbagQ3GaY/TerlOrQY6+mDFUAXjVLCbJayGS",
+	"yYSEvgI9G5cIm1dNXNBq2om72BFEgrIoAt2SbGLis0A/toEoO1VaCphdxWiHKiDrxeNe1NLqICVAE3AW",
+	"t/ECFPnYyEGEv01R3iZ/n/pL5AFrDg1syWfsoGgd13Hx83LzvlvCx4DXbBmtYtteO8UPJqbtpTMPsg1Y",
+	"3VZdx+h/WIrmeIlwLJgqnV9T81YyMzRL0DyNJV3EheFFQ5VYZ6kdrmV86yn0Z9vGigpbUbum8obdc1UZ",
+	"gyHYee+y7rcqGLZ2MYbaul2YSYrjMq1Np5xMsSy02VMs5F+R9pset+nV2AG2q4m9aj6CA/Qo0DfQd6Ak",
+	"mmticNe4gCjNB6NpzMY4hsOZ3eEkJIBBU4TgyCHiowAdFfb/COygI8DiUbEENhXoSHl3jo5XFHHRU4tt",
+	"RTq7VRb0W7aEilFBdLkvxBLVPmwOUjAPygnKhBY4VRpcUavq2QjBQoqtk0wRhhKxlERqzO/E96uwYWN5",
+	"drLrap26OA/AWthpW6THeHHVqyZTXFGJ4rhauQdv+Ipjr7+xWw0HzjDTvXJFhakPsHyF89ve3Ru68NYU",
+	"snjv6AoVVVEzf0uV0bysxHW+ZN1xWbf9P0aXSYTCLHDHFYYgP9Qhptwqc5xEqzTId3q+B9UjxYKEdLI0",
+	"4lB1FxIlwgZni77or+F39VVzX+H2yl8bqa7LomW7km1c1s7JVeO76A/mbNiZordyIQUU3XcpW9fwVi4H",
+	"dvi+q9iwRrfG+amX1aQ8Fc7+o78qBvnhyKiEbHLoWtWjzrE5ncMcAm1jlSxL6Vg5EkdFYf2ofWxS+8C3",
+	"mMZ4HJMq2kuHZAftxNyEbd6LBQMv6yOm35uJD7mSarko6orXVchHtxnUJ2D81ZZerfO82RvOPa8AuWtB",
+	"p6hyDcNKU3NXo2q/jBdLEnWxUBo5K4vwOZy7jcscDUXNNY7ehodpQagnf/1FkiQiUac6fJp8DopssqJ3",
+	"hvT9Ve8ykimcJa0L35nPW0XNLrK9f/jSd4YOu9e++xYDMrMKdDklrRI/dTXo1qOXw6xC10LcFI+lRzJs",
+	"kdrfngYbSr2tR4aHWOyt3WGcrWx3MROP3LGlomttGUQd+MVCazspyaRsR7eE0r4EPRjXtVX5si4Boemy",
+	"oV7CVkM8EvouFifRCeOIzDGNj9HLlHOSyHgZ6O9H2ccgScxlr04rJG4XchjqRI1hMhD/qfvi62Cyf/bQ",
+	"AktJeFLnripOVuOwUj0dHIeVgacXmCdtXHUvS5UH8vI+xXIr+tQ+RleTMiKoMJimcP+pagQBuieUC5kN",
+	"p31/Y4IWNLyBaRZ6ixoTMt3Cd/n6yRc8X8T2+WUA//2xTcr19UyTuKFp0yRCzRlkJf8dwlc2g/7rGL3S",
+	"WFeIyF6qAxxmGalZ/CEuIuxpadAueqWv6AhHESeiuC8AjcYhoNPpqhqy+Zgm9qq5tGM55hvWoIi3m6v8",
+	"I5G2JaFMhS2BaGkHjXF4U4sx9UkdnSfgY4scSs9/iaiw/8Rx3AqfLauvoY86KtH4rhVm6TQpOGSUULrI",
+	"mSZAFm37ULjtsUzZY5myGu9Zfl4f3oVAAfb9cujlfFbn7rdvGIUtyxhpSHK8rkT3zVNhskcmSsgb3VCv",
+	"w16l5RHvNimuorppl9IHM/aW3Id2eL/N8iFbGEOcTKmQhBtAduNEzFbfBJyFrJCrvJsUNZ7Bd1BVkzS+",
+	"MujFzpJgsj2jIku5KG7fflRRMgjCOWvTxOVaR2hkJFqbEPMaktmckQRSxKNkvjnG4b49zxf+S74zHiwp",
+	"a1AX9pJMlxiityQpAFd2wwgCaZ52t7crSn5M45tmcVKkQhW5ebtbf4iFBA6HZkj3QLiIP4F02Su2NhSH",
+	"5sB2DqtJxJI63i7qA38o7RWY7av+99eTP9rd4TjneTdXjP3wZztzG4+M+1GX91vno9oPUJqUOeVQCoIk",
+	"FYr9hv2aCa+efXWHXd210yGT+GAnqutlrg0caBukR1b5yfjTnNzlgo7nKGw0WaEvFlnoLbs9tGNie9ok",
+	"IMO3O58ytEumT/Ninh17EH2y0eZROvvOtcjcLDgkGaN29FHIvDXVfB0/DE6YugxZYYsqfTUrxr3j0r4H",
+	"HaWqyqC2DCDNC55mWzReIrey5lp1YbvUr22x+Ldww/TSXleuEan77dY6XbF4HOVuexNZnidc5VXhj1s2",
+	"8MdRNNLD+Bv421Xp0icjpVdk/x6Fpic8DkMihGc1nRv+/5LQ31KS3xOzSdGTDotlY6Bop6Did/r6Lou+",
+	"13ia00To+n10kmXXBEjiGyLQgpOQRETl39wSnZZNI5JIKpdoRnBE+Per7oC63YZee4oMfCe+d7KWLDkH",
+	"uuqVez4xjmwlYnOJoL6HfB9dNBwniOT58vtcrOC6kKbpx8CW1uVgdNPJ9LVC2ooo3/Z1qUbdpRD1Vo1M",
+	"FpP8gvHVMsFzGra9Z1QH+cEmPnPdx2aPSyVX7hdht1bmC6iXtmRTmVLFHhvF1H99gDwBmBoKqF7qU6tL",
+	"ngAg+xDb9edw78CmyQ3lhEk02SfrJkua0N1KGpkmt2dap0sYTmoRdWzLHz98qoRixO6JEvtQ0RFg3i1p",
+	"72/uhiKouqDg7BiodaB3Jtzt5Wx0NBa3reysOClcnebwfOos/sb96W0ZZwHGRJV13sPP3ZnnEDNNtOIP",
+	"WPClmsADXc7f0tQDMiW8gdSOHVoNToXGb5srNSm15sv6JLBvhy2buUFjw2HOltdUHp4qNGg5vPYrj9lj",
+	"rbiqYvho9657reNVIA0JtmK5zJh+KB3yHvc/29Q4NQ67R/ADPvU2Wf+asW4PzmMBeqlZib3N+MZV1BwZ",
+	"7flXJ1A1suxHm2O1NWI2M3RxsAnC4TpGmC/3Yxfe6QunImw52s0yNeLvyHjG2E0D6sFz+8m8tctr622K",
+	"LbOe7nLLoOtwLwXsfu/dvYAFzA0LGy/9gsNsX+GmoJRRgMOZkzI9w8mU6ExI4AoaEjRX96pUIBggzxQw",
+	"YBwJ9MuHN7qnkpnt9S2BtMpXJKa3RLVGCzHnGkJ9AyvQ/+u/52Mc9tW7Qfan+WaZ/3JN50RIPF+oC1/7",
+	"60c6TbBMOQlMwVKAd4ZPnzz9AU2YLgFokTIjX9Df316+7H/8++Xpk6cBuiHL/Gm+DEFCTmRgD11pJw50",
+	"zW7Koqz52phFy8ICVbOgicreVb3eJKc2J5d80RQACeSQGcsmk+OaBC27W9sxKMzo/lsb87AQdW+rmrfu",
+	"JjbcNKxNkD5gNsWdhe4Qcyn2Ta45GVL2zKgQXlWcFU7k1tc4OYO1sGTucpZ4+MscS/UHfJ9T4Jpv+krn",
+	"riLCWh3gdfr+ulR9mNW5Go6Gy5LWeXDm8TfPIvrmZi3+UCdC1qNxhZWWvfensdPsitaw1OynB2yr5fu5",
+	"d9ZaBlqtdyfbuwYz7VM+jO28jNECc9uQ2jQxRRHlJJRQFyWJiKtFHZX7I9bZIM7zrVghdvwaO8TNxdGL",
+	"Unh7ABMkT3ZpAtMg/jEXp0vUWgH4vQofy4VhG24tnTl/rOpJ7HCx017YZHK4ooI5Oe8BUuYQlroPcaHd",
+	"cFBoMaxNg8Bv+jh83e3Eyzm2bSZ3zh2HbK4UGexP2Ug436i97SJsTaZOfNlgK22LDQa7OXguK+ra4Rk5",
+	"D8tYe2TmdKRpb0Wgt+xWl7jLR1OqX0KwnCEqBYkncICoxoYT+MGWu8SJFDq7/FcS+g4OHVuwSY55WHXS",
+	"BIx0UScHu1YnC4E4j+pkuxCgR4nS+0B0VqUpR7QJRRYnIRGS8ba+lEv7/gEcrc1eEshkDGegkuviz0B5",
+	"jnKeLuwdaJ1p/XgkHxwD5b2+LBnrbs0ZagKUEMyJkLridQdGCmc0jjhJWvLRS/P6/dgoePRkSvHIiAfM",
+	"iI7EzbyaY6L1Wn/9zAIjwqAkTDmVS8U8YyxoOIJt71384zOQsA770qyV8rh30TvBC3rCxzg8uR0qKjcj",
+	"l8nnneVegfAYfLELty+CuUXLSwB/DVYOoEsv5B+r9nNtPrSdws13H3RU5WqA81ZiFlzbKWvlt9iGA5sv",
+	"bYT66i+zCDvzpY0jXP1lyJkQtmQ7MpaEM9JLeH6pH3/QT9sMe+deBJmhHE199QAm6X/B2S0FTqbJNB/p",
+	"HZ/ihP5uZFiLvczqRy5iHOqqOT4I8xJRq8ec0yn8kEwNqELXoPjw4+VLdDvMx7yaLxhvhTMIYoLx0oUd",
+	"Mh/l9Ze2oyRM2p4dBi44bnVErI5Cc7ckuxddvaMzZr6PqoSaRhROr6//fwBzi/Y88IUBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
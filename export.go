@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"os"
+
+	"github.com/merlante/prbac-spicedb/server"
+)
+
+// runExport prints an organization's RBAC state in the form runImport takes, e.g.
+//
+//	prbac-spicedb export -org 12345 > export.json
+//
+// Role and group names are kept outside SpiceDB, so the command exports roles and groups named after their ids. Export
+// through the running service instead to keep the names.
+func runExport(config *Config, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	org := flags.String("org", "", "organization to export")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *org == "" || flags.NArg() != 0 {
+		flags.Usage()
+		return errors.New("an organization is required")
+	}
+
+	// Role access is read back into permissions by the ones services.json configures, among others
+	services, err := getServiceMappingSource(config).Load()
+	if err != nil {
+		return err
+	}
+
+	spiceDbClient, conn, err := server.GetSpiceDbClient(config.SpiceDB)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	db, err := openDatabase(config)
+	if err != nil {
+		return err
+	}
+	if db != nil {
+		defer db.Close()
+	}
+	metadata, err := getMetadataStore(db)
+	if err != nil {
+		return err
+	}
+
+	prbacServer := server.PrbacSpicedbServer{
+		RbacServices:  services,
+		SpicedbClient: spiceDbClient,
+		Metadata:      metadata,
+		Consistency:   config.SpiceDB.Consistency,
+	}
+
+	export, err := prbacServer.Export(context.Background(), *org)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(export)
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"os"
+
+	"github.com/merlante/prbac-spicedb/api"
+	"github.com/merlante/prbac-spicedb/server"
+)
+
+// runImport imports an RBAC v1 export file into an organization and prints the import report, e.g.
+//
+//	prbac-spicedb import -org 12345 [-dry-run] [-batch-size 500] export.json
+//
+// Role and group names go to the database the service keeps them in, so there must be one unless nothing is written.
+func runImport(config *Config, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	org := flags.String("org", "", "organization to import into")
+	dryRun := flags.Bool("dry-run", false, "report what would be imported without writing anything")
+	batchSize := flags.Int("batch-size", server.DefaultImportBatchSize, "number of relationships to write per request to SpiceDB")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *org == "" || flags.NArg() != 1 {
+		flags.Usage()
+		return errors.New("an organization and an export file are required")
+	}
+	if config.Database.DSN == "" && !*dryRun {
+		return errors.New("a database is required to import, or names would be lost, set DATABASE_DSN or use -dry-run")
+	}
+
+	bytes, err := os.ReadFile(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	var export api.RbacExport
+	if err := json.Unmarshal(bytes, &export); err != nil {
+		return err
+	}
+
+	spiceDbClient, conn, err := server.GetSpiceDbClient(config.SpiceDB)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	auditLog, err := getAuditLog(config)
+	if err != nil {
+		return err
+	}
+
+	db, err := openDatabase(config)
+	if err != nil {
+		return err
+	}
+	if db != nil {
+		defer db.Close()
+	}
+	metadata, err := getMetadataStore(db)
+	if err != nil {
+		return err
+	}
+
+	prbacServer := server.PrbacSpicedbServer{
+		SpicedbClient: spiceDbClient,
+		Metadata:      metadata,
+		Audit:         auditLog,
+		Consistency:   config.SpiceDB.Consistency,
+	}
+
+	report, err := prbacServer.Import(context.Background(), *org, export, *dryRun, *batchSize)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(report)
+}
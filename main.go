@@ -1,69 +1,299 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"github.com/go-chi/chi/v5"
 	"github.com/merlante/prbac-spicedb/api"
-	"io"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 
+	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/merlante/prbac-spicedb/server"
-)
-
-var (
-	spiceDBURL   = "localhost:50051"
-	spiceDBToken = "foobar"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 func main() {
-	overwriteVarsFromEnv()
+	if len(os.Args) > 1 && (os.Args[1] == "import" || os.Args[1] == "export") {
+		run := runImport
+		if os.Args[1] == "export" {
+			run = runExport
+		}
+
+		// Subcommands have flags of their own, so they are configured through the environment and config file only
+		config, err := loadConfig(nil)
+		if err == nil {
+			err = run(config, os.Args[2:])
+		}
+		if err != nil {
+			fmt.Printf("[ERROR] %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
 
-	services, err := getRbacServices()
+	config, err := loadConfig(os.Args[1:])
 	if err != nil {
-		fmt.Errorf("%v", err)
+		fmt.Printf("[ERROR] %v\n", err)
 		os.Exit(1)
 	}
 
-	spiceDbClient, err := server.GetSpiceDbClient(spiceDBURL, spiceDBToken)
+	// Background work stops, and requests in flight are drained, on SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
+
+	shutdownTracing, err := setupTracing(ctx, config)
+	if err != nil {
+		fmt.Printf("[ERROR] %v\n", err)
+		os.Exit(1)
+	}
+
+	spiceDbClient, conn, err := server.GetSpiceDbClient(config.SpiceDB)
+	if err != nil {
+		fmt.Printf("[ERROR] %v\n", err)
+		os.Exit(1)
+	}
+
+	auditLog, err := getAuditLog(config)
+	if err != nil {
+		fmt.Printf("[ERROR] %v\n", err)
+		os.Exit(1)
+	}
+
+	db, err := openDatabase(config)
+	if err != nil {
+		fmt.Printf("[ERROR] %v\n", err)
+		os.Exit(1)
+	}
+	metadata, err := getMetadataStore(db)
+	if err != nil {
+		fmt.Printf("[ERROR] %v\n", err)
+		os.Exit(1)
+	}
+	webhooks, err := getWebhookStore(db)
 	if err != nil {
-		fmt.Errorf("%v", err)
+		fmt.Printf("[ERROR] %v\n", err)
 		os.Exit(1)
 	}
 
-	server := server.PrbacSpicedbServer{
-		RbacServices:  services,
+	prbacServer := server.PrbacSpicedbServer{
+		BasePath:      config.BasePath,
 		SpicedbClient: spiceDbClient,
+		Metadata:      metadata,
+		Webhooks:      server.NewWebhookNotifier(webhooks),
+		Audit:         auditLog,
+		Consistency:   config.SpiceDB.Consistency,
 	}
-	r := api.Handler(api.NewStrictHandler(&server, nil))
 
-	http.ListenAndServe(":8080", r)
-}
+	reloader := &server.ServicesReloader{Server: &prbacServer, Source: getServiceMappingSource(config), Interval: config.Services.ReloadInterval}
+	if _, err := reloader.Reload(); err != nil {
+		fmt.Printf("[ERROR] %v\n", err)
+		os.Exit(1)
+	}
+	if config.Services.ReloadInterval > 0 {
+		go reloader.Run(ctx)
+	}
+
+	var handler api.StrictServerInterface = &prbacServer
+	var shadow *server.ShadowServer
+	if config.Shadow.URL != "" {
+		shadow, err = server.NewShadowServer(&prbacServer, config.Shadow.URL, server.ShadowPrimary(config.Shadow.Primary))
+		if err != nil {
+			fmt.Printf("[ERROR] %v\n", err)
+			os.Exit(1)
+		}
+		shadow.Timeout = config.Shadow.Timeout
+		handler = shadow
+	}
+
+	// Background work that stops with an error shuts the service down, the way SIGTERM does
+	failed := make(chan error, 2)
+
+	if len(config.KafkaBrokers) != 0 {
+		stream := server.NewKafkaChangeStream(config.KafkaBrokers, config.Replication.Topic, config.Replication.GroupId)
+		replicator := &server.Replicator{Server: &prbacServer, Stream: stream}
+
+		go func() {
+			defer stream.Close()
 
-func getRbacServices() (services server.Services, err error) {
-	servicesFile, err := os.Open("services.json")
+			if err := replicator.Run(ctx); err != nil {
+				failed <- fmt.Errorf("replication stopped: %w", err)
+			}
+		}()
+	}
+
+	if config.Watch.Sink != "" {
+		sink, err := getWatchSink(config)
+		if err != nil {
+			fmt.Printf("[ERROR] %v\n", err)
+			os.Exit(1)
+		}
+
+		watcher := &server.Watcher{Server: &prbacServer, Sink: sink, Cursor: &server.FileCursorStore{Path: config.Watch.CursorFile}}
+
+		go func() {
+			if err := watcher.Run(ctx); err != nil {
+				failed <- fmt.Errorf("change feed stopped: %w", err)
+			}
+		}()
+	}
+
+	spec, err := server.GetOpenAPISpec(config.BasePath)
 	if err != nil {
-		return nil, err
+		fmt.Printf("[ERROR] %v\n", err)
+		os.Exit(1)
 	}
-	defer servicesFile.Close()
+	specHandler, err := server.OpenAPIHandler(spec)
+	if err != nil {
+		fmt.Printf("[ERROR] %v\n", err)
+		os.Exit(1)
+	}
+	validation, err := server.ValidationMiddleware(spec)
+	if err != nil {
+		fmt.Printf("[ERROR] %v\n", err)
+		os.Exit(1)
+	}
+
+	r := chi.NewRouter()
+	r.Handle("/metrics", promhttp.Handler())
+	r.Get(config.BasePath+"/openapi.json", specHandler)
+	r.Group(func(r chi.Router) {
+		r.Use(server.TracingMiddleware)
+		r.Use(server.MetricsMiddleware)
+		r.Use(server.RecoveryMiddleware)
+		r.Use(server.IdentityMiddleware(config.Dev))
+		r.Use(validation)
+		api.HandlerFromMuxWithBaseURL(api.NewStrictHandler(handler, []api.StrictMiddlewareFunc{
+			server.AuthorizationMiddleware(&prbacServer),
+			server.OperationMetricsMiddleware,
+			server.OperationTracingMiddleware,
+		}), r, config.BasePath)
+	})
+
+	httpServer := &http.Server{
+		Addr:         config.Listen,
+		Handler:      r,
+		ReadTimeout:  config.HTTP.ReadTimeout,
+		WriteTimeout: config.HTTP.WriteTimeout,
+		IdleTimeout:  config.HTTP.IdleTimeout,
+	}
+
+	served := make(chan error, 1)
+	go func() {
+		served <- httpServer.ListenAndServe()
+	}()
+
+	var failure error
+	select {
+	case failure = <-served:
+	case failure = <-failed:
+	case <-ctx.Done():
+	}
+	if failure != nil {
+		fmt.Printf("[ERROR] %v\n", failure)
+	}
+	stop() // for the rest of the background work to stop too
 
-	bytes, err := io.ReadAll(servicesFile)
+	fmt.Printf("[INFO] Shutting down, waiting up to %s for requests in flight\n", config.HTTP.ShutdownTimeout)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), config.HTTP.ShutdownTimeout)
+	defer cancel()
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		fmt.Printf("[WARN] requests still in flight at shutdown: %v\n", err)
+	}
+	if shadow != nil {
+		shadow.Wait() // each comparison is bounded by the shadow timeout
+	}
+	if err := prbacServer.Webhooks.Shutdown(shutdownCtx); err != nil {
+		fmt.Printf("[WARN] webhook deliveries abandoned at shutdown: %v\n", err)
+	}
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		fmt.Printf("[WARN] exporting the last spans: %v\n", err)
+	}
+	if err := conn.Close(); err != nil {
+		fmt.Printf("[WARN] closing the SpiceDB connection: %v\n", err)
+	}
+	if db != nil {
+		if err := db.Close(); err != nil {
+			fmt.Printf("[WARN] closing the database: %v\n", err)
+		}
+	}
+
+	if failure != nil {
+		os.Exit(1)
+	}
+}
+
+func getWatchSink(config *Config) (server.ChangeSink, error) {
+	switch config.Watch.Sink {
+	case "log":
+		return server.LogChangeSink{}, nil
+	case "webhook":
+		if config.Watch.WebhookURL == "" {
+			return nil, fmt.Errorf("WATCH_WEBHOOK_URL is required for the webhook sink")
+		}
+		return &server.WebhookChangeSink{URL: config.Watch.WebhookURL}, nil
+	case "kafka":
+		if len(config.KafkaBrokers) == 0 {
+			return nil, fmt.Errorf("KAFKA_BROKERS is required for the kafka sink")
+		}
+		return server.NewKafkaChangeSink(config.KafkaBrokers, config.Watch.KafkaTopic), nil
+	}
+
+	return nil, fmt.Errorf("unknown watch sink %q, expected log, webhook or kafka", config.Watch.Sink)
+}
+
+func getAuditLog(config *Config) (server.AuditLog, error) {
+	if config.Audit.SQLDSN == "" {
+		return &server.FileAuditLog{Path: config.Audit.LogFile}, nil
+	}
+
+	db, err := sql.Open("pgx", config.Audit.SQLDSN)
 	if err != nil {
 		return nil, err
 	}
 
-	json.Unmarshal(bytes, &services)
+	return server.NewSQLAuditLog(db)
+}
+
+// openDatabase opens the database metadata and webhooks are kept in, or returns nil in dev mode when there is none.
+func openDatabase(config *Config) (*sql.DB, error) {
+	if config.Database.DSN == "" {
+		fmt.Println("[WARN] No DATABASE_DSN, names, descriptions and webhooks are kept in memory and lost on restart")
+		return nil, nil
+	}
+
+	return sql.Open("pgx", config.Database.DSN)
+}
+
+func getMetadataStore(db *sql.DB) (server.MetadataStore, error) {
+	if db == nil {
+		return server.NewInMemoryMetadataStore(), nil
+	}
+
+	return server.NewSQLMetadataStore(db)
+}
 
-	return
+func getWebhookStore(db *sql.DB) (server.WebhookStore, error) {
+	if db == nil {
+		return server.NewInMemoryWebhookStore(), nil
+	}
+
+	return server.NewSQLWebhookStore(db)
 }
 
-func overwriteVarsFromEnv() {
-	envSpicedbUrl := os.Getenv("SPICEDB_URL")
-	if envSpicedbUrl != "" {
-		spiceDBURL = envSpicedbUrl
+func getServiceMappingSource(config *Config) server.ServiceMappingSource {
+	sources := server.LayeredServices{&server.ServicesFile{Path: config.Services.File}}
+	if config.Services.Dir != "" {
+		sources = append(sources, &server.ServicesDirectory{Path: config.Services.Dir})
 	}
-	envSpicedbPsk := os.Getenv("SPICEDB_PSK")
-	if envSpicedbPsk != "" {
-		spiceDBToken = envSpicedbPsk
+	if config.Services.ConfigMap != "" {
+		sources = append(sources, &server.ConfigMapServices{Path: config.Services.ConfigMap})
 	}
+
+	return sources
 }
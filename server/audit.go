@@ -0,0 +1,454 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/google/uuid"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+// Outcomes of the writes recorded in the audit log.
+const (
+	AuditSuccess = "success"
+	AuditFailure = "failure"
+)
+
+// AuditEntry records one write to SpiceDB: who made it, in which organization, through which operation, to which
+// objects, the relationship updates written, and how it turned out.
+type AuditEntry struct {
+	Id        string        `json:"id"`
+	Time      time.Time     `json:"time"`
+	OrgId     string        `json:"org_id"`
+	Actor     AuditActor    `json:"actor"`
+	Operation string        `json:"operation"`
+	Targets   []AuditTarget `json:"targets"`
+	Updates   []AuditUpdate `json:"updates"`
+	ZedToken  string        `json:"zed_token,omitempty"`
+	Outcome   string        `json:"outcome"`
+	Error     string        `json:"error,omitempty"`
+}
+
+// AuditActor is the caller of an operation, as its identity header describes them. Writes made by replication or
+// the command line have no actor.
+type AuditActor struct {
+	Username   string `json:"username"`
+	IsOrgAdmin bool   `json:"is_org_admin"`
+}
+
+// AuditTarget is an object an operation was asked to change, e.g. the group principals were added to.
+type AuditTarget struct {
+	Type string `json:"type"`
+	Id   string `json:"id"`
+}
+
+// AuditUpdate is a relationship update, with the relationship as resource#relation@subject.
+type AuditUpdate struct {
+	Operation    string `json:"operation"`
+	Relationship string `json:"relationship"`
+}
+
+// AuditQuery selects the entries of an organization. Zero values select everything: Start and End bound the time
+// of the entries, End exclusively, and Target matches the id of any of an entry's targets.
+type AuditQuery struct {
+	OrgId     string
+	Start     time.Time
+	End       time.Time
+	Actor     string
+	Target    string
+	Operation string
+	Limit     int
+	Offset    int
+}
+
+func (q AuditQuery) matches(entry AuditEntry) bool {
+	return entry.OrgId == q.OrgId &&
+		(q.Start.IsZero() || !entry.Time.Before(q.Start)) &&
+		(q.End.IsZero() || entry.Time.Before(q.End)) &&
+		(q.Actor == "" || entry.Actor.Username == q.Actor) &&
+		(q.Operation == "" || entry.Operation == q.Operation) &&
+		(q.Target == "" || slices.ContainsFunc(entry.Targets, func(target AuditTarget) bool { return target.Id == q.Target }))
+}
+
+// AuditLog is an append-only record of writes. Query returns a page of the matching entries, newest first, and how
+// many entries match in all.
+type AuditLog interface {
+	Append(entry AuditEntry) error
+	Query(query AuditQuery) ([]AuditEntry, int, error)
+}
+
+// FileAuditLog appends entries to a file as JSON lines.
+type FileAuditLog struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+func (l *FileAuditLog) Append(entry AuditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	file, err := os.OpenFile(l.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// Query reads the whole file, which is only fit for modest logs; the SQL backend is for the rest.
+func (l *FileAuditLog) Query(query AuditQuery) ([]AuditEntry, int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	file, err := os.Open(l.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	defer file.Close()
+
+	var matching []AuditEntry
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024) // an import writes many updates in one entry
+	for line := 1; scanner.Scan(); line++ {
+		var entry AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, 0, fmt.Errorf("%s line %d: %w", l.Path, line, err)
+		}
+		if query.matches(entry) {
+			matching = append(matching, entry)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	// Entries are appended as they happen, so the newest are last
+	slices.Reverse(matching)
+
+	start := min(max(query.Offset, 0), len(matching))
+	end := len(matching)
+	if query.Limit > 0 {
+		end = min(start+query.Limit, end)
+	}
+
+	return matching[start:end], len(matching), nil
+}
+
+// SQLAuditLog keeps entries in a SQL database, in the audit_log table, with the ids of their targets in
+// audit_log_targets. Statements use $n placeholders, as PostgreSQL and SQLite both accept.
+type SQLAuditLog struct {
+	db *sql.DB
+}
+
+// NewSQLAuditLog creates the audit log's tables in db unless they exist.
+func NewSQLAuditLog(db *sql.DB) (*SQLAuditLog, error) {
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS audit_log (
+			id TEXT PRIMARY KEY,
+			time BIGINT NOT NULL,
+			org_id TEXT NOT NULL,
+			actor TEXT NOT NULL,
+			actor_is_org_admin BOOLEAN NOT NULL,
+			operation TEXT NOT NULL,
+			targets TEXT NOT NULL,
+			updates TEXT NOT NULL,
+			zed_token TEXT NOT NULL,
+			outcome TEXT NOT NULL,
+			error TEXT NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS audit_log_org_time ON audit_log (org_id, time)`,
+		`CREATE TABLE IF NOT EXISTS audit_log_targets (
+			entry_id TEXT NOT NULL REFERENCES audit_log (id),
+			target_id TEXT NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS audit_log_targets_target ON audit_log_targets (target_id)`,
+	}
+
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			return nil, fmt.Errorf("creating audit log tables: %w", err)
+		}
+	}
+
+	return &SQLAuditLog{db: db}, nil
+}
+
+func (l *SQLAuditLog) Append(entry AuditEntry) error {
+	targets, err := json.Marshal(entry.Targets)
+	if err != nil {
+		return err
+	}
+	updates, err := json.Marshal(entry.Updates)
+	if err != nil {
+		return err
+	}
+
+	tx, err := l.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`INSERT INTO audit_log (id, time, org_id, actor, actor_is_org_admin, operation, targets, updates, zed_token, outcome, error)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		entry.Id, entry.Time.UnixNano(), entry.OrgId, entry.Actor.Username, entry.Actor.IsOrgAdmin, entry.Operation,
+		string(targets), string(updates), entry.ZedToken, entry.Outcome, entry.Error)
+	if err != nil {
+		return err
+	}
+
+	for _, target := range entry.Targets {
+		if _, err := tx.Exec(`INSERT INTO audit_log_targets (entry_id, target_id) VALUES ($1, $2)`, entry.Id, target.Id); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (l *SQLAuditLog) Query(query AuditQuery) ([]AuditEntry, int, error) {
+	conditions := []string{"org_id = $1"}
+	args := []interface{}{query.OrgId}
+	condition := func(format string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(format, len(args)))
+	}
+
+	if !query.Start.IsZero() {
+		condition("time >= $%d", query.Start.UnixNano())
+	}
+	if !query.End.IsZero() {
+		condition("time < $%d", query.End.UnixNano())
+	}
+	if query.Actor != "" {
+		condition("actor = $%d", query.Actor)
+	}
+	if query.Operation != "" {
+		condition("operation = $%d", query.Operation)
+	}
+	if query.Target != "" {
+		condition("id IN (SELECT entry_id FROM audit_log_targets WHERE target_id = $%d)", query.Target)
+	}
+	where := strings.Join(conditions, " AND ")
+
+	var total int
+	if err := l.db.QueryRow(`SELECT COUNT(*) FROM audit_log WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	page := `SELECT id, time, org_id, actor, actor_is_org_admin, operation, targets, updates, zed_token, outcome, error
+		FROM audit_log WHERE ` + where + ` ORDER BY time DESC, id DESC`
+	limit := query.Limit
+	if limit <= 0 {
+		limit = math.MaxInt32 // SQLite only takes an OFFSET after a LIMIT, and PostgreSQL takes no LIMIT -1
+	}
+	args = append(args, limit, max(query.Offset, 0))
+	page += fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+
+	rows, err := l.db.Query(page, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var entries []AuditEntry
+	for rows.Next() {
+		var entry AuditEntry
+		var nanos int64
+		var targets, updates string
+		if err := rows.Scan(&entry.Id, &nanos, &entry.OrgId, &entry.Actor.Username, &entry.Actor.IsOrgAdmin, &entry.Operation,
+			&targets, &updates, &entry.ZedToken, &entry.Outcome, &entry.Error); err != nil {
+			return nil, 0, err
+		}
+
+		entry.Time = time.Unix(0, nanos).UTC()
+		if err := json.Unmarshal([]byte(targets), &entry.Targets); err != nil {
+			return nil, 0, err
+		}
+		if err := json.Unmarshal([]byte(updates), &entry.Updates); err != nil {
+			return nil, 0, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, total, rows.Err()
+}
+
+// auditEntry starts the entry of an operation made by the caller in the caller's organization.
+func auditEntry(ctx context.Context, operation string, targets ...AuditTarget) AuditEntry {
+	entry := AuditEntry{OrgId: getUserOrg(ctx), Operation: operation, Targets: targets}
+	if identity, ok := identityFromContext(ctx); ok {
+		entry.Actor = AuditActor{Username: identity.Username, IsOrgAdmin: identity.IsOrgAdmin}
+	}
+
+	return entry
+}
+
+func auditTargets(objectType string, ids ...string) []AuditTarget {
+	targets := make([]AuditTarget, len(ids))
+	for i, id := range ids {
+		targets[i] = AuditTarget{Type: objectType, Id: id}
+	}
+
+	return targets
+}
+
+// writeRelationships writes updates in one request, subject to any preconditions, and records the write, whether it
+// succeeded or not, in the audit log. Every write the service makes on behalf of a tenant goes through here. A
+// failure to record the write is logged rather than failing an operation whose write has already happened.
+func (p *PrbacSpicedbServer) writeRelationships(ctx context.Context, entry AuditEntry, updates []*v1.RelationshipUpdate, preconditions ...*v1.Precondition) (*v1.WriteRelationshipsResponse, error) {
+	resp, err := p.SpicedbClient.WriteRelationships(ctx, &v1.WriteRelationshipsRequest{
+		Updates:               updates,
+		OptionalPreconditions: preconditions,
+	})
+	if err == nil {
+		p.recordWritten(resp.GetWrittenAt())
+	}
+
+	if p.Audit == nil {
+		return resp, err
+	}
+
+	entry.Id = uuid.NewString()
+	entry.Time = time.Now().UTC()
+	entry.Updates = make([]AuditUpdate, len(updates))
+	for i, update := range updates {
+		entry.Updates[i] = AuditUpdate{
+			Operation:    strings.TrimPrefix(update.GetOperation().String(), "OPERATION_"),
+			Relationship: formatRelationship(update.GetRelationship()),
+		}
+	}
+
+	entry.Outcome = AuditSuccess
+	if err != nil {
+		entry.Outcome = AuditFailure
+		entry.Error = err.Error()
+	} else {
+		entry.ZedToken = resp.GetWrittenAt().GetToken()
+	}
+
+	if auditErr := p.Audit.Append(entry); auditErr != nil {
+		fmt.Printf("[ERROR] Recording %s by %q in org %s in the audit log: %v\n", entry.Operation, entry.Actor.Username, entry.OrgId, auditErr)
+	}
+
+	return resp, err
+}
+
+// formatRelationship formats a relationship as SpiceDB's tooling does, e.g. group:admins#member@user:alice.
+func formatRelationship(relationship *v1.Relationship) string {
+	formatted := fmt.Sprintf("%s:%s#%s@%s:%s",
+		relationship.GetResource().GetObjectType(), relationship.GetResource().GetObjectId(),
+		relationship.GetRelation(),
+		relationship.GetSubject().GetObject().GetObjectType(), relationship.GetSubject().GetObject().GetObjectId())
+
+	if relation := relationship.GetSubject().GetOptionalRelation(); relation != "" {
+		formatted += "#" + relation
+	}
+
+	return formatted
+}
+
+func (p *PrbacSpicedbServer) ListAuditLog(ctx context.Context, request api.ListAuditLogRequestObject) (api.ListAuditLogResponseObject, error) {
+	if !isOrgAdmin(ctx) {
+		return api.ListAuditLog403JSONResponse(forbiddenBody("only organization administrators can read the audit log")), nil
+	}
+	if p.Audit == nil {
+		return api.ListAuditLog500JSONResponse(errorBody(500, "the audit log is not enabled")), nil
+	}
+
+	params := request.Params
+	query := AuditQuery{
+		OrgId:     getUserOrg(ctx),
+		Actor:     valueOrEmpty(params.Actor),
+		Target:    valueOrEmpty(params.Target),
+		Operation: valueOrEmpty(params.Operation),
+		Limit:     defaultLimit,
+	}
+	if params.Start != nil {
+		query.Start = *params.Start
+	}
+	if params.End != nil {
+		query.End = *params.End
+	}
+	if !query.Start.IsZero() && !query.End.IsZero() && !query.Start.Before(query.End) {
+		return api.ListAuditLog400JSONResponse(errorBody(400, "start must be before end")), nil
+	}
+	if params.Limit != nil && *params.Limit > 0 {
+		query.Limit = *params.Limit
+	}
+	if params.Offset != nil && *params.Offset > 0 {
+		query.Offset = *params.Offset
+	}
+
+	entries, total, err := p.Audit.Query(query)
+	if err != nil {
+		return api.ListAuditLog500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	data := make([]api.AuditLogEntry, len(entries))
+	for i, entry := range entries {
+		data[i] = toApiAuditLogEntry(entry)
+	}
+
+	return api.ListAuditLog200JSONResponse{
+		Data:  data,
+		Links: p.paginationLinks("/audit-log/", total, min(query.Offset, total), query.Limit),
+		Meta:  paginationMeta(total),
+	}, nil
+}
+
+func toApiAuditLogEntry(entry AuditEntry) api.AuditLogEntry {
+	apiEntry := api.AuditLogEntry{
+		Id:        uuid.MustParse(entry.Id),
+		Time:      entry.Time,
+		OrgId:     entry.OrgId,
+		Operation: entry.Operation,
+		Targets:   make([]api.AuditTarget, len(entry.Targets)),
+		Updates:   make([]api.AuditUpdate, len(entry.Updates)),
+		Outcome:   api.AuditLogEntryOutcome(entry.Outcome),
+	}
+	apiEntry.Actor.Username = entry.Actor.Username
+	apiEntry.Actor.IsOrgAdmin = &entry.Actor.IsOrgAdmin
+
+	for i, target := range entry.Targets {
+		apiEntry.Targets[i] = api.AuditTarget{Type: target.Type, Id: target.Id}
+	}
+	for i, update := range entry.Updates {
+		apiEntry.Updates[i] = api.AuditUpdate{Operation: api.AuditUpdateOperation(update.Operation), Relationship: update.Relationship}
+	}
+
+	if entry.ZedToken != "" {
+		apiEntry.ZedToken = &entry.ZedToken
+	}
+	if entry.Error != "" {
+		apiEntry.Error = &entry.Error
+	}
+
+	return apiEntry
+}
@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/merlante/prbac-spicedb/api"
+	_ "modernc.org/sqlite"
+)
+
+func TestFileAuditLog(t *testing.T) {
+	testAuditLog(t, &FileAuditLog{Path: filepath.Join(t.TempDir(), "audit.jsonl")})
+}
+
+func TestSQLAuditLog(t *testing.T) {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "audit.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	log, err := NewSQLAuditLog(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testAuditLog(t, log)
+}
+
+// testAuditLog checks that a backend keeps entries as appended and filters them as AuditQuery describes.
+func testAuditLog(t *testing.T, log AuditLog) {
+	t.Helper()
+
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	entries := []AuditEntry{
+		{Operation: "addPrincipalToGroup", OrgId: "acme", Actor: AuditActor{Username: "alice", IsOrgAdmin: true},
+			Targets: []AuditTarget{{Type: "group", Id: "g1"}, {Type: "principal", Id: "bob"}},
+			Updates: []AuditUpdate{{Operation: "TOUCH", Relationship: "group:g1#member@user:bob"}}, ZedToken: "token-1", Outcome: AuditSuccess},
+		{Operation: "createRole", OrgId: "acme", Actor: AuditActor{Username: "bob"},
+			Targets: []AuditTarget{{Type: "role", Id: "r1"}}, Outcome: AuditFailure, Error: "unavailable"},
+		{Operation: "addRoleToGroup", OrgId: "acme", Actor: AuditActor{Username: "alice", IsOrgAdmin: true},
+			Targets: []AuditTarget{{Type: "group", Id: "g1"}, {Type: "role", Id: "r1"}}, ZedToken: "token-2", Outcome: AuditSuccess},
+		{Operation: "addPrincipalToGroup", OrgId: "globex", Actor: AuditActor{Username: "carol"},
+			Targets: []AuditTarget{{Type: "group", Id: "g1"}}, ZedToken: "token-3", Outcome: AuditSuccess},
+	}
+	for i := range entries {
+		entries[i].Id = uuid.NewString()
+		entries[i].Time = start.Add(time.Duration(i) * time.Minute)
+		if err := log.Append(entries[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		query    AuditQuery
+		expected []int // indexes into entries, newest first
+		total    int
+	}{
+		{"organization", AuditQuery{OrgId: "acme"}, []int{2, 1, 0}, 3},
+		{"time range", AuditQuery{OrgId: "acme", Start: start.Add(time.Minute), End: start.Add(2 * time.Minute)}, []int{1}, 1},
+		{"actor", AuditQuery{OrgId: "acme", Actor: "alice"}, []int{2, 0}, 2},
+		{"target", AuditQuery{OrgId: "acme", Target: "r1"}, []int{2, 1}, 2},
+		{"operation", AuditQuery{OrgId: "acme", Operation: "addPrincipalToGroup"}, []int{0}, 1},
+		{"page", AuditQuery{OrgId: "acme", Limit: 1, Offset: 1}, []int{1}, 3},
+		{"past the end", AuditQuery{OrgId: "acme", Offset: 5}, nil, 3},
+	}
+
+	for _, test := range tests {
+		found, total, err := log.Query(test.query)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+
+		var expected []AuditEntry
+		for _, i := range test.expected {
+			expected = append(expected, entries[i])
+		}
+
+		if total != test.total || !slices.EqualFunc(found, expected, equalAuditEntries) {
+			t.Errorf("%s: expected %d of %d entries %+v, got %d %+v", test.name, len(expected), test.total, expected, total, found)
+		}
+	}
+}
+
+func equalAuditEntries(a, b AuditEntry) bool {
+	return a.Id == b.Id && a.Time.Equal(b.Time) && a.OrgId == b.OrgId && a.Actor == b.Actor && a.Operation == b.Operation &&
+		slices.Equal(a.Targets, b.Targets) && slices.Equal(a.Updates, b.Updates) &&
+		a.ZedToken == b.ZedToken && a.Outcome == b.Outcome && a.Error == b.Error
+}
+
+func TestListAuditLog(t *testing.T) {
+	p, spicedb := newTestServer(t)
+	p.Audit = &FileAuditLog{Path: filepath.Join(t.TempDir(), "audit.jsonl")}
+	ctx := withIdentity(context.Background(), "acme", "alice", true)
+
+	groupId := uuid.New()
+	if _, err := p.AddPrincipalToGroup(ctx, api.AddPrincipalToGroupRequestObject{Uuid: groupId, Body: &api.GroupPrincipalIn{
+		Principals: []api.PrincipalIn{{Username: "bob"}},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+
+	spicedb.failWrites = 1
+	if _, err := p.DeletePrincipalFromGroup(ctx, api.DeletePrincipalFromGroupRequestObject{Uuid: groupId, Params: api.DeletePrincipalFromGroupParams{Usernames: "bob"}}); err == nil {
+		t.Fatal("expected the write to fail")
+	}
+
+	resp, err := p.ListAuditLog(ctx, api.ListAuditLogRequestObject{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, ok := resp.(api.ListAuditLog200JSONResponse)
+	if !ok {
+		t.Fatalf("expected 200 response, got %T", resp)
+	}
+	if len(page.Data) != 2 {
+		t.Fatalf("expected 2 entries, got %+v", page.Data)
+	}
+
+	failed, added := page.Data[0], page.Data[1]
+	if added.Operation != "addPrincipalToGroup" || added.Actor.Username != "alice" || added.OrgId != "acme" || added.Outcome != api.Success {
+		t.Errorf("unexpected entry %+v", added)
+	}
+	if added.ZedToken == nil || *added.ZedToken == "" {
+		t.Errorf("expected the entry to carry the ZedToken of the write")
+	}
+	if !slices.Equal(added.Targets, []api.AuditTarget{{Type: "group", Id: groupId.String()}, {Type: "principal", Id: "bob"}}) {
+		t.Errorf("unexpected targets %+v", added.Targets)
+	}
+	if !slices.Equal(added.Updates, []api.AuditUpdate{
+		{Operation: api.TOUCH, Relationship: "group:" + groupId.String() + "#member@user:bob"},
+		{Operation: api.CREATE, Relationship: "group:" + groupId.String() + "#tenant@organization:acme"},
+	}) {
+		t.Errorf("unexpected updates %+v", added.Updates)
+	}
+	if failed.Operation != "deletePrincipalFromGroup" || failed.Outcome != api.Failure || failed.Error == nil || failed.ZedToken != nil {
+		t.Errorf("unexpected entry for the failed write %+v", failed)
+	}
+
+	target := "bob"
+	operation := "addPrincipalToGroup"
+	if resp, _ := p.ListAuditLog(ctx, api.ListAuditLogRequestObject{Params: api.ListAuditLogParams{Target: &target, Operation: &operation}}); len(resp.(api.ListAuditLog200JSONResponse).Data) != 1 {
+		t.Errorf("expected the filters to select one entry, got %+v", resp)
+	}
+
+	end := time.Now().Add(-time.Hour)
+	start := time.Now()
+	if resp, _ := p.ListAuditLog(ctx, api.ListAuditLogRequestObject{Params: api.ListAuditLogParams{Start: &start, End: &end}}); !isResponse[api.ListAuditLog400JSONResponse](resp) {
+		t.Errorf("expected 400 response for an empty time range, got %T", resp)
+	}
+
+	member := withIdentity(context.Background(), "acme", "bob", false)
+	if resp, _ := p.ListAuditLog(member, api.ListAuditLogRequestObject{}); !isResponse[api.ListAuditLog403JSONResponse](resp) {
+		t.Errorf("expected 403 response for a member, got %T", resp)
+	}
+
+	other := withIdentity(context.Background(), "globex", "carol", true)
+	if resp, _ := p.ListAuditLog(other, api.ListAuditLogRequestObject{}); len(resp.(api.ListAuditLog200JSONResponse).Data) != 0 {
+		t.Errorf("expected another tenant to see no entries, got %+v", resp)
+	}
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+const (
+	// rbacAllPermission is rbac:*:*, which every management operation of a tenant is allowed by.
+	rbacAllPermission = "rbac_all_all"
+	// rbacPrincipalReadPermission is rbac:principal:read, which allows reading who the tenant's principals are and
+	// what they can access.
+	rbacPrincipalReadPermission = "rbac_principal_read"
+)
+
+// operationPermissions is the permission on the caller's root workspace an operation requires, for the operations
+// that require less than rbacAllPermission. An empty permission means the operation is open to anyone.
+var operationPermissions = map[string]string{
+	"GetStatus": "",
+
+	"ListPrincipals":         rbacPrincipalReadPermission,
+	"GetPrincipalsFromGroup": rbacPrincipalReadPermission,
+	"LookupAccessSubjects":   rbacPrincipalReadPermission,
+}
+
+// AuthorizationMiddleware checks that the caller may call an operation before it is handled. Management operations
+// require rbac:*:* on the caller's organization's root workspace, and operations reading principals require
+// rbac:principal:read, which rbac:*:* implies. Callers may always query their own access; querying another
+// principal's access requires rbac:principal:read. Organization administrators, as asserted by their identity, may
+// call everything in their organization.
+//
+// Provisioning and deprovisioning an organization other than the caller's requires rbac:*:* on the platform's realm,
+// which only platform operators are granted; to anyone else, other organizations are not found.
+//
+// Callers without an identity are refused with 401, and callers without the permission with 403.
+func AuthorizationMiddleware(p *PrbacSpicedbServer) api.StrictMiddlewareFunc {
+	return func(f api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+			permission, ok := operationPermissions[operationID]
+			if !ok {
+				permission = rbacAllPermission
+			}
+			if username := queriedPrincipal(request); username != nil {
+				permission = rbacPrincipalReadPermission
+				if identity, ok := identityFromContext(ctx); ok && (*username == "" || *username == identity.Username) {
+					permission = ""
+				}
+			}
+
+			if permission == "" {
+				return f(ctx, w, r, request)
+			}
+
+			identity, ok := identityFromContext(ctx)
+			if !ok || identity.Username == "" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return nil, nil
+			}
+
+			if org := targetOrganization(request); org != "" && org != identity.OrgId {
+				allowed, err := p.checkPermissionForAnySubject(ctx,
+					&v1.ObjectReference{ObjectType: "realm", ObjectId: defaultRealm},
+					rbacAllPermission, getPrincipalSubjects(ctx, identity.Username))
+				if err != nil {
+					writeJSON(w, http.StatusInternalServerError, errorBody(500, err.Error()))
+					return nil, nil
+				}
+				if !allowed {
+					writeJSON(w, http.StatusNotFound, errorBody(404, "organization not found: "+org))
+					return nil, nil
+				}
+
+				return f(ctx, w, r, request)
+			}
+
+			if !identity.IsOrgAdmin {
+				allowed, err := p.checkPermissionForAnySubject(ctx,
+					&v1.ObjectReference{ObjectType: "workspace", ObjectId: getRootWorkspace(getUserOrg(ctx))},
+					permission, getPrincipalSubjects(ctx, identity.Username))
+				if err != nil {
+					writeJSON(w, http.StatusInternalServerError, errorBody(500, err.Error()))
+					return nil, nil
+				}
+				if !allowed {
+					writeJSON(w, http.StatusForbidden, forbiddenBody(fmt.Sprintf("%s requires the %s permission", operationID, describeRbacPermission(permission))))
+					return nil, nil
+				}
+			}
+
+			return f(ctx, w, r, request)
+		}
+	}
+}
+
+// queriedPrincipal returns the principal an access query is about, empty for the caller, or nil if the request is
+// not an access query.
+func queriedPrincipal(request interface{}) *string {
+	var username *string
+	switch request := request.(type) {
+	case api.GetPrincipalAccessRequestObject:
+		username = request.Params.Username
+	case api.ExplainAccessRequestObject:
+		username = request.Params.Username
+	case api.LookupAccessibleResourcesRequestObject:
+		username = request.Params.Username
+	case api.CheckAccessRequestObject:
+		if request.Body != nil {
+			username = request.Body.Username
+		}
+	case api.CheckAccessBulkRequestObject:
+		if request.Body != nil {
+			username = request.Body.Username
+		}
+	default:
+		return nil
+	}
+
+	if username == nil {
+		return new(string)
+	}
+	return username
+}
+
+// targetOrganization returns the organization an operation on organizations is about, or an empty string if the
+// request is not one.
+func targetOrganization(request interface{}) string {
+	switch request := request.(type) {
+	case api.ProvisionOrganizationRequestObject:
+		return request.OrgId
+	case api.DeprovisionOrganizationRequestObject:
+		return request.OrgId
+	}
+
+	return ""
+}
+
+func describeRbacPermission(permission string) string {
+	if permission == rbacPrincipalReadPermission {
+		return "rbac:principal:read"
+	}
+	return "rbac:*:*"
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
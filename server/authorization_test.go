@@ -0,0 +1,138 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+// newAuthorizationTestRouter serves p as main does, behind the identity and authorization middlewares.
+func newAuthorizationTestRouter(p *PrbacSpicedbServer) http.Handler {
+	r := chi.NewRouter()
+	r.Use(IdentityMiddleware(true))
+	api.HandlerFromMux(api.NewStrictHandler(p, []api.StrictMiddlewareFunc{AuthorizationMiddleware(p)}), r)
+
+	return r
+}
+
+func identityHeaderFor(username string, orgAdmin bool) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf(`{"identity": {"org_id": "acme", "user": {"username": %q, "is_org_admin": %t}}}`, username, orgAdmin)))
+}
+
+func TestAuthorizationMiddleware(t *testing.T) {
+	p, spicedb := newTestServer(t)
+	spicedb.permissions = []string{
+		"workspace:acme_root#rbac_all_all@user:alice",
+		"workspace:acme_root#rbac_principal_read@user:alice",
+		"workspace:acme_root#rbac_principal_read@user:bob",
+	}
+	router := newAuthorizationTestRouter(p)
+
+	addPrincipal := fmt.Sprintf(`POST /groups/%s/principals/ {"principals": [{"username": "dave"}]}`, uuid.New())
+	tests := []struct {
+		name     string
+		request  string
+		username string
+		orgAdmin bool
+		status   int
+	}{
+		{"write with rbac:*:*", addPrincipal, "alice", false, http.StatusOK},
+		{"write with rbac:principal:read only", addPrincipal, "bob", false, http.StatusForbidden},
+		{"write without permissions", addPrincipal, "carol", false, http.StatusForbidden},
+		{"write as org admin", addPrincipal, "carol", true, http.StatusOK},
+		{"write without identity", addPrincipal, "", false, http.StatusUnauthorized},
+		{"own access", `POST /access/check/ {"resource_type": "rbac/v1role", "resource_id": "r1", "permission": "inventory:hosts:read"}`, "carol", false, 0},
+		{"another principal's access", `POST /access/check/ {"username": "alice", "resource_type": "rbac/v1role", "resource_id": "r1", "permission": "inventory:hosts:read"}`, "carol", false, http.StatusForbidden},
+		{"another principal's access with rbac:principal:read", `POST /access/check/ {"username": "alice", "resource_type": "rbac/v1role", "resource_id": "r1", "permission": "inventory:hosts:read"}`, "bob", false, 0},
+		{"status without identity", `GET /status/`, "", false, 0},
+	}
+
+	for _, test := range tests {
+		method, rest, _ := strings.Cut(test.request, " ")
+		path, body, _ := strings.Cut(rest, " ")
+
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if test.username != "" {
+			req.Header.Set("x-rh-identity", identityHeaderFor(test.username, test.orgAdmin))
+		}
+
+		w := httptest.NewRecorder()
+		func() {
+			// Operations the middleware lets through may not be implemented; all that matters is they got that far
+			defer func() { recover() }()
+			router.ServeHTTP(w, req)
+		}()
+
+		switch {
+		case test.status == 0 && (w.Code == http.StatusUnauthorized || w.Code == http.StatusForbidden):
+			t.Errorf("%s: expected the request to be let through, got %d %s", test.name, w.Code, w.Body)
+		case test.status != 0 && w.Code != test.status:
+			t.Errorf("%s: expected %d, got %d %s", test.name, test.status, w.Code, w.Body)
+		}
+
+		if test.status == http.StatusForbidden {
+			var body api.Error403
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil || len(body.Errors) != 1 || *body.Errors[0].Status != "403" {
+				t.Errorf("%s: expected an Error403 body, got %s", test.name, w.Body)
+			}
+		}
+	}
+
+	// Both permitted writes add the same member to the group the first one claimed
+	if tuples := spicedb.tuples(); len(tuples) != 2 {
+		t.Errorf("expected only the permitted writes to be made, got %v", tuples)
+	}
+}
+
+func TestAuthorizationOfOtherOrganizations(t *testing.T) {
+	p, spicedb := newTestServer(t, "organization:globex#realm@realm:redhat", "workspace:globex_root#parent@organization:globex")
+	spicedb.permissions = []string{
+		"workspace:acme_root#rbac_all_all@user:alice",
+		"realm:redhat#rbac_all_all@user:olivia",
+	}
+	router := newAuthorizationTestRouter(p)
+
+	tests := []struct {
+		name     string
+		request  string
+		username string
+		orgAdmin bool
+		status   int
+	}{
+		{"provision another organization as org admin", "PUT /organizations/globex/", "carol", true, http.StatusNotFound},
+		{"provision another organization with rbac:*:*", "PUT /organizations/globex/", "alice", false, http.StatusNotFound},
+		{"deprovision another organization as org admin", "DELETE /organizations/globex/", "carol", true, http.StatusNotFound},
+		{"deprovision another organization with rbac:*:*", "DELETE /organizations/globex/", "alice", false, http.StatusNotFound},
+		{"provision another organization as platform operator", "PUT /organizations/initech/", "olivia", false, http.StatusOK},
+		{"provision own organization as org admin", "PUT /organizations/acme/", "carol", true, http.StatusOK},
+	}
+
+	for _, test := range tests {
+		before := spicedb.tuples()
+
+		method, path, _ := strings.Cut(test.request, " ")
+		req := httptest.NewRequest(method, path, strings.NewReader("{}"))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("x-rh-identity", identityHeaderFor(test.username, test.orgAdmin))
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != test.status {
+			t.Errorf("%s: expected %d, got %d %s", test.name, test.status, w.Code, w.Body)
+		}
+		if test.status == http.StatusNotFound && !slices.Equal(spicedb.tuples(), before) {
+			t.Errorf("%s: expected nothing to be written", test.name)
+		}
+	}
+}
@@ -0,0 +1,646 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+// ChangeEvent is a change to the legacy RBAC service's state, as the service writes it to its outbox table and
+// Debezium's outbox event router publishes it: the aggregate that changed, what happened to it, and its state after
+// the change, or before it for deletions. Events are keyed by org_id, so that an organization's events stay in order.
+//
+// The aggregates and their event types are:
+//   - role: created, updated and deleted, with an RbacExportRole
+//   - group: created, updated and deleted, with an RbacExportGroup; principals_added and principals_removed, with the
+//     principals added or removed
+//   - policy: created, updated and deleted, with an RbacExportPolicy. RBAC v1 keeps a policy per group, so an updated
+//     policy replaces all the roles of its group.
+type ChangeEvent struct {
+	Id            string          `json:"id"`
+	AggregateType string          `json:"aggregatetype"`
+	AggregateId   string          `json:"aggregateid"`
+	Type          string          `json:"type"`
+	OrgId         string          `json:"org_id"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+// invalidChangeEventError is a change event that cannot be applied however often it is retried.
+type invalidChangeEventError struct {
+	err error
+}
+
+func (e *invalidChangeEventError) Error() string {
+	return "invalid change event: " + e.err.Error()
+}
+
+func (e *invalidChangeEventError) Unwrap() error {
+	return e.err
+}
+
+func invalidChangeEvent(format string, args ...interface{}) error {
+	return &invalidChangeEventError{err: fmt.Errorf(format, args...)}
+}
+
+// isInvalidChangeEvent reports whether an error from ApplyChangeEvent is down to the event rather than to SpiceDB.
+func isInvalidChangeEvent(err error) bool {
+	var invalid *invalidChangeEventError
+	return errors.As(err, &invalid)
+}
+
+// changeTranslation is what applying a change event does: the relationship updates written for it, the
+// preconditions of that write, and what is done to metadata once they are.
+type changeTranslation struct {
+	updates       []*v1.RelationshipUpdate
+	preconditions []*v1.Precondition
+	metadata      func() error
+}
+
+// changeEventNote is what is logged about an event when applying it leaves something out.
+type changeEventNote struct {
+	Id            string `json:"id"`
+	AggregateType string `json:"aggregatetype"`
+	AggregateId   string `json:"aggregateid"`
+	Type          string `json:"type"`
+	OrgId         string `json:"org_id"`
+	Note          string `json:"note"`
+}
+
+// logChangeEvent writes a note about an event to stdout as JSON.
+func logChangeEvent(level string, event ChangeEvent, note string) {
+	encoded, _ := json.Marshal(changeEventNote{
+		Id:            event.Id,
+		AggregateType: event.AggregateType,
+		AggregateId:   event.AggregateId,
+		Type:          event.Type,
+		OrgId:         event.OrgId,
+		Note:          note,
+	})
+	fmt.Printf("[%s] change event: %s\n", level, encoded)
+}
+
+// claimForEvent checks that an object an event names is not another organization's, which makes the event invalid,
+// and for an object no organization owns yet returns what the event's write must include to record the event's
+// organization as its owner.
+func (p *PrbacSpicedbServer) claimForEvent(ctx context.Context, event ChangeEvent, objectType, objectId string) ([]*v1.RelationshipUpdate, []*v1.Precondition, error) {
+	tenant, err := p.getTenant(ctx, objectType, objectId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if tenant == event.OrgId {
+		return nil, nil, nil
+	}
+	if tenant != "" {
+		return nil, nil, invalidChangeEvent("%s %s event %s names %s %s, which belongs to another organization than %s", event.AggregateType, event.Type, event.Id, objectType, objectId, event.OrgId)
+	}
+
+	claim, precondition := tenantClaim(objectType, objectId, event.OrgId)
+	return []*v1.RelationshipUpdate{claim}, []*v1.Precondition{precondition}, nil
+}
+
+// ApplyChangeEvent translates a change event, as published to the stream, into relationships and writes them in one
+// WriteRelationships request. Updates are TOUCHes and DELETEs of what the event leaves in place, so applying an
+// event again, as happens when a stream redelivers it, changes nothing. Events this service has no use for, such as
+// Debezium's deletes of outbox rows, are ignored.
+func (p *PrbacSpicedbServer) ApplyChangeEvent(ctx context.Context, value []byte) error {
+	event, ok, err := decodeChangeEvent(value)
+	if err != nil {
+		return &invalidChangeEventError{err: err}
+	}
+	if !ok {
+		return nil
+	}
+
+	var translation changeTranslation
+	switch event.AggregateType {
+	case "role":
+		translation, err = p.translateRoleEvent(ctx, event)
+	case "group":
+		translation, err = p.translateGroupEvent(ctx, event)
+	case "policy":
+		translation, err = p.translatePolicyEvent(ctx, event)
+	default:
+		err = invalidChangeEvent("unknown aggregate type %q", event.AggregateType)
+	}
+	if err != nil {
+		return err
+	}
+
+	if updates := uniqueUpdates(translation.updates); len(updates) != 0 {
+		entry := auditEntry(ctx, "replicate/"+event.AggregateType+"."+event.Type, AuditTarget{Type: event.AggregateType, Id: event.AggregateId})
+		entry.OrgId = event.OrgId
+		if _, err := p.writeRelationships(ctx, entry, updates, translation.preconditions...); err != nil {
+			return err
+		}
+	}
+
+	if translation.metadata != nil {
+		return translation.metadata()
+	}
+
+	return nil
+}
+
+// decodeChangeEvent finds the event in a message, which is the outbox row itself, or is wrapped as Debezium's JSON
+// converter wraps it, in a schema envelope, a change data capture envelope, or both. Debezium may also hand on JSON
+// columns as strings. It reports false for messages that carry no event.
+func decodeChangeEvent(value []byte) (ChangeEvent, bool, error) {
+	raw := json.RawMessage(value)
+
+	for {
+		raw = unquoteJSON(raw)
+
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return ChangeEvent{}, false, err
+		}
+
+		if _, ok := fields["aggregatetype"]; ok {
+			var event ChangeEvent
+			if err := json.Unmarshal(raw, &event); err != nil {
+				return ChangeEvent{}, false, err
+			}
+			event.Payload = unquoteJSON(event.Payload)
+
+			if event.AggregateId == "" || event.Type == "" || event.OrgId == "" {
+				return ChangeEvent{}, false, fmt.Errorf("event %s lacks an aggregateid, type or org_id", event.Id)
+			}
+
+			return event, true, nil
+		}
+
+		if op, ok := fields["op"]; ok {
+			// Only inserts into the outbox are events; it is emptied by deleting its rows once they are captured
+			if string(op) != `"c"` && string(op) != `"r"` {
+				return ChangeEvent{}, false, nil
+			}
+
+			raw = fields["after"]
+			continue
+		}
+
+		payload, ok := fields["payload"]
+		if !ok || string(payload) == "null" {
+			return ChangeEvent{}, false, errors.New("no change event found in message")
+		}
+		raw = payload
+	}
+}
+
+// unquoteJSON decodes JSON that has been encoded again as a string.
+func unquoteJSON(raw json.RawMessage) json.RawMessage {
+	var encoded string
+	if err := json.Unmarshal(raw, &encoded); err == nil {
+		return json.RawMessage(encoded)
+	}
+
+	return raw
+}
+
+func decodePayload(event ChangeEvent, into interface{}) error {
+	if err := json.Unmarshal(event.Payload, into); err != nil {
+		return invalidChangeEvent("%s %s event %s: %v", event.AggregateType, event.Type, event.Id, err)
+	}
+
+	return nil
+}
+
+func (p *PrbacSpicedbServer) translateRoleEvent(ctx context.Context, event ChangeEvent) (changeTranslation, error) {
+	roleId := event.AggregateId
+
+	if isSystemRole(roleId) {
+		logChangeEvent("INFO", event, "ignored, as the role is a system role")
+		return changeTranslation{}, nil
+	}
+
+	claim, preconditions, err := p.claimForEvent(ctx, event, "rbac/v1role", roleId)
+	if err != nil {
+		return changeTranslation{}, err
+	}
+
+	switch event.Type {
+	case "created", "updated":
+		var role api.RbacExportRole
+		if err := decodePayload(event, &role); err != nil {
+			return changeTranslation{}, err
+		}
+		if valueOrFalse(role.System) {
+			logChangeEvent("INFO", event, "ignored, as the role is a system role")
+			return changeTranslation{}, nil
+		}
+
+		if workspaceId, err := p.findUnownedWorkspace(ctx, event.OrgId, role.Access, nil); err != nil {
+			return changeTranslation{}, err
+		} else if workspaceId != "" {
+			return changeTranslation{}, invalidChangeEvent("role %s is bound to workspace %s, which organization %s does not have", roleId, workspaceId, event.OrgId)
+		}
+
+		updates, unhandled, err := p.roleReplaceUpdates(ctx, event.OrgId, roleId, role.Access)
+		if err != nil {
+			return changeTranslation{}, err
+		}
+		for _, description := range unhandled {
+			logChangeEvent("INFO", event, description)
+		}
+		updates = append(updates, claim...)
+
+		return changeTranslation{updates: updates, preconditions: preconditions, metadata: func() error {
+			return p.putChangedMetadata("role", roleId, role.Name, valueOrEmpty(role.Description))
+		}}, nil
+	case "deleted":
+		updates, err := p.roleDeleteUpdates(ctx, roleId)
+		if err != nil {
+			return changeTranslation{}, err
+		}
+
+		return changeTranslation{updates: updates, metadata: func() error {
+			return p.Metadata.Delete("role", roleId)
+		}}, nil
+	}
+
+	return changeTranslation{}, invalidChangeEvent("unknown role event type %q", event.Type)
+}
+
+func (p *PrbacSpicedbServer) translateGroupEvent(ctx context.Context, event ChangeEvent) (changeTranslation, error) {
+	groupId := event.AggregateId
+
+	claim, preconditions, err := p.claimForEvent(ctx, event, "group", groupId)
+	if err != nil {
+		return changeTranslation{}, err
+	}
+
+	switch event.Type {
+	case "created", "updated":
+		var group api.RbacExportGroup
+		if err := decodePayload(event, &group); err != nil {
+			return changeTranslation{}, err
+		}
+
+		// Default groups are provisioned by this service under ids of its own
+		if valueOrFalse(group.PlatformDefault) || valueOrFalse(group.AdminDefault) {
+			logChangeEvent("INFO", event, "ignored, as the group is a default group")
+			return changeTranslation{}, nil
+		}
+
+		// Members and roles have events of their own; all a group has of its own is its owner
+		return changeTranslation{updates: claim, preconditions: preconditions, metadata: func() error {
+			return p.putChangedMetadata("group", groupId, group.Name, valueOrEmpty(group.Description))
+		}}, nil
+	case "deleted":
+		updates, err := p.groupDeleteUpdates(ctx, event.OrgId, groupId)
+		if err != nil {
+			return changeTranslation{}, err
+		}
+
+		return changeTranslation{updates: updates, metadata: func() error {
+			return p.Metadata.Delete("group", groupId)
+		}}, nil
+	case "principals_added", "principals_removed":
+		var membership struct {
+			Principals []api.PrincipalIn `json:"principals"`
+		}
+		if err := decodePayload(event, &membership); err != nil {
+			return changeTranslation{}, err
+		}
+
+		usernames := make([]string, len(membership.Principals))
+		for i, principal := range membership.Principals {
+			usernames[i] = principal.Username
+		}
+
+		operation := v1.RelationshipUpdate_OPERATION_TOUCH
+		if event.Type == "principals_removed" {
+			operation = v1.RelationshipUpdate_OPERATION_DELETE
+		}
+
+		return changeTranslation{updates: groupPrincipalUpdates(operation, groupId, usernames)}, nil
+	}
+
+	return changeTranslation{}, invalidChangeEvent("unknown group event type %q", event.Type)
+}
+
+func (p *PrbacSpicedbServer) translatePolicyEvent(ctx context.Context, event ChangeEvent) (changeTranslation, error) {
+	var policy api.RbacExportPolicy
+	if err := decodePayload(event, &policy); err != nil {
+		return changeTranslation{}, err
+	}
+
+	groupId := policy.Group.String()
+	roles := make([]string, len(policy.Roles))
+	for i, role := range policy.Roles {
+		roles[i] = role.String()
+	}
+
+	if _, _, err := p.claimForEvent(ctx, event, "group", groupId); err != nil {
+		return changeTranslation{}, err
+	}
+	for _, role := range roles {
+		if isSystemRole(role) {
+			continue
+		}
+		if _, _, err := p.claimForEvent(ctx, event, "rbac/v1role", role); err != nil {
+			return changeTranslation{}, err
+		}
+	}
+
+	var added, removed []string
+	switch event.Type {
+	case "created":
+		added = roles
+	case "deleted":
+		removed = roles
+	case "updated":
+		current, err := p.getGroupRoles(ctx, groupId)
+		if err != nil {
+			return changeTranslation{}, err
+		}
+
+		added = roles
+		for _, role := range current {
+			if !slices.Contains(roles, role) {
+				removed = append(removed, role)
+			}
+		}
+	default:
+		return changeTranslation{}, invalidChangeEvent("unknown policy event type %q", event.Type)
+	}
+
+	var updates []*v1.RelationshipUpdate
+	for _, role := range removed {
+		bindings, err := p.getRoleBindingIds(ctx, role)
+		if err != nil {
+			return changeTranslation{}, err
+		}
+		updates = append(updates, groupRoleUpdates(v1.RelationshipUpdate_OPERATION_DELETE, event.OrgId, groupId, role, bindings)...)
+	}
+	for _, role := range added {
+		bindings, err := p.getRoleBindingIds(ctx, role)
+		if err != nil {
+			return changeTranslation{}, err
+		}
+		if len(bindings) == 0 && !isSystemRole(role) {
+			logChangeEvent("WARN", event, fmt.Sprintf("group %s is not bound to role %s, which is unknown", groupId, role))
+			continue
+		}
+		updates = append(updates, groupRoleUpdates(v1.RelationshipUpdate_OPERATION_TOUCH, event.OrgId, groupId, role, bindings)...)
+	}
+
+	return changeTranslation{updates: updates}, nil
+}
+
+// roleReplaceUpdates translates a role as roleUpdates does, listing what it cannot translate, and also revokes what a previous version of the role
+// granted that this one does not. The groups bound to the role are the subjects of each of its role_bindings, so they
+// are copied to any role_binding this version adds.
+func (p *PrbacSpicedbServer) roleReplaceUpdates(ctx context.Context, org, roleId string, accesses []api.Access) ([]*v1.RelationshipUpdate, []string, error) {
+	updates, unhandled := roleUpdates(getRootWorkspace(org), roleId, accesses)
+
+	wanted := make(map[string]bool)
+	for _, update := range updates {
+		wanted[relationshipKey(update.GetRelationship())] = true
+	}
+
+	existingBindings, err := p.getRoleBindingIds(ctx, roleId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subjects, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+		ResourceType:       "role_binding",
+		OptionalResourceId: roleId,
+		OptionalRelation:   "subject",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bindings := roleBindingIds(updates)
+	for _, binding := range bindings {
+		if slices.Contains(existingBindings, binding) {
+			continue
+		}
+
+		for _, subject := range subjects {
+			updates = append(updates, &v1.RelationshipUpdate{
+				Operation: v1.RelationshipUpdate_OPERATION_TOUCH,
+				Relationship: &v1.Relationship{
+					Resource: &v1.ObjectReference{ObjectType: "role_binding", ObjectId: binding},
+					Relation: "subject",
+					Subject:  subject.GetSubject(),
+				},
+			})
+		}
+	}
+
+	for _, binding := range existingBindings {
+		if slices.Contains(bindings, binding) {
+			continue
+		}
+
+		deletes, err := p.bindingDeleteUpdates(ctx, binding)
+		if err != nil {
+			return nil, nil, err
+		}
+		updates = append(updates, deletes...)
+	}
+
+	permissions, err := p.deleteUpdates(ctx, &v1.RelationshipFilter{
+		ResourceType:       "role",
+		OptionalResourceId: roleId,
+		OptionalSubjectFilter: &v1.SubjectFilter{
+			SubjectType: "user",
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, update := range permissions {
+		if !wanted[relationshipKey(update.GetRelationship())] {
+			updates = append(updates, update)
+		}
+	}
+
+	return updates, unhandled, nil
+}
+
+// roleDeleteUpdates removes a role, its role_bindings, and with them the groups bound to it.
+func (p *PrbacSpicedbServer) roleDeleteUpdates(ctx context.Context, roleId string) ([]*v1.RelationshipUpdate, error) {
+	bindings, err := p.getRoleBindingIds(ctx, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	var updates []*v1.RelationshipUpdate
+	for _, binding := range bindings {
+		deletes, err := p.bindingDeleteUpdates(ctx, binding)
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, deletes...)
+	}
+
+	deletes, err := p.deleteUpdates(ctx,
+		&v1.RelationshipFilter{ResourceType: "role", OptionalResourceId: roleId},
+		&v1.RelationshipFilter{ResourceType: "rbac/v1role", OptionalResourceId: roleId},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(updates, deletes...), nil
+}
+
+// bindingDeleteUpdates removes a role_binding: what it grants, its subjects, and where it is granted.
+func (p *PrbacSpicedbServer) bindingDeleteUpdates(ctx context.Context, bindingId string) ([]*v1.RelationshipUpdate, error) {
+	return p.deleteUpdates(ctx,
+		&v1.RelationshipFilter{ResourceType: "role_binding", OptionalResourceId: bindingId},
+		&v1.RelationshipFilter{
+			ResourceType:          "workspace",
+			OptionalRelation:      "user_grant",
+			OptionalSubjectFilter: &v1.SubjectFilter{SubjectType: "role_binding", OptionalSubjectId: bindingId},
+		},
+		&v1.RelationshipFilter{
+			ResourceType:          "rbac/v1role",
+			OptionalRelation:      "binding",
+			OptionalSubjectFilter: &v1.SubjectFilter{SubjectType: "role_binding", OptionalSubjectId: bindingId},
+		},
+	)
+}
+
+// groupDeleteUpdates removes a group's members, its membership of other groups, and its place as a subject of role
+// bindings, along with the bindings to system roles it had of its own at the root workspace.
+func (p *PrbacSpicedbServer) groupDeleteUpdates(ctx context.Context, org, groupId string) ([]*v1.RelationshipUpdate, error) {
+	updates, err := p.deleteUpdates(ctx,
+		&v1.RelationshipFilter{ResourceType: "group", OptionalResourceId: groupId},
+		&v1.RelationshipFilter{
+			ResourceType:          "group",
+			OptionalSubjectFilter: &v1.SubjectFilter{SubjectType: "group", OptionalSubjectId: groupId},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := p.getGroupRoles(ctx, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range roles {
+		bindings, err := p.getRoleBindingIds(ctx, role)
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, groupRoleUpdates(v1.RelationshipUpdate_OPERATION_DELETE, org, groupId, role, bindings)...)
+	}
+
+	return updates, nil
+}
+
+// getGroupRoles lists the RBAC v1 roles a group is bound to, whether through the role's own bindings or, for system
+// roles, through a binding of the group's own at the root workspace.
+func (p *PrbacSpicedbServer) getGroupRoles(ctx context.Context, groupId string) ([]string, error) {
+	subjects, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+		ResourceType:     "role_binding",
+		OptionalRelation: "subject",
+		OptionalSubjectFilter: &v1.SubjectFilter{
+			SubjectType:       "group",
+			OptionalSubjectId: groupId,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []string
+	for _, subject := range subjects {
+		bindingId := subject.GetResource().GetObjectId()
+
+		owners, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+			ResourceType:     "rbac/v1role",
+			OptionalRelation: "binding",
+			OptionalSubjectFilter: &v1.SubjectFilter{
+				SubjectType:       "role_binding",
+				OptionalSubjectId: bindingId,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, owner := range owners {
+			if role := owner.GetResource().GetObjectId(); !slices.Contains(roles, role) {
+				roles = append(roles, role)
+			}
+		}
+
+		if len(owners) == 0 {
+			role, ok, err := p.getRootBindingRole(ctx, groupId, bindingId)
+			if err != nil {
+				return nil, err
+			}
+			if ok && !slices.Contains(roles, role) {
+				roles = append(roles, role)
+			}
+		}
+	}
+
+	return roles, nil
+}
+
+// deleteUpdates deletes every relationship matching any of the filters.
+func (p *PrbacSpicedbServer) deleteUpdates(ctx context.Context, filters ...*v1.RelationshipFilter) ([]*v1.RelationshipUpdate, error) {
+	var updates []*v1.RelationshipUpdate
+	for _, filter := range filters {
+		relationships, err := readRelationships(ctx, p.SpicedbClient, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, relationship := range relationships {
+			updates = append(updates, &v1.RelationshipUpdate{Operation: v1.RelationshipUpdate_OPERATION_DELETE, Relationship: relationship})
+		}
+	}
+
+	return updates, nil
+}
+
+// uniqueUpdates leaves out repeated updates of the same relationship, which SpiceDB rejects within a request, keeping
+// the first.
+func uniqueUpdates(updates []*v1.RelationshipUpdate) []*v1.RelationshipUpdate {
+	seen := make(map[string]bool)
+
+	unique := make([]*v1.RelationshipUpdate, 0, len(updates))
+	for _, update := range updates {
+		key := relationshipKey(update.GetRelationship())
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		unique = append(unique, update)
+	}
+
+	return unique
+}
+
+// putChangedMetadata stores the name and description of a changed object, keeping when it was created.
+func (p *PrbacSpicedbServer) putChangedMetadata(objectType, objectId, name, description string) error {
+	now := time.Now().UTC()
+
+	metadata, ok, err := p.Metadata.Get(objectType, objectId)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		metadata.Created = now
+	}
+	metadata.Name, metadata.Description, metadata.Modified = name, description, now
+
+	return p.Metadata.Put(objectType, objectId, metadata)
+}
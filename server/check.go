@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/merlante/prbac-spicedb/api"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func (p *PrbacSpicedbServer) CheckAccess(ctx context.Context, request api.CheckAccessRequestObject) (api.CheckAccessResponseObject, error) {
+	username := getPrincipalUsername(ctx, request.Body.Username)
+	if username == "" {
+		return api.CheckAccess401Response{}, nil
+	}
+
+	definitions, err := readSchemaDefinitions(ctx, p.SpicedbClient)
+	if err != nil {
+		return api.CheckAccess500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	item := api.CheckItem{
+		ResourceType: request.Body.ResourceType,
+		ResourceId:   request.Body.ResourceId,
+		Permission:   request.Body.Permission,
+	}
+
+	result, invalid, err := p.checkAccess(ctx, definitions, getPrincipalSubjects(ctx, username), item, valueOrFalse(request.Body.Debug))
+	if err != nil {
+		return api.CheckAccess500JSONResponse(errorBody(500, err.Error())), nil
+	}
+	if invalid != "" {
+		return api.CheckAccess400JSONResponse(errorBody(400, invalid)), nil
+	}
+
+	return api.CheckAccess200JSONResponse(result), nil
+}
+
+func (p *PrbacSpicedbServer) CheckAccessBulk(ctx context.Context, request api.CheckAccessBulkRequestObject) (api.CheckAccessBulkResponseObject, error) {
+	username := getPrincipalUsername(ctx, request.Body.Username)
+	if username == "" {
+		return api.CheckAccessBulk401Response{}, nil
+	}
+
+	definitions, err := readSchemaDefinitions(ctx, p.SpicedbClient)
+	if err != nil {
+		return api.CheckAccessBulk500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	subjects := getPrincipalSubjects(ctx, username)
+
+	results := make([]api.CheckResult, len(request.Body.Data))
+	for i, item := range request.Body.Data {
+		result, invalid, err := p.checkAccess(ctx, definitions, subjects, item, valueOrFalse(request.Body.Debug))
+		if err != nil {
+			return api.CheckAccessBulk500JSONResponse(errorBody(500, err.Error())), nil
+		}
+		if invalid != "" {
+			return api.CheckAccessBulk400JSONResponse(errorBody(400, fmt.Sprintf("data[%d]: %s", i, invalid))), nil
+		}
+
+		results[i] = result
+	}
+
+	return api.CheckAccessBulk200JSONResponse{Data: results}, nil
+}
+
+// checkAccess checks an RBAC v1 permission on a single resource for any of the subjects. If the permission cannot
+// be checked on the resource type, the problem is described instead. With debug set, each subject is checked with
+// tracing and the traces are returned, rather than stopping at the first subject that has the permission.
+func (p *PrbacSpicedbServer) checkAccess(ctx context.Context, definitions map[string]schemaDefinition, subjects []*v1.SubjectReference, item api.CheckItem, debug bool) (api.CheckResult, string, error) {
+	result := api.CheckResult{
+		ResourceType: item.ResourceType,
+		ResourceId:   item.ResourceId,
+		Permission:   item.Permission,
+	}
+
+	permission, ok := p.spicedbPermission(definitions, item.ResourceType, item.Permission)
+	if !ok {
+		return result, fmt.Sprintf("permission %s cannot be checked on %s", item.Permission, item.ResourceType), nil
+	}
+
+	resource := &v1.ObjectReference{ObjectType: item.ResourceType, ObjectId: item.ResourceId}
+
+	if !debug {
+		allowed, err := p.checkPermissionForAnySubject(ctx, resource, permission, subjects)
+		result.Allowed = allowed
+
+		return result, "", err
+	}
+
+	traces := make([]map[string]interface{}, 0)
+	for _, subject := range subjects {
+		r, debugInfo, err := p.checkPermissionWithTrace(ctx, &v1.CheckPermissionRequest{
+			Resource:   resource,
+			Permission: permission,
+			Subject:    subject,
+		})
+		if err != nil {
+			return result, "", err
+		}
+
+		if r.Permissionship == v1.CheckPermissionResponse_PERMISSIONSHIP_HAS_PERMISSION {
+			result.Allowed = true
+		}
+
+		if debugInfo.GetCheck() != nil {
+			trace, err := traceToMap(debugInfo.GetCheck())
+			if err != nil {
+				return result, "", err
+			}
+			traces = append(traces, trace)
+		}
+	}
+	result.DebugTrace = &traces
+
+	return result, "", nil
+}
+
+// traceToMap renders a SpiceDB debug trace in its canonical JSON form.
+func traceToMap(trace *v1.CheckDebugTrace) (map[string]interface{}, error) {
+	encoded, err := protojson.Marshal(trace)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded map[string]interface{}
+	err = json.Unmarshal(encoded, &decoded)
+
+	return decoded, err
+}
+
+func valueOrFalse(value *bool) bool {
+	return value != nil && *value
+}
@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+const checkTestSchema = `
+definition workspace {
+    relation parent: workspace | organization
+    relation user_grant: role_binding
+    permission inventory_hosts_write = user_grant->inventory_hosts_write + parent->inventory_hosts_write
+}
+
+definition inventory/hosts {
+    relation workspace: workspace
+    permission read = workspace->inventory_hosts_read + workspace->inventory_all_read
+    permission write = workspace->inventory_hosts_write
+}
+
+definition dispatcher/service {
+    relation workspace: workspace
+    permission view = workspace->dispatcher_view_runs
+}
+`
+
+func TestSpicedbPermission(t *testing.T) {
+	p := &PrbacSpicedbServer{RbacServices: testServices}
+	definitions := parseSchemaDefinitions(checkTestSchema)
+
+	for _, test := range []struct {
+		resourceType, permission, expected string
+	}{
+		{"inventory/hosts", "inventory:hosts:write", "write"},
+		{"inventory/hosts", "inventory:*:read", "read"},
+		{"workspace", "inventory:hosts:write", "inventory_hosts_write"},
+		{"dispatcher/service", "playbook-dispatcher:run:read", "view"},
+		{"inventory/hosts", "playbook-dispatcher:run:read", ""},
+		{"inventory/hosts", "inventory:hosts:*", ""},
+		{"inventory/unknown", "inventory:unknown:read", ""},
+	} {
+		permission, ok := p.spicedbPermission(definitions, test.resourceType, test.permission)
+		if permission != test.expected || ok != (test.expected != "") {
+			t.Errorf("%s on %s: expected %q, got %q", test.permission, test.resourceType, test.expected, permission)
+		}
+	}
+}
+
+func TestCheckAccess(t *testing.T) {
+	p, spicedb := newTestServer(t)
+	spicedb.schema = checkTestSchema
+	spicedb.permissions = []string{"inventory/hosts:h1#write@user:alice"}
+	p.RbacServices = testServices
+
+	alice := "alice"
+	for _, test := range []struct {
+		resourceId string
+		expected   bool
+	}{
+		{"h1", true},
+		{"h2", false},
+	} {
+		resp, err := p.CheckAccess(context.Background(), api.CheckAccessRequestObject{Body: &api.CheckIn{
+			Username: &alice, ResourceType: "inventory/hosts", ResourceId: test.resourceId, Permission: "inventory:hosts:write",
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		result := resp.(api.CheckAccess200JSONResponse)
+		if result.Allowed != test.expected || result.DebugTrace != nil {
+			t.Errorf("unexpected result for %s: %+v", test.resourceId, result)
+		}
+	}
+}
+
+func TestCheckAccessWithDebugTrace(t *testing.T) {
+	p, spicedb := newTestServer(t)
+	spicedb.schema = checkTestSchema
+	spicedb.permissions = []string{"inventory/hosts:h1#write@user:alice"}
+	p.RbacServices = testServices
+
+	alice, debug := "alice", true
+	resp, err := p.CheckAccess(context.Background(), api.CheckAccessRequestObject{Body: &api.CheckIn{
+		Username: &alice, ResourceType: "inventory/hosts", ResourceId: "h1", Permission: "inventory:hosts:write", Debug: &debug,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := resp.(api.CheckAccess200JSONResponse)
+	if !result.Allowed || result.DebugTrace == nil {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	// One trace for the user and one for the platform default group
+	traces := *result.DebugTrace
+	if len(traces) != 2 || traces[0]["result"] != "PERMISSIONSHIP_HAS_PERMISSION" || traces[1]["result"] != "PERMISSIONSHIP_NO_PERMISSION" {
+		t.Errorf("unexpected traces: %+v", traces)
+	}
+}
+
+func TestCheckAccessBulk(t *testing.T) {
+	p, spicedb := newTestServer(t)
+	spicedb.schema = checkTestSchema
+	spicedb.permissions = []string{"inventory/hosts:h2#read@user:alice"}
+	p.RbacServices = testServices
+
+	alice := "alice"
+	resp, err := p.CheckAccessBulk(context.Background(), api.CheckAccessBulkRequestObject{Body: &api.CheckBulkIn{
+		Username: &alice,
+		Data: []api.CheckItem{
+			{ResourceType: "inventory/hosts", ResourceId: "h1", Permission: "inventory:hosts:read"},
+			{ResourceType: "inventory/hosts", ResourceId: "h2", Permission: "inventory:hosts:read"},
+		},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := resp.(api.CheckAccessBulk200JSONResponse).Data
+	if len(data) != 2 || data[0].Allowed || !data[1].Allowed {
+		t.Errorf("unexpected results: %+v", data)
+	}
+
+	resp, _ = p.CheckAccessBulk(context.Background(), api.CheckAccessBulkRequestObject{Body: &api.CheckBulkIn{
+		Username: &alice,
+		Data:     []api.CheckItem{{ResourceType: "inventory/hosts", ResourceId: "h1", Permission: "cost-management:*:read"}},
+	}})
+	if _, ok := resp.(api.CheckAccessBulk400JSONResponse); !ok {
+		t.Errorf("expected 400, got %+v", resp)
+	}
+}
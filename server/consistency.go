@@ -0,0 +1,67 @@
+package server
+
+import (
+	"fmt"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+)
+
+// ConsistencyMode is how fresh the relationships SpiceDB answers checks and lookups from must be.
+type ConsistencyMode string
+
+const (
+	// ConsistencyMinimizeLatency lets SpiceDB answer from its cache, which may miss writes of the last few seconds
+	ConsistencyMinimizeLatency ConsistencyMode = "minimize_latency"
+	// ConsistencyFullyConsistent answers from the latest relationships, at the cost of the cache
+	ConsistencyFullyConsistent ConsistencyMode = "fully_consistent"
+	// ConsistencyAtLeastAsFresh answers from relationships at least as fresh as the last write made through this
+	// server, so that its own writes are seen right away
+	ConsistencyAtLeastAsFresh ConsistencyMode = "at_least_as_fresh"
+)
+
+// ParseConsistencyMode checks a consistency mode, the empty mode being ConsistencyMinimizeLatency.
+func ParseConsistencyMode(mode string) (ConsistencyMode, error) {
+	switch ConsistencyMode(mode) {
+	case "":
+		return ConsistencyMinimizeLatency, nil
+	case ConsistencyMinimizeLatency, ConsistencyFullyConsistent, ConsistencyAtLeastAsFresh:
+		return ConsistencyMode(mode), nil
+	}
+
+	return "", fmt.Errorf("unknown consistency mode %q, expected minimize_latency, fully_consistent or at_least_as_fresh", mode)
+}
+
+// consistency is the consistency to ask SpiceDB for on checks and lookups. In ConsistencyAtLeastAsFresh mode, before
+// this server has written anything, it minimizes latency.
+func (p *PrbacSpicedbServer) consistency() *v1.Consistency {
+	switch p.Consistency {
+	case ConsistencyFullyConsistent:
+		return &v1.Consistency{Requirement: &v1.Consistency_FullyConsistent{FullyConsistent: true}}
+	case ConsistencyAtLeastAsFresh:
+		if token := p.lastWritten(); token != nil {
+			return &v1.Consistency{Requirement: &v1.Consistency_AtLeastAsFresh{AtLeastAsFresh: token}}
+		}
+	}
+
+	return &v1.Consistency{Requirement: &v1.Consistency_MinimizeLatency{MinimizeLatency: true}}
+}
+
+func (p *PrbacSpicedbServer) lastWritten() *v1.ZedToken {
+	p.writtenMu.Lock()
+	defer p.writtenMu.Unlock()
+
+	return p.written
+}
+
+// recordWritten keeps the ZedToken of a write for ConsistencyAtLeastAsFresh. Writes made concurrently may be recorded
+// in either order, so a check may only be guaranteed to see one of them.
+func (p *PrbacSpicedbServer) recordWritten(token *v1.ZedToken) {
+	if token == nil {
+		return
+	}
+
+	p.writtenMu.Lock()
+	defer p.writtenMu.Unlock()
+
+	p.written = token
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+func TestConsistency(t *testing.T) {
+	if _, err := ParseConsistencyMode("eventual"); err == nil {
+		t.Error("expected an unknown consistency mode to be refused")
+	}
+	if mode, err := ParseConsistencyMode(""); err != nil || mode != ConsistencyMinimizeLatency {
+		t.Errorf("expected the empty mode to minimize latency, got %q %v", mode, err)
+	}
+
+	if c := (&PrbacSpicedbServer{}).consistency(); !c.GetMinimizeLatency() {
+		t.Errorf("expected checks to minimize latency by default, got %v", c)
+	}
+	if c := (&PrbacSpicedbServer{Consistency: ConsistencyFullyConsistent}).consistency(); !c.GetFullyConsistent() {
+		t.Errorf("expected checks to be fully consistent, got %v", c)
+	}
+
+	p, spicedb := newTestServer(t)
+	p.Consistency = ConsistencyAtLeastAsFresh
+	if c := p.consistency(); !c.GetMinimizeLatency() {
+		t.Errorf("expected checks to minimize latency before anything is written, got %v", c)
+	}
+
+	ctx := withIdentity(context.Background(), "acme", "alice", true)
+	if _, err := p.ProvisionOrganization(ctx, api.ProvisionOrganizationRequestObject{OrgId: "acme"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var written *v1.ZedToken
+	if c := p.consistency(); c.GetAtLeastAsFresh() == nil {
+		t.Fatalf("expected checks to be at least as fresh as the write, got %v", c)
+	} else {
+		written = c.GetAtLeastAsFresh()
+	}
+
+	spicedb.failWrites = 1
+	if _, err := p.ProvisionOrganization(ctx, api.ProvisionOrganizationRequestObject{OrgId: "globex"}); err != nil {
+		t.Fatal(err)
+	}
+	if c := p.consistency(); c.GetAtLeastAsFresh().GetToken() != written.GetToken() {
+		t.Errorf("expected a failed write to leave the token as it was, got %v", c)
+	}
+}
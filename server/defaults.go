@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+)
+
+// getPrincipalUsername returns the principal a query is about: the username parameter if given, otherwise the caller.
+func getPrincipalUsername(ctx context.Context, username *string) string {
+	if username != nil && *username != "" {
+		return *username
+	}
+
+	if identity, ok := identityFromContext(ctx); ok {
+		return identity.Username
+	}
+
+	return ""
+}
+
+// getPrincipalSubjects lists everything a principal's access is evaluated as. Besides the user itself, every
+// principal in an organization is implicitly a member of the organization's platform_default group, and the
+// organization's admins, as asserted by their identity, of its admin_default group.
+func getPrincipalSubjects(ctx context.Context, username string) []*v1.SubjectReference {
+	org := getUserOrg(ctx)
+
+	subjects := []*v1.SubjectReference{
+		{Object: &v1.ObjectReference{ObjectType: "user", ObjectId: username}},
+		{Object: &v1.ObjectReference{ObjectType: "group", ObjectId: getDefaultGroupId(org, "platform_default")}, OptionalRelation: "member"},
+	}
+
+	// Admin status is only known for the caller, not for other principals queried by username
+	if identity, ok := identityFromContext(ctx); ok && identity.IsOrgAdmin && identity.Username == username {
+		subjects = append(subjects, &v1.SubjectReference{
+			Object:           &v1.ObjectReference{ObjectType: "group", ObjectId: getDefaultGroupId(org, "admin_default")},
+			OptionalRelation: "member",
+		})
+	}
+
+	return subjects
+}
+
+// customizeDefaultGroup is called before a tenant changes the roles of a group. If the group is the tenant's
+// platform_default group and still tracks the system default, the system default role bindings are replaced with
+// copies under the tenant's own binding ids first, and the group is marked as customized in SpiceDB, so later
+// provisioning leaves the tenant's changes alone.
+func (p *PrbacSpicedbServer) customizeDefaultGroup(ctx context.Context, groupId string) error {
+	org := getUserOrg(ctx)
+	if groupId != getDefaultGroupId(org, "platform_default") {
+		return nil
+	}
+	if customized, err := p.isCustomizedDefaultGroup(ctx, groupId); err != nil || customized {
+		return err
+	}
+
+	group := getDefaultGroup("platform_default")
+	updates := []*v1.RelationshipUpdate{customizedUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, org, groupId)}
+	for _, role := range group.roles {
+		updates = append(updates, tenantBindingUpdates(v1.RelationshipUpdate_OPERATION_TOUCH, org, groupId, role)...)
+		updates = append(updates, rootBindingUpdates(v1.RelationshipUpdate_OPERATION_DELETE, org, groupId, role)...)
+	}
+
+	_, err := p.writeRelationships(ctx, auditEntry(ctx, "customizeDefaultGroup", auditTargets("group", groupId)...), updates)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	metadata, ok, err := p.Metadata.Get("group", groupId)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		metadata.Created = now
+	}
+	metadata.Name = "Custom default access"
+	metadata.Description = group.description
+	metadata.System = false
+	metadata.Modified = now
+
+	return p.Metadata.Put("group", groupId, metadata)
+}
+
+// isCustomizedDefaultGroup reports whether a default group no longer tracks the system default.
+func (p *PrbacSpicedbServer) isCustomizedDefaultGroup(ctx context.Context, groupId string) (bool, error) {
+	relationships, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+		ResourceType:       "group",
+		OptionalResourceId: groupId,
+		OptionalRelation:   "customized",
+	})
+	return len(relationships) != 0, err
+}
+
+// customizedUpdate marks a default group as made its own by the organization, see customizeDefaultGroup.
+func customizedUpdate(operation v1.RelationshipUpdate_Operation, org, groupId string) *v1.RelationshipUpdate {
+	return createRelationshipUpdate(operation, "group", groupId, "customized", "organization", org)
+}
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+var testServices = Services{
+	"playbook-dispatcher": Permission{
+		"run:read": ResourcePerm{
+			Permission: "dispatcher_view_runs",
+			Filter:     Filter{Name: "service", Operator: "equal", ResourceType: "dispatcher/service", Verb: "view"},
+		},
+	},
+}
+
+func TestGetPrincipalAccessThroughPlatformDefaultGroup(t *testing.T) {
+	platformDefault := getDefaultGroupId(defaultOrg, "platform_default")
+
+	p, spicedb := newTestServer(t)
+	spicedb.permissions = []string{"workspace:aspian_root#dispatcher_view_runs@group:" + platformDefault + "#member"}
+	p.RbacServices = testServices
+
+	username := "alice"
+	resp, err := p.GetPrincipalAccess(context.Background(), api.GetPrincipalAccessRequestObject{
+		Params: api.GetPrincipalAccessParams{Application: "playbook-dispatcher", Username: &username},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := resp.(api.GetPrincipalAccess200JSONResponse).Data
+	if len(data) != 1 || data[0].Permission != "playbook-dispatcher:run:read" || len(data[0].ResourceDefinitions) != 0 {
+		t.Errorf("unexpected access: %+v", data)
+	}
+}
+
+func TestGetPrincipalAccessThroughAdminDefaultGroup(t *testing.T) {
+	adminDefault := getDefaultGroupId("acme", "admin_default")
+
+	p, spicedb := newTestServer(t)
+	spicedb.permissions = []string{"dispatcher/service:remediations#view@group:" + adminDefault + "#member"}
+	p.RbacServices = testServices
+
+	for _, test := range []struct {
+		name     string
+		admin    bool
+		username string
+		expected int
+	}{
+		{"org admin", true, "", 1},
+		{"not an org admin", false, "", 0},
+		{"another principal", true, "bob", 0},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := withIdentity(context.Background(), "acme", "alice", test.admin)
+
+			params := api.GetPrincipalAccessParams{Application: "playbook-dispatcher"}
+			if test.username != "" {
+				params.Username = &test.username
+			}
+
+			resp, err := p.GetPrincipalAccess(ctx, api.GetPrincipalAccessRequestObject{Params: params})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			data := resp.(api.GetPrincipalAccess200JSONResponse).Data
+			if len(data) != test.expected {
+				t.Fatalf("expected %d accesses, got %+v", test.expected, data)
+			}
+			if test.expected > 0 && data[0].ResourceDefinitions[0].AttributeFilter.Value != "remediations" {
+				t.Errorf("unexpected access: %+v", data)
+			}
+		})
+	}
+}
+
+func TestGetPrincipalAccessWithoutPrincipal(t *testing.T) {
+	p, _ := newTestServer(t)
+	p.RbacServices = testServices
+
+	resp, err := p.GetPrincipalAccess(context.Background(), api.GetPrincipalAccessRequestObject{
+		Params: api.GetPrincipalAccessParams{Application: "playbook-dispatcher"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := resp.(api.GetPrincipalAccess401Response); !ok {
+		t.Errorf("expected 401, got %T", resp)
+	}
+}
+
+func TestCustomizedPlatformDefaultGroupSurvivesProvisioning(t *testing.T) {
+	p, spicedb := newTestServer(t)
+
+	ctx := withIdentity(context.Background(), "acme", "alice", true)
+	provision := func() {
+		if _, err := p.ProvisionOrganization(ctx, api.ProvisionOrganizationRequestObject{OrgId: "acme", Body: &api.OrganizationIn{}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	provision()
+
+	platformDefault := getDefaultGroupId("acme", "platform_default")
+	removed := permissionsToSystemRoles["inventory:hosts:read"]
+
+	resp, err := p.DeleteRoleFromGroup(ctx, api.DeleteRoleFromGroupRequestObject{
+		Uuid:   uuid.MustParse(platformDefault),
+		Params: api.DeleteRoleFromGroupParams{Roles: removed},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := resp.(api.DeleteRoleFromGroup204Response); !ok {
+		t.Fatalf("expected 204, got %T", resp)
+	}
+
+	metadata, _, _ := p.Metadata.Get("group", platformDefault)
+
+	// As after a restart with metadata lost, which customization does not depend upon
+	p.Metadata = NewInMemoryMetadataStore()
+	provision()
+
+	tuples := spicedb.tuples()
+	for _, bindingId := range []string{getRootBindingId(platformDefault, removed), getTenantBindingId(platformDefault, removed)} {
+		if slices.Contains(tuples, "workspace:acme_root#user_grant@role_binding:"+bindingId) {
+			t.Errorf("provisioning restored the removed default role as %s", bindingId)
+		}
+	}
+	kept := permissionsToSystemRoles["inventory:groups:read"]
+	if !slices.Contains(tuples, "workspace:acme_root#user_grant@role_binding:"+getTenantBindingId(platformDefault, kept)) {
+		t.Errorf("customizing the group lost the remaining default role")
+	}
+	if slices.Contains(tuples, "workspace:acme_root#user_grant@role_binding:"+getRootBindingId(platformDefault, kept)) {
+		t.Errorf("customizing the group kept the provisioned binding")
+	}
+	if !slices.Contains(tuples, "group:"+platformDefault+"#customized@organization:acme") {
+		t.Errorf("customization not recorded in SpiceDB")
+	}
+
+	if metadata.System || metadata.Name != "Custom default access" {
+		t.Errorf("unexpected metadata: %+v", metadata)
+	}
+}
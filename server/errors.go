@@ -0,0 +1,57 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+// errorBody builds the RBAC v1 error document, e.g. {"errors": [{"status": "404", "detail": "..."}]}.
+func errorBody(status int, detail string) api.Error {
+	statusText := strconv.Itoa(status)
+
+	body := api.Error{}
+	body.Errors = append(body.Errors, struct {
+		Detail *string `json:"detail,omitempty"`
+		Status *string `json:"status,omitempty"`
+	}{
+		Detail: &detail,
+		Status: &statusText,
+	})
+
+	return body
+}
+
+// notFoundBody builds the RBAC v1 error document for the 404 responses documented as ErrorNotFound.
+func notFoundBody(detail string) api.ErrorNotFound {
+	status := strconv.Itoa(404)
+
+	body := api.ErrorNotFound{}
+	body.Errors = append(body.Errors, struct {
+		Detail *string `json:"detail,omitempty"`
+		Source *string `json:"source,omitempty"`
+		Status *string `json:"status,omitempty"`
+	}{
+		Detail: &detail,
+		Status: &status,
+	})
+
+	return body
+}
+
+// forbiddenBody builds the RBAC v1 error document for 403 responses.
+func forbiddenBody(detail string) api.Error403 {
+	status := strconv.Itoa(403)
+
+	body := api.Error403{}
+	body.Errors = append(body.Errors, struct {
+		Detail *string `json:"detail,omitempty"`
+		Source *string `json:"source,omitempty"`
+		Status *string `json:"status,omitempty"`
+	}{
+		Detail: &detail,
+		Status: &status,
+	})
+
+	return body
+}
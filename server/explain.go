@@ -0,0 +1,312 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+func (p *PrbacSpicedbServer) ExplainAccess(ctx context.Context, request api.ExplainAccessRequestObject) (api.ExplainAccessResponseObject, error) {
+	params := request.Params
+
+	username := getPrincipalUsername(ctx, params.Username)
+	if username == "" {
+		return api.ExplainAccess401Response{}, nil
+	}
+
+	definitions, err := readSchemaDefinitions(ctx, p.SpicedbClient)
+	if err != nil {
+		return api.ExplainAccess500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	permission, ok := p.spicedbPermission(definitions, params.ResourceType, params.Permission)
+	if !ok {
+		return api.ExplainAccess400JSONResponse(errorBody(400, fmt.Sprintf("permission %s cannot be checked on %s", params.Permission, params.ResourceType))), nil
+	}
+
+	if found, err := p.isInUserOrg(ctx, params.ResourceType, params.ResourceId); err != nil {
+		return api.ExplainAccess500JSONResponse(errorBody(500, err.Error())), nil
+	} else if !found {
+		return api.ExplainAccess404JSONResponse(errorBody(404, "resource not found: "+params.ResourceType+":"+params.ResourceId)), nil
+	}
+
+	explanation := api.Explanation{
+		ResourceType: params.ResourceType,
+		ResourceId:   params.ResourceId,
+		Permission:   params.Permission,
+		Username:     username,
+		Traces:       make([]api.ExplainTrace, 0),
+	}
+
+	subjects := getPrincipalSubjects(ctx, username)
+
+	var grantedBy []api.AccessPath
+	for _, subject := range subjects {
+		r, debugInfo, err := p.checkPermissionWithTrace(ctx, &v1.CheckPermissionRequest{
+			Resource:   &v1.ObjectReference{ObjectType: params.ResourceType, ObjectId: params.ResourceId},
+			Permission: permission,
+			Subject:    subject,
+		})
+		if err != nil {
+			return api.ExplainAccess500JSONResponse(errorBody(500, err.Error())), nil
+		}
+
+		trace := api.ExplainTrace{
+			Subject: formatSubject(subject),
+			Allowed: r.Permissionship == v1.CheckPermissionResponse_PERMISSIONSHIP_HAS_PERMISSION,
+		}
+		explanation.Allowed = explanation.Allowed || trace.Allowed
+
+		if check := debugInfo.GetCheck(); check != nil {
+			step := p.toExplainStep(check)
+			trace.Trace = &step
+
+			if path, ok := p.grantedPathFromTrace(check, api.AccessPath{}); ok && trace.Allowed {
+				if path.Group == nil && subject.GetObject().GetObjectType() == "group" {
+					group := subject.GetObject().GetObjectId()
+					path.Group = &group
+				}
+				grantedBy = append(grantedBy, path)
+			}
+		}
+
+		explanation.Traces = append(explanation.Traces, trace)
+	}
+
+	if len(grantedBy) != 0 {
+		explanation.GrantedBy = &grantedBy
+	}
+
+	explanation.Reason, err = p.explainReason(ctx, definitions, explanation, permission, subjects, grantedBy)
+	if err != nil {
+		return api.ExplainAccess500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	return api.ExplainAccess200JSONResponse(explanation), nil
+}
+
+// explainReason sums up an explanation in a sentence. Where access is denied, it finds where the chain from the
+// resource to the principal breaks by walking the relationships: either no role bound in the workspace chain has the
+// permission, or none of those role bindings are to the principal.
+func (p *PrbacSpicedbServer) explainReason(ctx context.Context, definitions map[string]schemaDefinition, explanation api.Explanation, permission string, subjects []*v1.SubjectReference, grantedBy []api.AccessPath) (string, error) {
+	if len(grantedBy) != 0 {
+		return fmt.Sprintf("%s is granted %s by %s", explanation.Username, explanation.Permission, p.describeAccessPath(grantedBy[0])), nil
+	}
+
+	workspaces, err := p.getWorkspaceChain(ctx, explanation.ResourceType, explanation.ResourceId)
+	if err != nil {
+		return "", err
+	}
+
+	paths, err := p.getGrantPaths(ctx, definitions, explanation.ResourceType, explanation.ResourceId, permission)
+	if err != nil {
+		return "", err
+	}
+	if len(paths) == 0 {
+		return fmt.Sprintf("no role bound in workspaces %s grants %s", strings.Join(workspaces, ", "), explanation.Permission), nil
+	}
+
+	var applicable, other []api.AccessPath
+	for _, path := range paths {
+		appliesToPrincipal, err := p.pathAppliesTo(ctx, path, explanation.Username, subjects)
+		if err != nil {
+			return "", err
+		}
+
+		if appliesToPrincipal {
+			applicable = append(applicable, p.toAccessPath(path))
+		} else {
+			other = append(other, p.toAccessPath(path))
+		}
+	}
+
+	if explanation.Allowed {
+		if len(applicable) != 0 {
+			return fmt.Sprintf("%s is granted %s by %s", explanation.Username, explanation.Permission, p.describeAccessPath(applicable[0])), nil
+		}
+		return fmt.Sprintf("%s is granted %s other than through a role bound in workspaces %s", explanation.Username, explanation.Permission, strings.Join(workspaces, ", ")), nil
+	}
+
+	if len(applicable) != 0 {
+		return fmt.Sprintf("%s is bound to %s, but SpiceDB denied access, e.g. through a caveat or entitlement", explanation.Username, p.describeAccessPath(applicable[0])), nil
+	}
+
+	descriptions := make([]string, len(other))
+	for i, path := range other {
+		descriptions[i] = p.describeAccessPath(path)
+	}
+
+	return fmt.Sprintf("%s is granted by %s, but %s is not bound to any of them", explanation.Permission, strings.Join(descriptions, "; "), explanation.Username), nil
+}
+
+// pathAppliesTo reports whether a grant path's subject is the principal, everyone, or a group the principal is a
+// member of, either explicitly or as one of its default groups.
+func (p *PrbacSpicedbServer) pathAppliesTo(ctx context.Context, path grantPath, username string, subjects []*v1.SubjectReference) (bool, error) {
+	subject := path.Subject.GetObject()
+
+	switch subject.GetObjectType() {
+	case "user":
+		return subject.GetObjectId() == username || subject.GetObjectId() == "*", nil
+	case "group":
+		for _, principalSubject := range subjects {
+			if formatSubject(principalSubject) == formatSubject(path.Subject) {
+				return true, nil
+			}
+		}
+
+		members, err := p.getGroupMembers(ctx, subject.GetObjectId())
+		return slices.Contains(members, username), err
+	}
+
+	return false, nil
+}
+
+// grantedPathFromTrace follows a granted check down through its granted sub-problems to the role relation that
+// granted it, picking up the workspace, role binding and group on the way.
+func (p *PrbacSpicedbServer) grantedPathFromTrace(trace *v1.CheckDebugTrace, path api.AccessPath) (api.AccessPath, bool) {
+	if trace.GetResult() != v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION {
+		return path, false
+	}
+
+	resource := trace.GetResource()
+	switch resource.GetObjectType() {
+	case "workspace":
+		path.Workspace = resource.GetObjectId()
+	case "role_binding":
+		path.RoleBinding = resource.GetObjectId()
+		if group, ok := grantedGroupFromTrace(trace); ok {
+			path.Group = &group
+		}
+	case "role":
+		roleName := p.getRoleName(resource.GetObjectId())
+		path.Role = resource.GetObjectId()
+		path.RoleName = &roleName
+		path.Permission = p.describePermission(trace.GetPermission())
+	}
+
+	subProblems := trace.GetSubProblems().GetTraces()
+	if len(subProblems) == 0 {
+		return path, path.Role != ""
+	}
+
+	for _, subProblem := range subProblems {
+		if found, ok := p.grantedPathFromTrace(subProblem, path); ok {
+			return found, true
+		}
+	}
+
+	return path, false
+}
+
+// grantedGroupFromTrace finds the group through which a role binding's subject was satisfied, if any.
+func grantedGroupFromTrace(trace *v1.CheckDebugTrace) (string, bool) {
+	for _, subProblem := range trace.GetSubProblems().GetTraces() {
+		if subProblem.GetResult() != v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION {
+			continue
+		}
+		if subProblem.GetResource().GetObjectType() == "group" {
+			return subProblem.GetResource().GetObjectId(), true
+		}
+		if group, ok := grantedGroupFromTrace(subProblem); ok {
+			return group, true
+		}
+	}
+
+	return "", false
+}
+
+// toExplainStep renders a SpiceDB debug trace with workspaces, roles and groups named, and permissions in RBAC v1
+// form where possible.
+func (p *PrbacSpicedbServer) toExplainStep(trace *v1.CheckDebugTrace) api.ExplainStep {
+	resource := trace.GetResource()
+
+	step := api.ExplainStep{
+		Type:       resource.GetObjectType(),
+		Id:         resource.GetObjectId(),
+		Permission: p.describePermission(trace.GetPermission()),
+		Result:     api.ExplainStepResultDenied,
+	}
+
+	switch trace.GetResult() {
+	case v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION:
+		step.Result = api.ExplainStepResultGranted
+	case v1.CheckDebugTrace_PERMISSIONSHIP_CONDITIONAL_PERMISSION:
+		step.Result = api.ExplainStepResultConditional
+	}
+
+	if name := p.getObjectName(resource.GetObjectType(), resource.GetObjectId()); name != "" {
+		step.Name = &name
+	}
+
+	if trace.GetWasCachedResult() {
+		cached := true
+		step.Cached = &cached
+	}
+
+	if subProblems := trace.GetSubProblems().GetTraces(); len(subProblems) != 0 {
+		steps := make([]api.ExplainStep, len(subProblems))
+		for i, subProblem := range subProblems {
+			steps[i] = p.toExplainStep(subProblem)
+		}
+		step.Steps = &steps
+	}
+
+	return step
+}
+
+// getObjectName names workspaces, roles and groups for display, returning an empty string for anything unnamed.
+func (p *PrbacSpicedbServer) getObjectName(objectType, objectId string) string {
+	switch objectType {
+	case "role":
+		if name := p.getRoleName(objectId); name != objectId {
+			return name
+		}
+	case "workspace", "group":
+		if metadata, ok := p.getMetadata(objectType, objectId); ok {
+			return metadata.Name
+		}
+	}
+
+	return ""
+}
+
+// describePermission renders a permission or relation in RBAC v1 form if it is one, e.g. "inventory:hosts:write",
+// or as it is otherwise, e.g. "parent".
+func (p *PrbacSpicedbServer) describePermission(relation string) string {
+	if permission, ok := p.permissionFromRelation(relation); ok {
+		return permission
+	}
+
+	return relation
+}
+
+func (p *PrbacSpicedbServer) describeAccessPath(path api.AccessPath) string {
+	role := path.Role
+	if path.RoleName != nil {
+		role = *path.RoleName
+	}
+
+	subject := "role binding " + path.RoleBinding
+	if path.Group != nil {
+		group := *path.Group
+		if name := p.getObjectName("group", group); name != "" {
+			group = name
+		}
+		subject = fmt.Sprintf("role binding %s to group %s", path.RoleBinding, group)
+	}
+
+	return fmt.Sprintf("role %q through %s in workspace %s", role, subject, path.Workspace)
+}
+
+func formatSubject(subject *v1.SubjectReference) string {
+	formatted := subject.GetObject().GetObjectType() + ":" + subject.GetObject().GetObjectId()
+	if subject.GetOptionalRelation() != "" {
+		formatted += "#" + subject.GetOptionalRelation()
+	}
+
+	return formatted
+}
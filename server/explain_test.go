@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+func traceNode(object, permission string, granted bool, subProblems ...*v1.CheckDebugTrace) *v1.CheckDebugTrace {
+	objectType, objectId, _ := strings.Cut(object, ":")
+
+	result := v1.CheckDebugTrace_PERMISSIONSHIP_NO_PERMISSION
+	if granted {
+		result = v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION
+	}
+
+	trace := &v1.CheckDebugTrace{
+		Resource:   &v1.ObjectReference{ObjectType: objectType, ObjectId: objectId},
+		Permission: permission,
+		Result:     result,
+	}
+	if len(subProblems) != 0 {
+		trace.Resolution = &v1.CheckDebugTrace_SubProblems_{SubProblems: &v1.CheckDebugTrace_SubProblems{Traces: subProblems}}
+	}
+
+	return trace
+}
+
+func TestExplainTraceInRbacVocabulary(t *testing.T) {
+	p, _ := newAccessTestServer(t)
+	p.Metadata.Put("workspace", "aspian_root", Metadata{Name: "Default workspace"})
+
+	// bob writes h1 through group g1's binding b1 to role r1 in the root workspace, ws1's parent
+	trace := traceNode("inventory/hosts:h1", "write", true,
+		traceNode("workspace:ws1", "inventory_hosts_write", true,
+			traceNode("role_binding:b2", "inventory_hosts_write", false),
+			traceNode("workspace:aspian_root", "inventory_hosts_write", true,
+				traceNode("role_binding:b1", "inventory_hosts_write", true,
+					traceNode("role_binding:b1", "subject", true,
+						traceNode("group:g1", "member", true)),
+					traceNode("role:r1", "inventory_hosts_write", true)))))
+
+	path, ok := p.grantedPathFromTrace(trace, api.AccessPath{})
+	if !ok {
+		t.Fatal("no path found")
+	}
+	if path.Workspace != "aspian_root" || path.RoleBinding != "b1" || path.Role != "r1" || *path.RoleName != "Host writers" ||
+		*path.Group != "g1" || path.Permission != "inventory:hosts:write" {
+		t.Errorf("unexpected path: %+v", path)
+	}
+
+	step := p.toExplainStep(trace)
+	workspace := (*step.Steps)[0]
+	if workspace.Permission != "inventory:hosts:write" || workspace.Result != api.ExplainStepResultGranted {
+		t.Errorf("unexpected step: %+v", workspace)
+	}
+	if denied := (*workspace.Steps)[0]; denied.Type != "role_binding" || denied.Result != api.ExplainStepResultDenied {
+		t.Errorf("unexpected step: %+v", denied)
+	}
+	if root := (*workspace.Steps)[1]; root.Name == nil || *root.Name != "Default workspace" {
+		t.Errorf("unexpected step: %+v", root)
+	}
+}
+
+func TestExplainAccessGranted(t *testing.T) {
+	p, _ := newAccessTestServer(t)
+
+	carol := "carol"
+	resp, err := p.ExplainAccess(context.Background(), api.ExplainAccessRequestObject{Params: api.ExplainAccessParams{
+		ResourceType: "inventory/hosts", ResourceId: "h1", Permission: "inventory:hosts:write", Username: &carol,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	explanation := resp.(api.ExplainAccess200JSONResponse)
+	if !explanation.Allowed || len(explanation.Traces) != 2 || explanation.Traces[0].Trace == nil {
+		t.Fatalf("unexpected explanation: %+v", explanation)
+	}
+	if !strings.Contains(explanation.Reason, `role "Host writers" through role binding b1 to group g1 in workspace aspian_root`) {
+		t.Errorf("unexpected reason: %s", explanation.Reason)
+	}
+}
+
+func TestExplainAccessDenied(t *testing.T) {
+	p, spicedb := newAccessTestServer(t)
+	spicedb.permissions = nil
+
+	dave := "dave"
+	resp, err := p.ExplainAccess(context.Background(), api.ExplainAccessRequestObject{Params: api.ExplainAccessParams{
+		ResourceType: "inventory/hosts", ResourceId: "h1", Permission: "inventory:hosts:read", Username: &dave,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	explanation := resp.(api.ExplainAccess200JSONResponse)
+	if explanation.Allowed || explanation.GrantedBy != nil {
+		t.Fatalf("unexpected explanation: %+v", explanation)
+	}
+	if !strings.HasSuffix(explanation.Reason, "but dave is not bound to any of them") || !strings.Contains(explanation.Reason, "role binding b2 in workspace ws1") {
+		t.Errorf("unexpected reason: %s", explanation.Reason)
+	}
+}
@@ -0,0 +1,334 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/google/uuid"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+func (p *PrbacSpicedbServer) ExportRbac(ctx context.Context, request api.ExportRbacRequestObject) (api.ExportRbacResponseObject, error) {
+	export, err := p.Export(ctx, getUserOrg(ctx))
+	if err != nil {
+		return api.ExportRbac500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	return api.ExportRbac200JSONResponse(export), nil
+}
+
+// Export reconstructs an organization's RBAC state from its relationships, in the form Import takes:
+//   - workspaces are the descendants of the organization's root workspace, parents first
+//   - roles are those the organization owns, with their access read back as GetRoleAccess reads it
+//   - groups are those that are subjects of the role_bindings granted in the organization's workspaces, along with
+//     the organization's default groups, and each group's roles are exported as a policy
+//
+// Groups bound to no role cannot be told apart from other organizations' groups, and so are not exported.
+func (p *PrbacSpicedbServer) Export(ctx context.Context, org string) (api.RbacExport, error) {
+	rootWorkspace := getRootWorkspace(org)
+
+	workspaces, err := p.getWorkspaceDescendants(ctx, rootWorkspace)
+	if err != nil {
+		return api.RbacExport{}, err
+	}
+
+	// Every role_binding granted in the organization
+	bindings := make(map[string]bool)
+	for _, workspace := range append([]string{rootWorkspace}, workspaceIds(workspaces)...) {
+		grants, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+			ResourceType:       "workspace",
+			OptionalResourceId: workspace,
+			OptionalRelation:   "user_grant",
+		})
+		if err != nil {
+			return api.RbacExport{}, err
+		}
+
+		for _, grant := range grants {
+			bindings[grant.GetSubject().GetObject().GetObjectId()] = true
+		}
+	}
+
+	roles, bindingRoles, err := p.exportRoles(ctx, org)
+	if err != nil {
+		return api.RbacExport{}, err
+	}
+
+	groupRoles := map[string][]string{
+		getDefaultGroupId(org, "platform_default"): nil,
+		getDefaultGroupId(org, "admin_default"):    nil,
+	}
+	for binding := range bindings {
+		subjects, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+			ResourceType:       "role_binding",
+			OptionalResourceId: binding,
+			OptionalRelation:   "subject",
+			OptionalSubjectFilter: &v1.SubjectFilter{
+				SubjectType: "group",
+			},
+		})
+		if err != nil {
+			return api.RbacExport{}, err
+		}
+
+		for _, subject := range subjects {
+			groupId := subject.GetSubject().GetObject().GetObjectId()
+
+			role, ok := bindingRoles[binding]
+			if !ok {
+				// Not one of a role's own bindings, so possibly a group's binding to a system role at the root
+				if role, ok, err = p.getRootBindingRole(ctx, groupId, binding); err != nil {
+					return api.RbacExport{}, err
+				}
+			}
+
+			if ok && !slices.Contains(groupRoles[groupId], role) {
+				groupRoles[groupId] = append(groupRoles[groupId], role)
+			}
+		}
+	}
+
+	groups, policies, principals, err := p.exportGroups(ctx, org, groupRoles)
+	if err != nil {
+		return api.RbacExport{}, err
+	}
+
+	exportedWorkspaces := make([]api.RbacExportWorkspace, len(workspaces))
+	for i, workspace := range workspaces {
+		converted := p.toWorkspace(workspace)
+		exportedWorkspaces[i] = api.RbacExportWorkspace{
+			Id:          converted.Id,
+			Name:        converted.Name,
+			Description: converted.Description,
+		}
+
+		// Left out for the root workspace, so the export can be imported into an organization by another name
+		if workspace.Parent != rootWorkspace {
+			exportedWorkspaces[i].Parent = converted.Parent
+		}
+	}
+
+	return api.RbacExport{
+		Workspaces: &exportedWorkspaces,
+		Roles:      &roles,
+		Groups:     &groups,
+		Principals: &principals,
+		Policies:   &policies,
+	}, nil
+}
+
+// exportRoles exports the roles the organization owns, and maps each binding of those roles to its role.
+func (p *PrbacSpicedbServer) exportRoles(ctx context.Context, org string) ([]api.RbacExportRole, map[string]string, error) {
+	owned, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+		ResourceType:     "rbac/v1role",
+		OptionalRelation: tenantRelation,
+		OptionalSubjectFilter: &v1.SubjectFilter{
+			SubjectType:       "organization",
+			OptionalSubjectId: org,
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	roleIds := make([]string, len(owned))
+	for i, relationship := range owned {
+		roleIds[i] = relationship.GetResource().GetObjectId()
+	}
+	sort.Strings(roleIds)
+
+	bindingRoles := make(map[string]string)
+	roles := make([]api.RbacExportRole, 0, len(roleIds))
+	for _, roleId := range roleIds {
+		roleRelationships, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+			ResourceType:       "rbac/v1role",
+			OptionalResourceId: roleId,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, relationship := range roleRelationships {
+			if relationship.GetRelation() == "binding" {
+				bindingRoles[relationship.GetSubject().GetObject().GetObjectId()] = roleId
+			}
+		}
+
+		accesses, err := p.getRoleAccesses(ctx, roleId, roleRelationships)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		id, err := uuid.Parse(roleId)
+		if err != nil {
+			return nil, nil, fmt.Errorf("role %s: %w", roleId, err)
+		}
+
+		role := api.RbacExportRole{Uuid: id, Name: p.getRoleName(roleId), Access: accesses}
+		metadata, ok, err := p.Metadata.Get("role", roleId)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ok && metadata.Description != "" {
+			description := metadata.Description
+			role.Description = &description
+		}
+
+		roles = append(roles, role)
+	}
+
+	return roles, bindingRoles, nil
+}
+
+// exportGroups exports each group with its members, and its roles as a policy, as RBAC v1 keeps a policy per group.
+func (p *PrbacSpicedbServer) exportGroups(ctx context.Context, org string, groupRoles map[string][]string) ([]api.RbacExportGroup, []api.RbacExportPolicy, []api.PrincipalIn, error) {
+	groupIds := make([]string, 0, len(groupRoles))
+	for groupId := range groupRoles {
+		groupIds = append(groupIds, groupId)
+	}
+	sort.Strings(groupIds)
+
+	groups := make([]api.RbacExportGroup, 0, len(groupIds))
+	policies := make([]api.RbacExportPolicy, 0)
+	usernames := make(map[string]bool)
+
+	for _, groupId := range groupIds {
+		id, err := uuid.Parse(groupId)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("group %s: %w", groupId, err)
+		}
+
+		group := api.RbacExportGroup{Uuid: id, Name: groupId}
+		metadata, ok, err := p.Metadata.Get("group", groupId)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if ok {
+			group.Name = metadata.Name
+			if metadata.Description != "" {
+				description := metadata.Description
+				group.Description = &description
+			}
+		}
+
+		switch groupId {
+		case getDefaultGroupId(org, "platform_default"):
+			customized, err := p.isCustomizedDefaultGroup(ctx, groupId)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			platformDefault, system := true, !customized
+			group.PlatformDefault, group.System = &platformDefault, &system
+		case getDefaultGroupId(org, "admin_default"):
+			adminDefault, system := true, true
+			group.AdminDefault, group.System = &adminDefault, &system
+		}
+
+		members, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+			ResourceType:       "group",
+			OptionalResourceId: groupId,
+			OptionalRelation:   "member",
+			OptionalSubjectFilter: &v1.SubjectFilter{
+				SubjectType: "user",
+			},
+		})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		principals := make([]api.PrincipalIn, len(members))
+		for i, member := range members {
+			principals[i] = api.PrincipalIn{Username: member.GetSubject().GetObject().GetObjectId()}
+			usernames[principals[i].Username] = true
+		}
+		sort.Slice(principals, func(i, j int) bool { return principals[i].Username < principals[j].Username })
+		group.Principals = &principals
+
+		groups = append(groups, group)
+
+		if roles := groupRoles[groupId]; len(roles) != 0 {
+			sort.Strings(roles)
+
+			policy := api.RbacExportPolicy{Name: "System Policy for Group " + groupId, Group: id}
+			for _, role := range roles {
+				roleId, err := uuid.Parse(role)
+				if err != nil {
+					return nil, nil, nil, fmt.Errorf("role %s: %w", role, err)
+				}
+				policy.Roles = append(policy.Roles, roleId)
+			}
+
+			policies = append(policies, policy)
+		}
+	}
+
+	principals := make([]api.PrincipalIn, 0, len(usernames))
+	for username := range usernames {
+		principals = append(principals, api.PrincipalIn{Username: username})
+	}
+	sort.Slice(principals, func(i, j int) bool { return principals[i].Username < principals[j].Username })
+
+	return groups, policies, principals, nil
+}
+
+// getRootBindingRole finds the system role a group is bound to by a binding rootBindingUpdates or tenantBindingUpdates
+// wrote, if it is one.
+func (p *PrbacSpicedbServer) getRootBindingRole(ctx context.Context, groupId, bindingId string) (string, bool, error) {
+	grants, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+		ResourceType:       "role_binding",
+		OptionalResourceId: bindingId,
+		OptionalRelation:   "granted",
+	})
+	if err != nil {
+		return "", false, err
+	}
+
+	for _, grant := range grants {
+		role := grant.GetSubject().GetObject().GetObjectId()
+		if isSystemRole(role) && (bindingId == getRootBindingId(groupId, role) || bindingId == getTenantBindingId(groupId, role)) {
+			return role, true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+// getWorkspaceDescendants lists the workspaces below a workspace, each after its parent.
+func (p *PrbacSpicedbServer) getWorkspaceDescendants(ctx context.Context, workspaceId string) ([]workspaceRef, error) {
+	var descendants []workspaceRef
+
+	visited := map[string]bool{workspaceId: true}
+	queue := []string{workspaceId}
+	for len(queue) != 0 {
+		children, err := p.getWorkspaceChildren(ctx, queue[0])
+		if err != nil {
+			return nil, err
+		}
+		queue = queue[1:]
+
+		sort.Slice(children, func(i, j int) bool { return children[i].Id < children[j].Id })
+		for _, child := range children {
+			if visited[child.Id] {
+				return nil, fmt.Errorf("workspace hierarchy contains a cycle at %s", child.Id)
+			}
+			visited[child.Id] = true
+
+			descendants = append(descendants, child)
+			queue = append(queue, child.Id)
+		}
+	}
+
+	return descendants, nil
+}
+
+func workspaceIds(workspaces []workspaceRef) []string {
+	ids := make([]string, len(workspaces))
+	for i, workspace := range workspaces {
+		ids[i] = workspace.Id
+	}
+
+	return ids
+}
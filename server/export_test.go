@@ -0,0 +1,208 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+// newExportTestServer provisions acme and imports into it a hierarchy of workspaces, a role with both unrestricted and
+// workspace-filtered access, a group bound to it and to a system role, and a customized platform default group, keeping
+// names and descriptions in metadata.
+func newExportTestServer(t *testing.T, metadata MetadataStore) (*PrbacSpicedbServer, *fakeSpiceDB) {
+	t.Helper()
+
+	p, spicedb := newTestServer(t)
+	p.Metadata = metadata
+
+	ctx := withIdentity(context.Background(), "acme", "alice", true)
+	if _, err := p.ProvisionOrganization(ctx, api.ProvisionOrganizationRequestObject{OrgId: "acme", Body: &api.OrganizationIn{}}); err != nil {
+		t.Fatal(err)
+	}
+
+	export := parseTestExport(t, `{
+  "workspaces": [
+    {"id": "ws0", "name": "Production"},
+    {"id": "ws1", "name": "Production hosts", "description": "Hosts serving production traffic", "parent": "ws0"}
+  ],
+  "roles": [
+    {
+      "uuid": "`+importedRole+`",
+      "name": "Host operators",
+      "description": "Reads all hosts, writes hosts in ws1",
+      "access": [
+        {"permission": "inventory:hosts:read", "resourceDefinitions": []},
+        {"permission": "inventory:hosts:write", "resourceDefinitions": [
+          {"attributeFilter": {"key": "group.id", "operation": "equal", "value": "ws1"}}
+        ]}
+      ]
+    }
+  ],
+  "groups": [
+    {"uuid": "`+importedGroup+`", "name": "Operators", "principals": [{"username": "bob"}, {"username": "alice"}]},
+    {"uuid": "0a6c6f3e-2f1d-4c59-a5a5-4a0b7e9d3c33", "name": "Custom default access", "platform_default": true, "system": false}
+  ],
+  "policies": [
+    {"name": "Operators policy", "group": "`+importedGroup+`", "roles": ["`+importedRole+`", "`+permissionsToSystemRoles["inventory:groups:write"]+`"]},
+    {"name": "Default policy", "group": "0a6c6f3e-2f1d-4c59-a5a5-4a0b7e9d3c33", "roles": ["`+permissionsToSystemRoles["inventory:hosts:read"]+`"]}
+  ]
+}`)
+
+	report, err := p.Import(ctx, "acme", export, false, DefaultImportBatchSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Rejected) != 0 {
+		t.Fatalf("unexpected rejected entries: %v", reportIds(report.Rejected))
+	}
+
+	return p, spicedb
+}
+
+func TestExport(t *testing.T) {
+	p, _ := newExportTestServer(t, NewInMemoryMetadataStore())
+
+	// Another organization's role, which is left out
+	globex := withIdentity(context.Background(), "globex", "carol", true)
+	if _, err := p.ProvisionOrganization(globex, api.ProvisionOrganizationRequestObject{OrgId: "globex", Body: &api.OrganizationIn{}}); err != nil {
+		t.Fatal(err)
+	}
+	other := parseTestExport(t, `{"roles": [{"uuid": "5d0a3e51-9a49-4c77-8f5e-3c3b8f4a2e10", "name": "Globex readers",
+  "access": [{"permission": "inventory:hosts:read", "resourceDefinitions": []}]}]}`)
+	if _, err := p.Import(globex, "globex", other, false, DefaultImportBatchSize); err != nil {
+		t.Fatal(err)
+	}
+
+	export, err := p.Export(context.Background(), "acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workspaces := *export.Workspaces
+	if len(workspaces) != 2 || workspaces[0].Id != "ws0" || workspaces[0].Parent != nil || *workspaces[1].Parent != "ws0" || workspaces[1].Name != "Production hosts" {
+		t.Errorf("unexpected workspaces: %+v", workspaces)
+	}
+
+	roles := *export.Roles
+	if len(roles) != 1 || roles[0].Name != "Host operators" || len(roles[0].Access) != 2 || roles[0].Access[1].ResourceDefinitions[0].AttributeFilter.Value != "ws1" {
+		t.Errorf("unexpected roles: %+v", roles)
+	}
+
+	groups := *export.Groups
+	if len(groups) != 3 {
+		t.Fatalf("unexpected groups: %+v", groups)
+	}
+	for _, group := range groups {
+		switch group.Uuid.String() {
+		case importedGroup:
+			if group.Name != "Operators" || len(*group.Principals) != 2 || (*group.Principals)[0].Username != "alice" {
+				t.Errorf("unexpected group: %+v", group)
+			}
+		case getDefaultGroupId("acme", "platform_default"):
+			if !*group.PlatformDefault || *group.System {
+				t.Errorf("expected a customized platform default group, got %+v", group)
+			}
+		case getDefaultGroupId("acme", "admin_default"):
+			if !*group.AdminDefault || !*group.System {
+				t.Errorf("expected the admin default group, got %+v", group)
+			}
+		}
+	}
+
+	var operatorRoles []string
+	for _, policy := range *export.Policies {
+		if policy.Group.String() == importedGroup {
+			for _, role := range policy.Roles {
+				operatorRoles = append(operatorRoles, role.String())
+			}
+		}
+	}
+	if !slices.Equal(operatorRoles, []string{importedRole, permissionsToSystemRoles["inventory:groups:write"]}) {
+		t.Errorf("unexpected roles for group: %v", operatorRoles)
+	}
+
+	if principals := *export.Principals; len(principals) != 2 || principals[1].Username != "bob" {
+		t.Errorf("unexpected principals: %+v", principals)
+	}
+}
+
+func TestExportRoundTripsThroughImport(t *testing.T) {
+	source, sourceSpicedb := newExportTestServer(t, NewInMemoryMetadataStore())
+
+	export, err := source.Export(context.Background(), "acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Through JSON, as exports are kept between environments
+	encoded, err := json.Marshal(export)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, spicedb := newTestServer(t)
+
+	ctx := withIdentity(context.Background(), "acme", "alice", true)
+	if _, err := p.ProvisionOrganization(ctx, api.ProvisionOrganizationRequestObject{OrgId: "acme", Body: &api.OrganizationIn{}}); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := p.Import(ctx, "acme", parseTestExport(t, string(encoded)), false, DefaultImportBatchSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Rejected) != 0 {
+		t.Errorf("unexpected rejected entries: %v", reportIds(report.Rejected))
+	}
+
+	expected, actual := sourceSpicedb.tuples(), spicedb.tuples()
+	slices.Sort(expected)
+	slices.Sort(actual)
+	if !slices.Equal(expected, actual) {
+		t.Errorf("relationships differ after the round trip:\nexpected %v\ngot      %v", expected, actual)
+	}
+
+	reexported, err := p.Export(context.Background(), "acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	reencoded, err := json.Marshal(reexported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(encoded) != string(reencoded) {
+		t.Errorf("exports differ after the round trip:\nexpected %s\ngot      %s", encoded, reencoded)
+	}
+}
+
+func TestExportAfterRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metadata.db")
+	p, spicedb := newExportTestServer(t, openTestMetadataStore(t, path))
+
+	export, err := p.Export(context.Background(), "acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, err := json.Marshal(export)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Another instance knows only what SpiceDB and the database keep
+	restarted := &PrbacSpicedbServer{BasePath: DefaultBasePath, SpicedbClient: spicedb.client(), Metadata: openTestMetadataStore(t, path)}
+	reexported, err := restarted.Export(context.Background(), "acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	reencoded, err := json.Marshal(reexported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(encoded) != string(reencoded) {
+		t.Errorf("exports differ after a restart:\nexpected %s\ngot      %s", encoded, reencoded)
+	}
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	identityHeader = "x-rh-identity"
+
+	// defaultOrg is assumed for requests without an identity header in dev mode, e.g. when running locally
+	defaultOrg = "aspian"
+)
+
+// Identity is the caller as described by the x-rh-identity header set by the platform gateway.
+type Identity struct {
+	OrgId      string
+	Username   string
+	IsOrgAdmin bool
+
+	header string // as received, to pass on to the legacy RBAC service in shadow mode
+}
+
+type identityHeaderDocument struct {
+	Identity struct {
+		OrgId string `json:"org_id"`
+		User  struct {
+			Username   string `json:"username"`
+			IsOrgAdmin bool   `json:"is_org_admin"`
+		} `json:"user"`
+	} `json:"identity"`
+}
+
+type identityContextKey struct{}
+
+// IdentityMiddleware decodes the x-rh-identity header into the request context. Requests with a malformed header, or
+// one without an org_id, are rejected. So are requests without a header, unless in dev mode, where they are let
+// through and treated as coming from defaultOrg.
+func IdentityMiddleware(dev bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get(identityHeader)
+			if header == "" {
+				if !dev {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			identity, err := parseIdentity(header)
+			if err != nil || identity.OrgId == "" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), identityContextKey{}, identity)))
+		})
+	}
+}
+
+func parseIdentity(header string) (Identity, error) {
+	decoded, err := base64.StdEncoding.DecodeString(header)
+	if err != nil {
+		return Identity{}, err
+	}
+
+	var document identityHeaderDocument
+	if err := json.Unmarshal(decoded, &document); err != nil {
+		return Identity{}, err
+	}
+
+	return Identity{
+		OrgId:      document.Identity.OrgId,
+		Username:   document.Identity.User.Username,
+		IsOrgAdmin: document.Identity.User.IsOrgAdmin,
+		header:     header,
+	}, nil
+}
+
+func identityFromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityContextKey{}).(Identity)
+	return identity, ok
+}
+
+// getUserOrg returns the organization of the caller, defaultOrg for a request IdentityMiddleware let through without
+// an identity.
+func getUserOrg(ctx context.Context) string {
+	if identity, ok := identityFromContext(ctx); ok {
+		return identity.OrgId
+	}
+
+	return defaultOrg
+}
+
+// isOrgAdmin reports whether the caller's identity asserts that they administer their organization.
+func isOrgAdmin(ctx context.Context) bool {
+	identity, ok := identityFromContext(ctx)
+	return ok && identity.IsOrgAdmin
+}
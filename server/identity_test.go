@@ -0,0 +1,46 @@
+package server
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIdentityMiddleware(t *testing.T) {
+	encode := func(document string) string {
+		return base64.StdEncoding.EncodeToString([]byte(document))
+	}
+
+	tests := []struct {
+		name   string
+		dev    bool
+		header string
+		status int
+		org    string
+	}{
+		{"identity", false, encode(`{"identity": {"org_id": "acme", "user": {"username": "alice"}}}`), http.StatusOK, "acme"},
+		{"no identity", false, "", http.StatusUnauthorized, ""},
+		{"no identity in dev mode", true, "", http.StatusOK, defaultOrg},
+		{"identity without an org", true, encode(`{"identity": {"user": {"username": "alice"}}}`), http.StatusUnauthorized, ""},
+		{"malformed identity", true, "not base64", http.StatusUnauthorized, ""},
+	}
+
+	for _, test := range tests {
+		var org string
+		handler := IdentityMiddleware(test.dev)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			org = getUserOrg(r.Context())
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/roles/", nil)
+		if test.header != "" {
+			req.Header.Set(identityHeader, test.header)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != test.status || org != test.org {
+			t.Errorf("%s: expected %d for org %q, got %d for org %q", test.name, test.status, test.org, rec.Code, org)
+		}
+	}
+}
@@ -0,0 +1,460 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+// DefaultImportBatchSize is how many relationship updates an import writes per request, well within SpiceDB's default
+// limit of 1000.
+const DefaultImportBatchSize = 500
+
+var errInvalidBatchSize = errors.New("invalid batch size")
+
+func (p *PrbacSpicedbServer) ImportRbacExport(ctx context.Context, request api.ImportRbacExportRequestObject) (api.ImportRbacExportResponseObject, error) {
+	batchSize := DefaultImportBatchSize
+	if request.Params.BatchSize != nil {
+		batchSize = *request.Params.BatchSize
+	}
+
+	report, err := p.Import(ctx, getUserOrg(ctx), *request.Body, valueOrFalse(request.Params.DryRun), batchSize)
+	if errors.Is(err, errInvalidBatchSize) {
+		return api.ImportRbacExport400JSONResponse(errorBody(400, err.Error())), nil
+	}
+	if err != nil {
+		return api.ImportRbacExport500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	return api.ImportRbacExport200JSONResponse(report), nil
+}
+
+// Import replays an RBAC v1 export into an organization. Workspaces are created first, each under its parent. Roles are translated as CreateRole translates them, keeping
+// their ids so that policies can refer to them, and each policy binds its group to its roles as AddRoleToGroup does.
+// Everything is collected first and then written in batches of batchSize; with dryRun nothing is written.
+//
+// Roles that already exist and the default groups, which provisioning maintains, are skipped, unless the export shows
+// the platform default group was customized. Entries that cannot be translated are rejected and left out.
+func (p *PrbacSpicedbServer) Import(ctx context.Context, org string, export api.RbacExport, dryRun bool, batchSize int) (api.ImportReport, error) {
+	if batchSize < 1 {
+		return api.ImportReport{}, fmt.Errorf("%w %d, it must be at least 1", errInvalidBatchSize, batchSize)
+	}
+
+	i := &importer{
+		p:             p,
+		org:           org,
+		report:        api.ImportReport{DryRun: dryRun, Created: []api.ImportReportEntry{}, Skipped: []api.ImportReportEntry{}, Rejected: []api.ImportReportEntry{}},
+		preconditions: make(map[int]*v1.Precondition),
+		seen:          make(map[string]bool),
+		parents:       make(map[string]bool),
+		roleBindings:  make(map[string][]string),
+		groups:        make(map[string]string),
+		metadata:      make(map[metadataKey]Metadata),
+	}
+
+	if err := i.importWorkspaces(ctx, valueOrEmptySlice(export.Workspaces)); err != nil {
+		return api.ImportReport{}, err
+	}
+
+	if err := i.importRoles(ctx, valueOrEmptySlice(export.Roles)); err != nil {
+		return api.ImportReport{}, err
+	}
+
+	if export.Principals != nil {
+		i.principals = make(map[string]bool)
+		for _, principal := range *export.Principals {
+			i.principals[principal.Username] = true
+		}
+	}
+
+	if err := i.importGroups(ctx, valueOrEmptySlice(export.Groups)); err != nil {
+		return api.ImportReport{}, err
+	}
+
+	if err := i.importPolicies(ctx, valueOrEmptySlice(export.Policies)); err != nil {
+		return api.ImportReport{}, err
+	}
+
+	i.customizeDefaultGroup()
+
+	i.report.Relationships = len(i.updates)
+	i.report.Batches = (len(i.updates) + batchSize - 1) / batchSize
+
+	if dryRun {
+		return i.report, nil
+	}
+
+	for start := 0; start < len(i.updates); start += batchSize {
+		end := min(start+batchSize, len(i.updates))
+
+		entry := auditEntry(ctx, "importRbacExport", auditTargets("organization", org)...)
+		entry.OrgId = org
+		var preconditions []*v1.Precondition
+		for index := start; index < end; index++ {
+			if precondition, ok := i.preconditions[index]; ok {
+				preconditions = append(preconditions, precondition)
+			}
+		}
+
+		_, err := p.writeRelationships(ctx, entry, i.updates[start:end], preconditions...)
+		if err != nil {
+			return api.ImportReport{}, fmt.Errorf("writing batch %d of %d, after %d relationships were written: %w", start/batchSize+1, i.report.Batches, start, err)
+		}
+	}
+
+	for object, metadata := range i.metadata {
+		if err := p.Metadata.Put(object.objectType, object.objectId, metadata); err != nil {
+			return api.ImportReport{}, fmt.Errorf("storing the metadata of %s %s: %w", object.objectType, object.objectId, err)
+		}
+	}
+
+	return i.report, nil
+}
+
+// importer holds the state of a single Import.
+type importer struct {
+	p   *PrbacSpicedbServer
+	org string
+
+	report        api.ImportReport
+	updates       []*v1.RelationshipUpdate
+	preconditions map[int]*v1.Precondition // written along with the update at the same index among updates
+	seen          map[string]bool          // relationships already among updates, as SpiceDB refuses the same one twice in a request
+	parents       map[string]bool          // workspaces whose parent is among updates
+
+	roleBindings map[string][]string      // role bindings of every role a policy may refer to, by role id
+	groups       map[string]string        // group ids in this service, by their ids in the export
+	principals   map[string]bool          // usernames of the exported principals, nil if none were exported
+	metadata     map[metadataKey]Metadata // to store once everything is written
+
+	customizedDefault string // the tenant's platform default group, if the export customizes it
+}
+
+func (i *importer) importWorkspaces(ctx context.Context, workspaces []api.RbacExportWorkspace) error {
+	rootWorkspace := getRootWorkspace(i.org)
+	now := time.Now().UTC()
+
+	for _, workspace := range workspaces {
+		if i.parents[workspace.Id] || workspace.Id == rootWorkspace {
+			i.reject(api.ImportReportEntryKindWorkspace, workspace.Id, workspace.Name, "duplicate workspace")
+			continue
+		}
+
+		if _, found, err := i.p.getWorkspaceParent(ctx, workspace.Id); err != nil {
+			return err
+		} else if found {
+			if _, owned, err := i.p.getOrgWorkspace(ctx, i.org, workspace.Id); err != nil {
+				return err
+			} else if !owned {
+				i.reject(api.ImportReportEntryKindWorkspace, workspace.Id, workspace.Name, "workspace id is in use by another organization")
+				continue
+			}
+
+			i.skip(api.ImportReportEntryKindWorkspace, workspace.Id, workspace.Name, "workspace already exists")
+			continue
+		}
+
+		parent := rootWorkspace
+		if workspace.Parent != nil {
+			parent = *workspace.Parent
+		}
+
+		if parent != rootWorkspace && !i.parents[parent] {
+			if _, found, err := i.p.getOrgWorkspace(ctx, i.org, parent); err != nil {
+				return err
+			} else if !found {
+				i.reject(api.ImportReportEntryKindWorkspace, workspace.Id, workspace.Name, "unknown parent workspace "+parent)
+				continue
+			}
+		}
+
+		i.claim(workspaceParentClaim(workspace.Id, parent))
+		i.claim(tenantClaim("workspace", workspace.Id, i.org))
+		i.metadata[metadataKey{"workspace", workspace.Id}] = Metadata{Name: workspace.Name, Description: valueOrEmpty(workspace.Description), Created: now, Modified: now}
+		i.create(api.ImportReportEntryKindWorkspace, workspace.Id, workspace.Name)
+	}
+
+	return nil
+}
+
+func (i *importer) importRoles(ctx context.Context, roles []api.RbacExportRole) error {
+	rootWorkspace := getRootWorkspace(i.org)
+	now := time.Now().UTC()
+
+	for _, role := range roles {
+		roleId := role.Uuid.String()
+
+		if _, ok := i.roleBindings[roleId]; ok {
+			i.reject(api.ImportReportEntryKindRole, roleId, role.Name, "duplicate role")
+			continue
+		}
+
+		if isSystemRole(roleId) {
+			i.roleBindings[roleId] = nil
+			i.skip(api.ImportReportEntryKindRole, roleId, role.Name, "system role")
+			continue
+		}
+		if valueOrFalse(role.System) {
+			i.skip(api.ImportReportEntryKindRole, roleId, role.Name, "system role unknown to this service")
+			continue
+		}
+
+		existing, err := i.p.getRoleBindingIds(ctx, roleId)
+		if err != nil {
+			return err
+		}
+		if len(existing) != 0 {
+			if owned, err := i.p.ownsRole(ctx, i.org, roleId); err != nil {
+				return err
+			} else if !owned {
+				i.reject(api.ImportReportEntryKindRole, roleId, role.Name, "role id is in use by another organization")
+				continue
+			}
+
+			i.roleBindings[roleId] = existing
+			i.skip(api.ImportReportEntryKindRole, roleId, role.Name, "role already exists")
+			continue
+		}
+
+		if role.Name == "" {
+			i.reject(api.ImportReportEntryKindRole, roleId, role.Name, "role name is required")
+			continue
+		}
+
+		if workspaceId, err := i.p.findUnownedWorkspace(ctx, i.org, role.Access, i.parents); err != nil {
+			return err
+		} else if workspaceId != "" {
+			i.reject(api.ImportReportEntryKindRole, roleId, role.Name, "unknown workspace "+workspaceId)
+			continue
+		}
+
+		updates, unhandled := roleUpdates(rootWorkspace, roleId, role.Access)
+		for _, description := range unhandled {
+			i.reject(api.ImportReportEntryKindAccess, roleId, role.Name, description)
+		}
+
+		i.add(updates...)
+		i.claim(tenantClaim("rbac/v1role", roleId, i.org))
+		i.roleBindings[roleId] = roleBindingIds(updates)
+		i.metadata[metadataKey{"role", roleId}] = Metadata{Name: role.Name, Description: valueOrEmpty(role.Description), Created: now, Modified: now}
+		i.create(api.ImportReportEntryKindRole, roleId, role.Name)
+	}
+
+	return nil
+}
+
+func (i *importer) importGroups(ctx context.Context, groups []api.RbacExportGroup) error {
+	now := time.Now().UTC()
+
+	for _, group := range groups {
+		exportId := group.Uuid.String()
+
+		if _, ok := i.groups[exportId]; ok {
+			i.reject(api.ImportReportEntryKindGroup, exportId, group.Name, "duplicate group")
+			continue
+		}
+
+		switch {
+		case valueOrFalse(group.AdminDefault):
+			i.groups[exportId] = getDefaultGroupId(i.org, "admin_default")
+			i.skip(api.ImportReportEntryKindGroup, exportId, group.Name, "admin default group is provisioned with the organization")
+			continue
+		case valueOrFalse(group.PlatformDefault) && valueOrFalse(group.System):
+			i.groups[exportId] = getDefaultGroupId(i.org, "platform_default")
+			i.skip(api.ImportReportEntryKindGroup, exportId, group.Name, "platform default group is provisioned with the organization")
+			continue
+		case valueOrFalse(group.PlatformDefault):
+			// Every principal is implicitly a member, so only the roles of a customized platform default group matter
+			groupId := getDefaultGroupId(i.org, "platform_default")
+			i.groups[exportId] = groupId
+			i.customizedDefault = groupId
+
+			i.metadata[metadataKey{"group", groupId}] = Metadata{Name: group.Name, Description: valueOrEmpty(group.Description), Created: now, Modified: now}
+			i.create(api.ImportReportEntryKindGroup, exportId, group.Name)
+			continue
+		}
+
+		groupId := exportId
+
+		ownership, err := i.p.getGroupOwnership(ctx, i.org, groupId)
+		if err != nil {
+			return err
+		}
+		if ownership == groupNotFound {
+			i.reject(api.ImportReportEntryKindGroup, exportId, group.Name, "group id is in use by another organization")
+			continue
+		}
+
+		i.groups[exportId] = groupId
+		if ownership == groupUnclaimed {
+			i.claim(groupClaim(i.org, groupId))
+		}
+
+		_, exists, err := i.p.Metadata.Get("group", groupId)
+		if err != nil {
+			return err
+		}
+		if exists {
+			i.skip(api.ImportReportEntryKindGroup, exportId, group.Name, "group already exists, its principals and roles are added to it")
+		} else {
+			i.metadata[metadataKey{"group", groupId}] = Metadata{Name: group.Name, Description: valueOrEmpty(group.Description), Created: now, Modified: now}
+			i.create(api.ImportReportEntryKindGroup, exportId, group.Name)
+		}
+
+		for _, principal := range valueOrEmptySlice(group.Principals) {
+			if i.principals != nil && !i.principals[principal.Username] {
+				i.reject(api.ImportReportEntryKindPrincipal, principal.Username, "", "not among the exported principals, not added to group "+group.Name)
+				continue
+			}
+
+			i.add(createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "group", groupId, "member", "user", principal.Username)) // TODO: needs to be an ID not a username
+		}
+	}
+
+	return nil
+}
+
+func (i *importer) importPolicies(ctx context.Context, policies []api.RbacExportPolicy) error {
+	for _, policy := range policies {
+		policyId := policy.Name
+		if policy.Uuid != nil {
+			policyId = policy.Uuid.String()
+		}
+
+		groupId, ok := i.groups[policy.Group.String()]
+		if !ok {
+			i.reject(api.ImportReportEntryKindPolicy, policyId, policy.Name, "unknown group "+policy.Group.String())
+			continue
+		}
+
+		if groupId == getDefaultGroupId(i.org, "admin_default") || (groupId == getDefaultGroupId(i.org, "platform_default") && groupId != i.customizedDefault) {
+			i.skip(api.ImportReportEntryKindPolicy, policyId, policy.Name, "default group roles are provisioned with the organization")
+			continue
+		}
+
+		var updates []*v1.RelationshipUpdate
+		var unknown string
+		for _, role := range policy.Roles {
+			roleId := role.String()
+
+			bindings, ok := i.roleBindings[roleId]
+			if !ok {
+				// Not in the export, but possibly imported before
+				existing, err := i.p.getRoleBindingIds(ctx, roleId)
+				if err != nil {
+					return err
+				}
+				if owned, err := i.p.ownsRole(ctx, i.org, roleId); err != nil {
+					return err
+				} else if (len(existing) == 0 && !isSystemRole(roleId)) || !owned {
+					unknown = roleId
+					break
+				}
+				bindings = existing
+			}
+
+			updates = append(updates, groupRoleUpdates(v1.RelationshipUpdate_OPERATION_TOUCH, i.org, groupId, roleId, bindings)...)
+		}
+
+		if unknown != "" {
+			i.reject(api.ImportReportEntryKindPolicy, policyId, policy.Name, "unknown role "+unknown)
+			continue
+		}
+
+		i.add(updates...)
+		i.create(api.ImportReportEntryKindPolicy, policyId, policy.Name)
+	}
+
+	return nil
+}
+
+// customizeDefaultGroup marks a customized platform default group as such, and removes the default roles provisioning
+// bound it to, leaving it bound to the roles of its policies under the tenant's own bindings.
+func (i *importer) customizeDefaultGroup() {
+	if i.customizedDefault == "" {
+		return
+	}
+
+	i.add(customizedUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, i.org, i.customizedDefault))
+	for _, role := range getDefaultGroup("platform_default").roles {
+		i.add(rootBindingUpdates(v1.RelationshipUpdate_OPERATION_DELETE, i.org, i.customizedDefault, role)...)
+	}
+}
+
+// add queues relationship updates, leaving out any already queued, and keeps track of the workspaces given a parent.
+func (i *importer) add(updates ...*v1.RelationshipUpdate) {
+	for _, update := range updates {
+		relationship := update.GetRelationship()
+
+		key := relationshipKey(relationship)
+		if i.seen[key] {
+			continue
+		}
+
+		if relationship.GetResource().GetObjectType() == "workspace" && relationship.GetRelation() == "parent" {
+			i.parents[relationship.GetResource().GetObjectId()] = true
+		}
+
+		i.seen[key] = true
+		i.updates = append(i.updates, update)
+	}
+}
+
+// claim queues an update along with a precondition the batch writing it must meet, such as that of
+// workspaceParentClaim.
+func (i *importer) claim(update *v1.RelationshipUpdate, precondition *v1.Precondition) {
+	queued := len(i.updates)
+	i.add(update)
+	if len(i.updates) > queued {
+		i.preconditions[queued] = precondition
+	}
+}
+
+func (i *importer) create(kind api.ImportReportEntryKind, id, name string) {
+	i.report.Created = append(i.report.Created, importReportEntry(kind, id, name, ""))
+}
+
+func (i *importer) skip(kind api.ImportReportEntryKind, id, name, reason string) {
+	i.report.Skipped = append(i.report.Skipped, importReportEntry(kind, id, name, reason))
+}
+
+func (i *importer) reject(kind api.ImportReportEntryKind, id, name, reason string) {
+	i.report.Rejected = append(i.report.Rejected, importReportEntry(kind, id, name, reason))
+}
+
+func importReportEntry(kind api.ImportReportEntryKind, id, name, reason string) api.ImportReportEntry {
+	entry := api.ImportReportEntry{Kind: kind, Id: id}
+	if name != "" {
+		entry.Name = &name
+	}
+	if reason != "" {
+		entry.Reason = &reason
+	}
+
+	return entry
+}
+
+func relationshipKey(relationship *v1.Relationship) string {
+	return fmt.Sprintf("%s:%s#%s@%s:%s#%s",
+		relationship.GetResource().GetObjectType(), relationship.GetResource().GetObjectId(),
+		relationship.GetRelation(),
+		relationship.GetSubject().GetObject().GetObjectType(), relationship.GetSubject().GetObject().GetObjectId(),
+		relationship.GetSubject().GetOptionalRelation())
+}
+
+type metadataKey struct {
+	objectType string
+	objectId   string
+}
+
+func valueOrEmptySlice[T any](values *[]T) []T {
+	if values == nil {
+		return nil
+	}
+
+	return *values
+}
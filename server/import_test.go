@@ -0,0 +1,287 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+const (
+	importedRole  = "7c1d6a2e-1b8f-4d3a-9a64-0e0f3c2b5a11"
+	importedGroup = "3f9e2b7c-6d54-4a1e-8b2f-5c7d9e0a1b22"
+)
+
+// testExport is shaped as RBAC v1 returns its objects, with unrestricted access as empty resource definitions.
+var testExport = `{
+  "workspaces": [{"id": "ws1", "name": "Web servers"}],
+  "roles": [
+    {
+      "uuid": "` + importedRole + `",
+      "name": "Host operators",
+      "description": "Reads all hosts, writes hosts in ws1",
+      "access": [
+        {"permission": "inventory:hosts:read", "resourceDefinitions": []},
+        {"permission": "inventory:hosts:write", "resourceDefinitions": [
+          {"attributeFilter": {"key": "group.id", "operation": "equal", "value": "ws1"}}
+        ]},
+        {"permission": "cost-management:cost_model:read", "resourceDefinitions": [
+          {"attributeFilter": {"key": "cost-management.cost_model", "operation": "equal", "value": "m1"}}
+        ]}
+      ]
+    },
+    {
+      "uuid": "` + permissionsToSystemRoles["inventory:groups:read"] + `",
+      "name": "Inventory groups viewer",
+      "system": true,
+      "access": [{"permission": "inventory:groups:read", "resourceDefinitions": []}]
+    }
+  ],
+  "groups": [
+    {
+      "uuid": "` + importedGroup + `",
+      "name": "Operators",
+      "principals": [{"username": "alice"}, {"username": "mallory"}]
+    },
+    {
+      "uuid": "0a6c6f3e-2f1d-4c59-a5a5-4a0b7e9d3c33",
+      "name": "Default access",
+      "platform_default": true,
+      "system": true
+    }
+  ],
+  "principals": [{"username": "alice"}, {"username": "bob"}],
+  "policies": [
+    {"uuid": "5b2e8d1f-9c3a-4f6e-b7d4-2a1c0e9f8d44", "name": "Operators policy", "group": "` + importedGroup + `", "roles": ["` + importedRole + `"]},
+    {"name": "Stale policy", "group": "` + importedGroup + `", "roles": ["9d8c7b6a-5f4e-4d3c-8b2a-1f0e9d8c7b55"]}
+  ]
+}`
+
+func parseTestExport(t *testing.T, export string) api.RbacExport {
+	t.Helper()
+
+	var parsed api.RbacExport
+	if err := json.Unmarshal([]byte(export), &parsed); err != nil {
+		t.Fatal(err)
+	}
+
+	return parsed
+}
+
+func reportIds(entries []api.ImportReportEntry) []string {
+	ids := make([]string, len(entries))
+	for i, entry := range entries {
+		ids[i] = string(entry.Kind) + ":" + entry.Id
+	}
+
+	return ids
+}
+
+func TestImport(t *testing.T) {
+	p, spicedb := newTestServer(t)
+
+	report, err := p.Import(context.Background(), defaultOrg, parseTestExport(t, testExport), false, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if created := reportIds(report.Created); !slices.Equal(created, []string{"workspace:ws1", "role:" + importedRole, "group:" + importedGroup, "policy:5b2e8d1f-9c3a-4f6e-b7d4-2a1c0e9f8d44"}) {
+		t.Errorf("unexpected created entries: %v", created)
+	}
+	if skipped := reportIds(report.Skipped); !slices.Equal(skipped, []string{"role:" + permissionsToSystemRoles["inventory:groups:read"], "group:0a6c6f3e-2f1d-4c59-a5a5-4a0b7e9d3c33"}) {
+		t.Errorf("unexpected skipped entries: %v", skipped)
+	}
+	if rejected := reportIds(report.Rejected); !slices.Equal(rejected, []string{"access:" + importedRole, "principal:mallory", "policy:Stale policy"}) {
+		t.Errorf("unexpected rejected entries: %v", rejected)
+	}
+
+	tuples := spicedb.tuples()
+	if len(tuples) != report.Relationships || report.Batches != (report.Relationships+4)/5 || spicedb.writes != report.Batches {
+		t.Errorf("expected %d relationships in %d batches, wrote %d in %d", report.Relationships, report.Batches, len(tuples), spicedb.writes)
+	}
+
+	for _, expected := range []string{
+		"role:" + importedRole + "#inventory_hosts_read@user:*",
+		"role_binding:" + importedRole + "_ws1#granted@role:" + permissionsToSystemRoles["inventory:hosts:write"],
+		"role_binding:" + importedRole + "#subject@group:" + importedGroup + "#member",
+		"role_binding:" + importedRole + "_ws1#subject@group:" + importedGroup + "#member",
+		"group:" + importedGroup + "#member@user:alice",
+	} {
+		if !slices.Contains(tuples, expected) {
+			t.Errorf("missing relationship %s", expected)
+		}
+	}
+	if slices.Contains(tuples, "group:"+importedGroup+"#member@user:mallory") {
+		t.Errorf("imported a member that is not a principal of the tenant")
+	}
+
+	if name := p.getRoleName(importedRole); name != "Host operators" {
+		t.Errorf("unexpected role name %q", name)
+	}
+	if metadata, _, _ := p.Metadata.Get("group", importedGroup); metadata.Name != "Operators" {
+		t.Errorf("unexpected group metadata: %+v", metadata)
+	}
+
+	// Importing again leaves the role as it is, but still binds it
+	report, err = p.Import(context.Background(), defaultOrg, parseTestExport(t, testExport), false, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Skipped[1].Id != importedRole || *report.Skipped[1].Reason != "role already exists" {
+		t.Errorf("unexpected skipped entries: %v", reportIds(report.Skipped))
+	}
+	if len(spicedb.tuples()) != len(tuples) {
+		t.Errorf("importing again changed relationships")
+	}
+}
+
+func TestImportDryRun(t *testing.T) {
+	p, spicedb := newTestServer(t)
+
+	ctx := withIdentity(context.Background(), defaultOrg, "alice", true)
+	dryRun := true
+	body := parseTestExport(t, testExport)
+
+	resp, err := p.ImportRbacExport(ctx, api.ImportRbacExportRequestObject{Params: api.ImportRbacExportParams{DryRun: &dryRun}, Body: &body})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := resp.(api.ImportRbacExport200JSONResponse)
+	if !report.DryRun || report.Relationships == 0 || report.Batches != 1 || len(report.Created) != 4 {
+		t.Errorf("unexpected report: %+v", report)
+	}
+	if spicedb.writes != 0 || len(spicedb.tuples()) != 0 {
+		t.Errorf("dry run wrote %d relationships", len(spicedb.tuples()))
+	}
+	if _, ok, _ := p.Metadata.Get("role", importedRole); ok {
+		t.Errorf("dry run stored metadata")
+	}
+}
+
+func TestImportRejectsInvalidBatchSize(t *testing.T) {
+	p, spicedb := newTestServer(t)
+
+	ctx := withIdentity(context.Background(), defaultOrg, "alice", true)
+	batchSize := 0
+	body := parseTestExport(t, testExport)
+
+	resp, err := p.ImportRbacExport(ctx, api.ImportRbacExportRequestObject{Params: api.ImportRbacExportParams{BatchSize: &batchSize}, Body: &body})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := resp.(api.ImportRbacExport400JSONResponse); !ok {
+		t.Errorf("expected 400, got %T", resp)
+	}
+	if spicedb.writes != 0 {
+		t.Errorf("wrote %d batches", spicedb.writes)
+	}
+}
+
+func TestImportCustomizedPlatformDefaultGroup(t *testing.T) {
+	p, spicedb := newTestServer(t)
+
+	ctx := withIdentity(context.Background(), "acme", "alice", true)
+	if _, err := p.ProvisionOrganization(ctx, api.ProvisionOrganizationRequestObject{OrgId: "acme", Body: &api.OrganizationIn{}}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The tenant dropped inventory:groups:read from its default access
+	hostsRead := permissionsToSystemRoles["inventory:hosts:read"]
+	export := parseTestExport(t, `{
+  "groups": [{"uuid": "0a6c6f3e-2f1d-4c59-a5a5-4a0b7e9d3c33", "name": "Custom default access", "platform_default": true, "system": false}],
+  "policies": [{"name": "Default policy", "group": "0a6c6f3e-2f1d-4c59-a5a5-4a0b7e9d3c33", "roles": ["`+hostsRead+`"]}]
+}`)
+
+	if _, err := p.Import(ctx, "acme", export, false, DefaultImportBatchSize); err != nil {
+		t.Fatal(err)
+	}
+
+	platformDefault := getDefaultGroupId("acme", "platform_default")
+	tuples := spicedb.tuples()
+	if !slices.Contains(tuples, "workspace:acme_root#user_grant@role_binding:"+getTenantBindingId(platformDefault, hostsRead)) {
+		t.Errorf("lost the default role the tenant kept")
+	}
+	for _, role := range getDefaultGroup("platform_default").roles {
+		if slices.Contains(tuples, "workspace:acme_root#user_grant@role_binding:"+getRootBindingId(platformDefault, role)) {
+			t.Errorf("kept the provisioned binding to %s", role)
+		}
+	}
+	if slices.Contains(tuples, "workspace:acme_root#user_grant@role_binding:"+getTenantBindingId(platformDefault, permissionsToSystemRoles["inventory:groups:read"])) {
+		t.Errorf("kept the default role the tenant dropped")
+	}
+	if customized, err := p.isCustomizedDefaultGroup(ctx, platformDefault); err != nil || !customized {
+		t.Errorf("platform default group not marked as customized")
+	}
+}
+
+func TestImportIntoAnotherOrganization(t *testing.T) {
+	p, spicedb := newTestServer(t)
+
+	if _, err := p.Import(context.Background(), "acme", parseTestExport(t, testExport), false, DefaultImportBatchSize); err != nil {
+		t.Fatal(err)
+	}
+	tuples := spicedb.tuples()
+
+	// The same ids exported by another tenant must not take over acme's role and group
+	report, err := p.Import(context.Background(), "globex", parseTestExport(t, testExport), false, DefaultImportBatchSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rejected := reportIds(report.Rejected)
+	for _, expected := range []string{"workspace:ws1", "role:" + importedRole, "group:" + importedGroup, "policy:5b2e8d1f-9c3a-4f6e-b7d4-2a1c0e9f8d44"} {
+		if !slices.Contains(rejected, expected) {
+			t.Errorf("expected %s to be rejected, got %v", expected, rejected)
+		}
+	}
+	for _, tuple := range spicedb.tuples() {
+		if !slices.Contains(tuples, tuple) && !strings.Contains(tuple, "globex") {
+			t.Errorf("importing into globex wrote %s", tuple)
+		}
+	}
+}
+
+func TestImportRejectsRolesInUnknownWorkspaces(t *testing.T) {
+	p, spicedb := newTestServer(t, "workspace:ws1#parent@workspace:acme_root", "workspace:ws1#tenant@organization:acme")
+
+	export := parseTestExport(t, `{
+  "roles": [
+    {"uuid": "`+importedRole+`", "name": "acme's hosts", "access": [{"permission": "inventory:hosts:write", "resourceDefinitions": [
+      {"attributeFilter": {"key": "group.id", "operation": "equal", "value": "ws1"}}
+    ]}]},
+    {"uuid": "9d8c7b6a-5f4e-4d3c-8b2a-1f0e9d8c7b55", "name": "All hosts", "access": [{"permission": "inventory:hosts:write", "resourceDefinitions": [
+      {"attributeFilter": {"key": "group.id", "operation": "equal", "value": "globex_root"}}
+    ]}]}
+  ]
+}`)
+
+	report, err := p.Import(context.Background(), "globex", export, false, DefaultImportBatchSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rejected := reportIds(report.Rejected); !slices.Equal(rejected, []string{"role:" + importedRole, "role:9d8c7b6a-5f4e-4d3c-8b2a-1f0e9d8c7b55"}) {
+		t.Errorf("unexpected rejected entries: %v", rejected)
+	}
+	if spicedb.writes != 0 {
+		t.Errorf("wrote %d batches", spicedb.writes)
+	}
+}
+
+func TestImportFailsOnClaimedIds(t *testing.T) {
+	// A role claimed by globex without being bound yet, as between the import's reads and its writes
+	p, spicedb := newTestServer(t, "rbac/v1role:"+importedRole+"#tenant@organization:globex")
+
+	export := parseTestExport(t, `{"roles": [{"uuid": "`+importedRole+`", "name": "Host operators", "access": [{"permission": "inventory:hosts:read", "resourceDefinitions": []}]}]}`)
+	if _, err := p.Import(context.Background(), "acme", export, false, DefaultImportBatchSize); err == nil {
+		t.Errorf("expected the import to fail")
+	}
+
+	if tuples := spicedb.tuples(); !slices.Equal(tuples, []string{"rbac/v1role:" + importedRole + "#tenant@organization:globex"}) {
+		t.Errorf("unexpected relationships %v", tuples)
+	}
+}
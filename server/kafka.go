@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaChangeStream reads change events from a Kafka topic as a member of a consumer group, committing offsets only
+// when told to.
+type KafkaChangeStream struct {
+	reader *kafka.Reader
+}
+
+func NewKafkaChangeStream(brokers []string, topic, groupId string) *KafkaChangeStream {
+	return &KafkaChangeStream{
+		reader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers: brokers,
+			Topic:   topic,
+			GroupID: groupId,
+		}),
+	}
+}
+
+func (s *KafkaChangeStream) Fetch(ctx context.Context) (ChangeMessage, error) {
+	message, err := s.reader.FetchMessage(ctx)
+	if err != nil {
+		return ChangeMessage{}, err
+	}
+
+	return ChangeMessage{
+		Topic:     message.Topic,
+		Partition: message.Partition,
+		Offset:    message.Offset,
+		Key:       message.Key,
+		Value:     message.Value,
+	}, nil
+}
+
+func (s *KafkaChangeStream) Commit(ctx context.Context, message ChangeMessage) error {
+	return s.reader.CommitMessages(ctx, kafka.Message{
+		Topic:     message.Topic,
+		Partition: message.Partition,
+		Offset:    message.Offset,
+	})
+}
+
+func (s *KafkaChangeStream) Close() error {
+	return s.reader.Close()
+}
+
+// KafkaChangeSink publishes access changes to a Kafka topic, keyed by the group or role they change, so that changes
+// to each stay in order.
+type KafkaChangeSink struct {
+	writer *kafka.Writer
+}
+
+func NewKafkaChangeSink(brokers []string, topic string) *KafkaChangeSink {
+	return &KafkaChangeSink{
+		writer: &kafka.Writer{
+			Addr:     kafka.TCP(brokers...),
+			Topic:    topic,
+			Balancer: &kafka.Hash{},
+		},
+	}
+}
+
+func (s *KafkaChangeSink) Publish(ctx context.Context, events []AccessChangeEvent) error {
+	messages := make([]kafka.Message, len(events))
+	for i, event := range events {
+		value, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		key := event.Group
+		if key == "" {
+			key = event.Role
+		}
+
+		messages[i] = kafka.Message{Key: []byte(key), Value: value}
+	}
+
+	return s.writer.WriteMessages(ctx, messages...)
+}
+
+func (s *KafkaChangeSink) Close() error {
+	return s.writer.Close()
+}
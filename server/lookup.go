@@ -0,0 +1,174 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+func (p *PrbacSpicedbServer) LookupAccessibleResources(ctx context.Context, request api.LookupAccessibleResourcesRequestObject) (api.LookupAccessibleResourcesResponseObject, error) {
+	params := request.Params
+
+	username := getPrincipalUsername(ctx, params.Username)
+	if username == "" {
+		return api.LookupAccessibleResources401Response{}, nil
+	}
+
+	definitions, err := readSchemaDefinitions(ctx, p.SpicedbClient)
+	if err != nil {
+		return api.LookupAccessibleResources500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	permission, ok := p.spicedbPermission(definitions, params.ResourceType, params.Permission)
+	if !ok {
+		return api.LookupAccessibleResources400JSONResponse(errorBody(400, fmt.Sprintf("permission %s cannot be looked up on %s", params.Permission, params.ResourceType))), nil
+	}
+
+	cursor := lookupCursor{}
+	if params.Cursor != nil {
+		if cursor, err = decodeLookupCursor(*params.Cursor); err != nil {
+			return api.LookupAccessibleResources400JSONResponse(errorBody(400, "invalid cursor")), nil
+		}
+	}
+
+	limit := defaultLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	ids, next, err := p.lookupResourcesPage(ctx, params.ResourceType, permission, getPrincipalSubjects(ctx, username), limit, cursor)
+	if err != nil {
+		return api.LookupAccessibleResources500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	resp := api.LookupAccessibleResources200JSONResponse{Data: ids}
+	resp.Meta.Limit = limit
+
+	if next != nil {
+		encoded := next.encode()
+		resp.Meta.Cursor = &encoded
+
+		query := url.Values{}
+		query.Set("resource_type", params.ResourceType)
+		query.Set("permission", params.Permission)
+		if params.Username != nil {
+			query.Set("username", *params.Username)
+		}
+		query.Set("limit", strconv.Itoa(limit))
+		query.Set("cursor", encoded)
+
+		link := p.BasePath + "/access/resources/?" + query.Encode()
+		resp.Links = &struct {
+			Next *string `json:"next,omitempty"`
+		}{Next: &link}
+	}
+
+	return resp, nil
+}
+
+// lookupCursor is where a lookup across several subjects got to: the subject being looked up, and SpiceDB's own
+// cursor into that subject's resources.
+type lookupCursor struct {
+	Subject int    `json:"subject"`
+	Token   string `json:"token,omitempty"`
+}
+
+func (c lookupCursor) encode() string {
+	encoded, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+func decodeLookupCursor(encoded string) (lookupCursor, error) {
+	var cursor lookupCursor
+
+	decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return cursor, err
+	}
+	if err := json.Unmarshal(decoded, &cursor); err != nil {
+		return cursor, err
+	}
+	if cursor.Subject < 0 {
+		return cursor, fmt.Errorf("invalid subject index %d", cursor.Subject)
+	}
+
+	return cursor, nil
+}
+
+// lookupResourcesPage returns up to limit ids of resources any of the subjects has the permission on, starting at the
+// cursor, along with the cursor for the next page, which is nil on the last page.
+//
+// Subjects are looked up one after another. A resource found for a later subject is only returned if none of the
+// earlier subjects have it too, so each resource is returned once across all pages.
+func (p *PrbacSpicedbServer) lookupResourcesPage(ctx context.Context, resourceType, permission string, subjects []*v1.SubjectReference, limit int, cursor lookupCursor) (ids []string, nextCursor *lookupCursor, err error) {
+	ctx, span := startSpan(ctx, "lookupResourcesPage", permissionAttribute.String(permission),
+		resourceAttribute.String(resourceType), subjectsAttribute.Int(len(subjects)))
+	defer func() {
+		span.SetAttributes(resultsAttribute.Int(len(ids)))
+		endSpan(span, err)
+	}()
+
+	ids = make([]string, 0, limit)
+
+	for len(ids) < limit && cursor.Subject < len(subjects) {
+		request := &v1.LookupResourcesRequest{
+			ResourceObjectType: resourceType,
+			Permission:         permission,
+			Subject:            subjects[cursor.Subject],
+			OptionalLimit:      uint32(limit - len(ids)),
+			Consistency:        p.consistency(),
+		}
+		if cursor.Token != "" {
+			request.OptionalCursor = &v1.Cursor{Token: cursor.Token}
+		}
+
+		lrClient, err := p.SpicedbClient.LookupResources(ctx, request)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		received := 0
+		for {
+			next, err := lrClient.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+
+			received++
+			cursor.Token = next.GetAfterResultCursor().GetToken()
+
+			seen, err := p.checkPermissionForAnySubject(ctx, &v1.ObjectReference{
+				ObjectType: resourceType,
+				ObjectId:   next.GetResourceObjectId(),
+			}, permission, subjects[:cursor.Subject])
+			if err != nil {
+				return nil, nil, err
+			}
+			if !seen {
+				ids = append(ids, next.GetResourceObjectId())
+			}
+		}
+
+		// Fewer results than asked for means this subject has no more
+		if received < int(request.OptionalLimit) {
+			cursor = lookupCursor{Subject: cursor.Subject + 1}
+		}
+	}
+
+	if cursor.Subject >= len(subjects) {
+		return ids, nil, nil
+	}
+
+	return ids, &cursor, nil
+}
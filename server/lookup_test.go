@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+func TestLookupAccessibleResourcesPages(t *testing.T) {
+	platformDefault := "#read@group:" + getDefaultGroupId(defaultOrg, "platform_default") + "#member"
+
+	p, spicedb := newTestServer(t)
+	spicedb.schema = checkTestSchema
+	spicedb.permissions = []string{
+		"inventory/hosts:h1#read@user:alice",
+		"inventory/hosts:h2#read@user:alice",
+		"inventory/hosts:h3#read@user:alice",
+		"inventory/hosts:h2" + platformDefault, // also readable by alice herself, so only returned once
+		"inventory/hosts:h4" + platformDefault,
+		"inventory/hosts:h5#read@user:bob",
+	}
+	p.RbacServices = testServices
+
+	alice, limit := "alice", 2
+	params := api.LookupAccessibleResourcesParams{ResourceType: "inventory/hosts", Permission: "inventory:hosts:read", Username: &alice, Limit: &limit}
+
+	var ids []string
+	for pages := 0; ; pages++ {
+		if pages == 5 {
+			t.Fatalf("lookup did not finish, got %v", ids)
+		}
+
+		resp, err := p.LookupAccessibleResources(context.Background(), api.LookupAccessibleResourcesRequestObject{Params: params})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		page := resp.(api.LookupAccessibleResources200JSONResponse)
+		if len(page.Data) > limit {
+			t.Fatalf("page exceeds limit: %v", page.Data)
+		}
+		ids = append(ids, page.Data...)
+
+		if page.Meta.Cursor == nil {
+			if page.Links != nil {
+				t.Errorf("last page links to a next page: %+v", page.Links)
+			}
+			break
+		}
+		params.Cursor = page.Meta.Cursor
+	}
+
+	if !slices.Equal(ids, []string{"h1", "h2", "h3", "h4"}) {
+		t.Errorf("unexpected resources: %v", ids)
+	}
+}
+
+func TestLookupAccessibleResourcesRejectsInvalidRequests(t *testing.T) {
+	p, spicedb := newTestServer(t)
+	spicedb.schema = checkTestSchema
+	p.RbacServices = testServices
+
+	alice, cursor := "alice", "not a cursor"
+	for _, params := range []api.LookupAccessibleResourcesParams{
+		{ResourceType: "inventory/hosts", Permission: "inventory:groups:read", Username: &alice},
+		{ResourceType: "inventory/hosts", Permission: "inventory:hosts:read", Username: &alice, Cursor: &cursor},
+	} {
+		resp, _ := p.LookupAccessibleResources(context.Background(), api.LookupAccessibleResourcesRequestObject{Params: params})
+		if _, ok := resp.(api.LookupAccessibleResources400JSONResponse); !ok {
+			t.Errorf("expected 400 for %+v, got %+v", params, resp)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Metadata is descriptive information about an object that has no place in SpiceDB relationships.
+type Metadata struct {
+	Name        string
+	Description string
+	System      bool // created and maintained by this service rather than by the tenant
+	Created     time.Time
+	Modified    time.Time
+}
+
+// MetadataStore keeps Metadata for objects, keyed by SpiceDB object type and id.
+type MetadataStore interface {
+	Get(objectType, objectId string) (Metadata, bool, error)
+	Put(objectType, objectId string, metadata Metadata) error
+	Delete(objectType, objectId string) error
+}
+
+type inMemoryMetadataStore struct {
+	mu       sync.RWMutex
+	metadata map[string]Metadata
+}
+
+// NewInMemoryMetadataStore keeps metadata for as long as the process runs, for tests and dev mode.
+func NewInMemoryMetadataStore() MetadataStore {
+	return &inMemoryMetadataStore{metadata: make(map[string]Metadata)}
+}
+
+func (s *inMemoryMetadataStore) Get(objectType, objectId string) (Metadata, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	metadata, ok := s.metadata[objectType+":"+objectId]
+	return metadata, ok, nil
+}
+
+func (s *inMemoryMetadataStore) Put(objectType, objectId string, metadata Metadata) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.metadata[objectType+":"+objectId] = metadata
+	return nil
+}
+
+func (s *inMemoryMetadataStore) Delete(objectType, objectId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.metadata, objectType+":"+objectId)
+	return nil
+}
+
+// SQLMetadataStore keeps metadata in a SQL database, in the metadata table. Statements use $n placeholders, as
+// PostgreSQL and SQLite both accept.
+type SQLMetadataStore struct {
+	db *sql.DB
+}
+
+// NewSQLMetadataStore creates the metadata table in db unless it exists.
+func NewSQLMetadataStore(db *sql.DB) (*SQLMetadataStore, error) {
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS metadata (
+		object_type TEXT NOT NULL,
+		object_id TEXT NOT NULL,
+		name TEXT NOT NULL,
+		description TEXT NOT NULL,
+		system BOOLEAN NOT NULL,
+		created BIGINT NOT NULL,
+		modified BIGINT NOT NULL,
+		PRIMARY KEY (object_type, object_id)
+	)`)
+	if err != nil {
+		return nil, fmt.Errorf("creating metadata table: %w", err)
+	}
+
+	return &SQLMetadataStore{db: db}, nil
+}
+
+func (s *SQLMetadataStore) Get(objectType, objectId string) (Metadata, bool, error) {
+	var metadata Metadata
+	var created, modified int64
+	err := s.db.QueryRow(`SELECT name, description, system, created, modified FROM metadata WHERE object_type = $1 AND object_id = $2`,
+		objectType, objectId).Scan(&metadata.Name, &metadata.Description, &metadata.System, &created, &modified)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Metadata{}, false, nil
+	}
+	if err != nil {
+		return Metadata{}, false, err
+	}
+
+	metadata.Created, metadata.Modified = timeFromNanos(created), timeFromNanos(modified)
+	return metadata, true, nil
+}
+
+func (s *SQLMetadataStore) Put(objectType, objectId string, metadata Metadata) error {
+	_, err := s.db.Exec(`INSERT INTO metadata (object_type, object_id, name, description, system, created, modified)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (object_type, object_id) DO UPDATE SET name = excluded.name, description = excluded.description,
+			system = excluded.system, created = excluded.created, modified = excluded.modified`,
+		objectType, objectId, metadata.Name, metadata.Description, metadata.System,
+		nanosFromTime(metadata.Created), nanosFromTime(metadata.Modified))
+	return err
+}
+
+func (s *SQLMetadataStore) Delete(objectType, objectId string) error {
+	_, err := s.db.Exec(`DELETE FROM metadata WHERE object_type = $1 AND object_id = $2`, objectType, objectId)
+	return err
+}
+
+// nanosFromTime stores a time as nanoseconds since the epoch, the zero time as 0.
+func nanosFromTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+func timeFromNanos(nanos int64) time.Time {
+	if nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos).UTC()
+}
+
+// getMetadata reads the metadata of an object for display, which goes on without it if it cannot be read.
+func (p *PrbacSpicedbServer) getMetadata(objectType, objectId string) (Metadata, bool) {
+	metadata, ok, err := p.Metadata.Get(objectType, objectId)
+	if err != nil {
+		fmt.Printf("[WARN] reading the metadata of %s %s: %v\n", objectType, objectId, err)
+	}
+
+	return metadata, ok
+}
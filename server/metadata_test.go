@@ -0,0 +1,85 @@
+package server
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestInMemoryMetadataStore(t *testing.T) {
+	testMetadataStore(t, NewInMemoryMetadataStore())
+}
+
+func TestSQLMetadataStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metadata.db")
+	testMetadataStore(t, openTestMetadataStore(t, path))
+
+	// What one instance stored is there for the next, as after a restart
+	metadata, ok, err := openTestMetadataStore(t, path).Get("role", "r1")
+	if err != nil || !ok || metadata.Name != "Host writers, renamed" {
+		t.Errorf("metadata not kept: %+v, %v, %v", metadata, ok, err)
+	}
+}
+
+// openTestMetadataStore opens a SQLMetadataStore in the SQLite database at path, as each instance of the service
+// opens the one it shares with the others.
+func openTestMetadataStore(t *testing.T, path string) *SQLMetadataStore {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	store, err := NewSQLMetadataStore(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+// testMetadataStore checks that a backend keeps metadata as put, replacing it on each put and forgetting it on delete.
+func testMetadataStore(t *testing.T, store MetadataStore) {
+	t.Helper()
+
+	created := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	role := Metadata{Name: "Host writers", Description: "Writes hosts", Created: created, Modified: created}
+	group := Metadata{Name: "Default access", System: true}
+
+	if err := store.Put("role", "r1", role); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put("group", "r1", group); err != nil {
+		t.Fatal(err)
+	}
+
+	if metadata, ok, err := store.Get("role", "r1"); err != nil || !ok || metadata != role {
+		t.Errorf("unexpected role metadata: %+v, %v, %v", metadata, ok, err)
+	}
+	if metadata, ok, err := store.Get("group", "r1"); err != nil || !ok || metadata != group {
+		t.Errorf("unexpected group metadata: %+v, %v, %v", metadata, ok, err)
+	}
+	if _, ok, err := store.Get("workspace", "r1"); err != nil || ok {
+		t.Errorf("found metadata never put: %v, %v", ok, err)
+	}
+
+	role.Name, role.Modified = "Host writers, renamed", created.Add(time.Hour)
+	if err := store.Put("role", "r1", role); err != nil {
+		t.Fatal(err)
+	}
+	if metadata, _, _ := store.Get("role", "r1"); metadata != role {
+		t.Errorf("metadata not replaced: %+v", metadata)
+	}
+
+	if err := store.Delete("group", "r1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, _ := store.Get("group", "r1"); ok {
+		t.Errorf("metadata not deleted")
+	}
+	if err := store.Delete("group", "r1"); err != nil {
+		t.Errorf("deleting again: %v", err)
+	}
+}
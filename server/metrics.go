@@ -0,0 +1,172 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/authzed/grpcutil"
+	"github.com/merlante/prbac-spicedb/api"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// unknownOperation labels requests that did not reach a handler, e.g. to an unknown path or with a malformed body.
+const unknownOperation = "unknown"
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "prbac_http_requests_total",
+		Help: "HTTP requests answered, by operation and status code.",
+	}, []string{"operation", "code"})
+
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "prbac_http_request_duration_seconds",
+		Help:    "Time taken to answer HTTP requests, by operation.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	spicedbRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "prbac_spicedb_requests_total",
+		Help: "Calls to SpiceDB, by RPC and gRPC status code.",
+	}, []string{"rpc", "code"})
+
+	spicedbRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "prbac_spicedb_request_duration_seconds",
+		Help:    "Time taken by calls to SpiceDB, by RPC, until the last message of a stream.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"rpc"})
+
+	lookupResourcesResults = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "prbac_spicedb_lookup_resources_results",
+		Help:    "Resources returned by a LookupResources call.",
+		Buckets: prometheus.ExponentialBuckets(1, 4, 8),
+	})
+)
+
+type operationContextKey struct{}
+
+// MetricsMiddleware records the count, status code and duration of requests, by the operation that answered them.
+// OperationMetricsMiddleware must be among the strict handler's middlewares for the operation to be known, and
+// RecoveryMiddleware must come after this one for the status code of a panic to be the one it answers with.
+func MetricsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		operation := unknownOperation
+		rw := &statusResponseWriter{ResponseWriter: w, status: http.StatusOK}
+
+		defer func() {
+			httpRequests.WithLabelValues(operation, strconv.Itoa(rw.status)).Inc()
+			httpRequestDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+		}()
+
+		next.ServeHTTP(rw, r.WithContext(context.WithValue(r.Context(), operationContextKey{}, &operation)))
+	})
+}
+
+// OperationMetricsMiddleware tells MetricsMiddleware which operation is answering a request.
+func OperationMetricsMiddleware(f api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		if operation, ok := ctx.Value(operationContextKey{}).(*string); ok {
+			*operation = operationID
+		}
+
+		return f(ctx, w, r, request)
+	}
+}
+
+// statusResponseWriter records the status code of a response.
+type statusResponseWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (w *statusResponseWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusResponseWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *statusResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		w.wroteHeader = true
+		flusher.Flush()
+	}
+}
+
+// rpcName is the method of a gRPC call, e.g. CheckPermission for /authzed.api.v1.PermissionsService/CheckPermission.
+func rpcName(fullMethod string) string {
+	_, method := grpcutil.SplitMethodName(fullMethod)
+	return method
+}
+
+func observeSpiceDbCall(rpc string, start time.Time, err error) {
+	spicedbRequests.WithLabelValues(rpc, status.Code(err).String()).Inc()
+	spicedbRequestDuration.WithLabelValues(rpc).Observe(time.Since(start).Seconds())
+}
+
+// metricsUnaryInterceptor records the count, status code and duration of calls to SpiceDB.
+func metricsUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	observeSpiceDbCall(rpcName(method), start, err)
+
+	return err
+}
+
+// metricsStreamInterceptor records streaming calls to SpiceDB as metricsUnaryInterceptor does unary calls, once their
+// last message is received, along with the number of results of LookupResources calls.
+func metricsStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	start := time.Now()
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		observeSpiceDbCall(rpcName(method), start, err)
+		return nil, err
+	}
+
+	return &metricsClientStream{ClientStream: stream, rpc: rpcName(method), start: start}, nil
+}
+
+type metricsClientStream struct {
+	grpc.ClientStream
+	rpc      string
+	start    time.Time
+	received int
+	done     bool
+}
+
+func (s *metricsClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == nil {
+		s.received++
+		return nil
+	}
+	if s.done {
+		return err
+	}
+	s.done = true
+
+	if errors.Is(err, io.EOF) {
+		observeSpiceDbCall(s.rpc, s.start, nil)
+		if s.rpc == "LookupResources" {
+			lookupResourcesResults.Observe(float64(s.received))
+		}
+	} else {
+		observeSpiceDbCall(s.rpc, s.start, err)
+	}
+
+	return err
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/go-chi/chi/v5"
+	"github.com/merlante/prbac-spicedb/api"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMetricsMiddleware(t *testing.T) {
+	p, _ := newTestServer(t)
+
+	r := chi.NewRouter()
+	r.Use(MetricsMiddleware)
+	r.Use(RecoveryMiddleware)
+	r.Use(IdentityMiddleware(true))
+	api.HandlerFromMux(api.NewStrictHandler(p, []api.StrictMiddlewareFunc{AuthorizationMiddleware(p), OperationMetricsMiddleware}), r)
+
+	counts := map[[2]string]float64{}
+	for _, labels := range [][2]string{{"GetStatus", "200"}, {"ListGroups", "401"}, {"ListCrossAccountRequests", "501"}} {
+		counts[labels] = testutil.ToFloat64(httpRequests.WithLabelValues(labels[0], labels[1]))
+	}
+
+	admin := base64.StdEncoding.EncodeToString([]byte(`{"identity": {"org_id": "acme", "user": {"username": "alice", "is_org_admin": true}}}`))
+	for _, path := range []string{"/status/", "/status/", "/groups/", "/cross-account-requests/"} {
+		request := httptest.NewRequest(http.MethodGet, path, nil)
+		if path == "/cross-account-requests/" {
+			request.Header.Set(identityHeader, admin)
+		}
+		r.ServeHTTP(httptest.NewRecorder(), request)
+	}
+
+	for labels, expected := range map[[2]string]float64{{"GetStatus", "200"}: 2, {"ListGroups", "401"}: 1, {"ListCrossAccountRequests", "501"}: 1} {
+		if got := testutil.ToFloat64(httpRequests.WithLabelValues(labels[0], labels[1])) - counts[labels]; got != expected {
+			t.Errorf("expected %v more %s requests answered with %s, got %v", expected, labels[0], labels[1], got)
+		}
+	}
+}
+
+// fakeClientStream replays results, then ends with err.
+type fakeClientStream struct {
+	grpc.ClientStream
+	results int
+	err     error
+}
+
+func (s *fakeClientStream) RecvMsg(m interface{}) error {
+	if s.results == 0 {
+		return s.err
+	}
+	s.results--
+	return nil
+}
+
+func TestSpiceDbMetricsInterceptors(t *testing.T) {
+	const checkPermission = "/authzed.api.v1.PermissionsService/CheckPermission"
+	const lookupResources = "/authzed.api.v1.PermissionsService/LookupResources"
+
+	failed := testutil.ToFloat64(spicedbRequests.WithLabelValues("CheckPermission", codes.Unavailable.String()))
+	err := metricsUnaryInterceptor(context.Background(), checkPermission, &v1.CheckPermissionRequest{}, &v1.CheckPermissionResponse{}, nil,
+		func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			return status.Error(codes.Unavailable, "connection refused")
+		})
+	if err == nil {
+		t.Fatal("expected the error to be passed on")
+	}
+	if got := testutil.ToFloat64(spicedbRequests.WithLabelValues("CheckPermission", codes.Unavailable.String())) - failed; got != 1 {
+		t.Errorf("expected the failed call to be counted, got %v", got)
+	}
+
+	succeeded := testutil.ToFloat64(spicedbRequests.WithLabelValues("LookupResources", codes.OK.String()))
+	lookups, results := histogramSamples(t, lookupResourcesResults)
+	stream, err := metricsStreamInterceptor(context.Background(), &grpc.StreamDesc{ServerStreams: true}, nil, lookupResources,
+		func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return &fakeClientStream{results: 3, err: io.EOF}, nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := 0
+	for {
+		if err := stream.RecvMsg(&v1.LookupResourcesResponse{}); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		received++
+	}
+	stream.RecvMsg(&v1.LookupResourcesResponse{}) // reading past the end is not another call
+
+	if received != 3 {
+		t.Errorf("expected the results to be passed on, got %d", received)
+	}
+	if got := testutil.ToFloat64(spicedbRequests.WithLabelValues("LookupResources", codes.OK.String())) - succeeded; got != 1 {
+		t.Errorf("expected the stream to be counted once it ended, got %v", got)
+	}
+	if count, sum := histogramSamples(t, lookupResourcesResults); count-lookups != 1 || sum-results != 3 {
+		t.Errorf("expected the number of results to be observed, got %d observations of %v more", count-lookups, sum-results)
+	}
+}
+
+// histogramSamples returns how many observations a histogram has made, and their sum.
+func histogramSamples(t *testing.T, histogram prometheus.Histogram) (uint64, float64) {
+	t.Helper()
+
+	var metric dto.Metric
+	if err := histogram.Write(&metric); err != nil {
+		t.Fatal(err)
+	}
+
+	return metric.GetHistogram().GetSampleCount(), metric.GetHistogram().GetSampleSum()
+}
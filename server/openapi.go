@@ -0,0 +1,103 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/google/uuid"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+func init() {
+	// UUIDs are checked as the handlers parse them, which kin-openapi does not do by default
+	openapi3.DefineStringFormatCallback("uuid", func(value string) error {
+		_, err := uuid.Parse(value)
+		return err
+	})
+
+	// Errors describe what is wrong with a request, without the schema it was checked against
+	openapi3.SchemaErrorDetailsDisabled = true
+}
+
+// DefaultBasePath is where the API is served, as the server URL of api/openapi.json declares.
+const DefaultBasePath = "/api/rbac/v1"
+
+// GetOpenAPISpec loads the OpenAPI document embedded in the api package, declaring the API to be served at basePath.
+func GetOpenAPISpec(basePath string) (*openapi3.T, error) {
+	spec, err := api.GetSwagger()
+	if err != nil {
+		return nil, err
+	}
+
+	if basePath == "" {
+		basePath = "/" // served at the root
+	}
+
+	spec.Servers = openapi3.Servers{{URL: basePath}}
+	return spec, nil
+}
+
+// OpenAPIHandler serves the OpenAPI document of the API.
+func OpenAPIHandler(spec *openapi3.T) (http.HandlerFunc, error) {
+	document, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(document)
+	}, nil
+}
+
+// ValidationMiddleware rejects requests whose parameters or body do not match the OpenAPI document with 400 and the
+// RBAC v1 error document, before they reach a handler. Requests to paths the document does not have are let through,
+// for the router to refuse. Authentication is left to IdentityMiddleware and AuthorizationMiddleware.
+func ValidationMiddleware(spec *openapi3.T) (func(http.Handler) http.Handler, error) {
+	router, err := legacy.NewRouter(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &openapi3filter.Options{
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		MultiError:         true,
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, pathParams, err := router.FindRoute(r)
+			if err != nil {
+				if !errors.Is(err, routers.ErrPathNotFound) && !errors.Is(err, routers.ErrMethodNotAllowed) {
+					writeValidationError(w, err)
+					return
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if err := openapi3filter.ValidateRequest(r.Context(), &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: pathParams,
+				Route:      route,
+				Options:    options,
+			}); err != nil {
+				writeValidationError(w, err)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}, nil
+}
+
+func writeValidationError(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(errorBody(http.StatusBadRequest, err.Error()))
+}
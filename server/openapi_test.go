@@ -0,0 +1,116 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+func TestValidationMiddleware(t *testing.T) {
+	spec, err := GetOpenAPISpec(DefaultBasePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validation, err := ValidationMiddleware(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	specHandler, err := OpenAPIHandler(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, _ := newTestServer(t)
+
+	r := chi.NewRouter()
+	r.Get(DefaultBasePath+"/openapi.json", specHandler)
+	r.Group(func(r chi.Router) {
+		r.Use(validation)
+		api.HandlerFromMuxWithBaseURL(api.NewStrictHandler(p, nil), r, DefaultBasePath)
+	})
+
+	tests := []struct {
+		name    string
+		method  string
+		path    string
+		body    string
+		status  int
+		invalid string // part of the expected error detail, if the request is invalid
+	}{
+		{"valid", http.MethodPost, "/roles/", `{"name": "hosts", "access": [{"permission": "inventory:hosts:read", "resourceDefinitions": []}]}`, http.StatusCreated, ""},
+		{"malformed body", http.MethodPost, "/roles/", `{"name": `, http.StatusBadRequest, "request body"},
+		{"missing property", http.MethodPost, "/roles/", `{"access": []}`, http.StatusBadRequest, `property "name" is missing`},
+		{"missing parameter", http.MethodGet, "/access/", "", http.StatusBadRequest, `parameter "application"`},
+		{"malformed path parameter", http.MethodGet, "/roles/not-a-uuid/access/", "", http.StatusBadRequest, `parameter "uuid"`},
+		{"unknown path", http.MethodGet, "/unknown/", "", http.StatusNotFound, ""},
+		{"outside the base path", http.MethodGet, "/status/", "", http.StatusNotFound, ""},
+	}
+
+	for _, test := range tests {
+		path := test.path
+		if test.name != "outside the base path" {
+			path = DefaultBasePath + path
+		}
+
+		request := httptest.NewRequest(test.method, path, strings.NewReader(test.body))
+		if test.body != "" {
+			request.Header.Set("Content-Type", "application/json")
+		}
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, request)
+
+		if rec.Code != test.status {
+			t.Errorf("%s: expected %d, got %d: %s", test.name, test.status, rec.Code, rec.Body.String())
+			continue
+		}
+		if test.invalid == "" {
+			continue
+		}
+
+		var body api.Error
+		if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || len(body.Errors) != 1 || body.Errors[0].Detail == nil || *body.Errors[0].Status != "400" {
+			t.Errorf("%s: expected an error document, got %s", test.name, rec.Body.String())
+		} else if !strings.Contains(*body.Errors[0].Detail, test.invalid) {
+			t.Errorf("%s: expected the detail to mention %q, got %q", test.name, test.invalid, *body.Errors[0].Detail)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, DefaultBasePath+"/openapi.json", nil))
+	var served struct {
+		Servers []struct {
+			URL string `json:"url"`
+		} `json:"servers"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &served); err != nil || len(served.Servers) != 1 || served.Servers[0].URL != DefaultBasePath {
+		t.Errorf("expected the spec to be served with the base path as its server, got %v %v", served, err)
+	}
+}
+
+func TestValidationMiddlewareAtRoot(t *testing.T) {
+	spec, err := GetOpenAPISpec("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	validation, err := ValidationMiddleware(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler := validation(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	for path, status := range map[string]int{"/access/": http.StatusBadRequest, "/access/?application=inventory": http.StatusNoContent} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code != status {
+			t.Errorf("%s: expected %d, got %d", path, status, rec.Code)
+		}
+	}
+}
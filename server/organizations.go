@@ -0,0 +1,276 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/google/uuid"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+const (
+	defaultRealm = "redhat"
+
+	// userAccessAdministratorRole is the system role carrying rbac:*:*, see schema/spicedb_bootstrap.yaml
+	userAccessAdministratorRole = "2d6f4b1e-7507-11ee-8c9d-0242ac170005"
+)
+
+// defaultGroupNamespace seeds the name-based (version 5) UUIDs of each tenant's default groups, so that provisioning
+// the same organization twice arrives at the same groups. It was generated at random for this service, so that the ids
+// do not collide with those other systems derive from the namespaces of RFC 4122. It must never change, as existing
+// default groups would be orphaned.
+var defaultGroupNamespace = uuid.MustParse("d6cc846e-aa75-4717-9308-739074b66b2d")
+
+// defaultGroup is a group every tenant is provisioned with, bound to its roles at the tenant's root workspace.
+type defaultGroup struct {
+	kind        string
+	name        string
+	description string
+	roles       []string
+}
+
+var defaultGroups = []defaultGroup{
+	{
+		kind:        "platform_default",
+		name:        "Default access",
+		description: "Roles granted to every principal in the organization.",
+		roles: []string{
+			permissionsToSystemRoles["inventory:hosts:read"],
+			permissionsToSystemRoles["inventory:groups:read"],
+		},
+	},
+	{
+		kind:        "admin_default",
+		name:        "Default admin access",
+		description: "Roles granted to every organization administrator.",
+		roles: []string{
+			userAccessAdministratorRole,
+		},
+	},
+}
+
+func (p *PrbacSpicedbServer) ProvisionOrganization(ctx context.Context, request api.ProvisionOrganizationRequestObject) (api.ProvisionOrganizationResponseObject, error) {
+	org := request.OrgId
+	rootWorkspace := getRootWorkspace(org)
+
+	realm := defaultRealm
+	if request.Body != nil && request.Body.Realm != nil {
+		realm = *request.Body.Realm
+	}
+
+	// Every update is a TOUCH, so provisioning an organization again is harmless
+	updates := []*v1.RelationshipUpdate{
+		createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "organization", org, "realm", "realm", realm),
+		createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "workspace", rootWorkspace, "parent", "organization", org),
+		tenantUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "workspace", rootWorkspace, org),
+	}
+
+	for _, group := range defaultGroups {
+		groupId := getDefaultGroupId(org, group.kind)
+		updates = append(updates, tenantUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "group", groupId, org))
+		customized, err := p.isCustomizedDefaultGroup(ctx, groupId)
+		if err != nil {
+			return api.ProvisionOrganization500JSONResponse(errorBody(500, err.Error())), nil
+		}
+		if customized {
+			continue // the tenant has made this group its own, see customizeDefaultGroup
+		}
+
+		for _, role := range group.roles {
+			updates = append(updates, rootBindingUpdates(v1.RelationshipUpdate_OPERATION_TOUCH, org, groupId, role)...)
+		}
+	}
+
+	entry := auditEntry(ctx, "provisionOrganization", auditTargets("organization", org)...)
+	entry.OrgId = org
+	_, err := p.writeRelationships(ctx, entry, updates)
+	if err != nil {
+		return api.ProvisionOrganization500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	now := time.Now().UTC()
+	for _, group := range defaultGroups {
+		groupId := getDefaultGroupId(org, group.kind)
+		_, ok, err := p.Metadata.Get("group", groupId)
+		if err == nil && !ok {
+			err = p.Metadata.Put("group", groupId, Metadata{Name: group.name, Description: group.description, System: true, Created: now, Modified: now})
+		}
+		if err != nil {
+			return api.ProvisionOrganization500JSONResponse(errorBody(500, err.Error())), nil
+		}
+	}
+
+	return api.ProvisionOrganization200JSONResponse{
+		OrgId:                org,
+		Realm:                realm,
+		RootWorkspace:        rootWorkspace,
+		PlatformDefaultGroup: uuid.MustParse(getDefaultGroupId(org, "platform_default")),
+		AdminDefaultGroup:    uuid.MustParse(getDefaultGroupId(org, "admin_default")),
+	}, nil
+}
+
+func (p *PrbacSpicedbServer) DeprovisionOrganization(ctx context.Context, request api.DeprovisionOrganizationRequestObject) (api.DeprovisionOrganizationResponseObject, error) {
+	org := request.OrgId
+	rootWorkspace := getRootWorkspace(org)
+
+	realms, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+		ResourceType:       "organization",
+		OptionalResourceId: org,
+		OptionalRelation:   "realm",
+	})
+	if err != nil {
+		return api.DeprovisionOrganization500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	root, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+		ResourceType:       "workspace",
+		OptionalResourceId: rootWorkspace,
+		OptionalRelation:   "parent",
+	})
+	if err != nil {
+		return api.DeprovisionOrganization500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	if len(realms) == 0 && len(root) == 0 {
+		return api.DeprovisionOrganization404JSONResponse(errorBody(404, "organization not found: "+org)), nil
+	}
+
+	var defaultBindings []string
+	for _, group := range defaultGroups {
+		for _, role := range group.roles {
+			groupId := getDefaultGroupId(org, group.kind)
+			defaultBindings = append(defaultBindings, getRootBindingId(groupId, role), getTenantBindingId(groupId, role))
+		}
+	}
+
+	inUse, err := p.getWorkspaceUsage(ctx, rootWorkspace, defaultBindings...)
+	if err != nil {
+		return api.DeprovisionOrganization500JSONResponse(errorBody(500, err.Error())), nil
+	}
+	if inUse != "" {
+		return api.DeprovisionOrganization409JSONResponse(errorBody(409, fmt.Sprintf("organization %s is still referenced by %s", org, inUse))), nil
+	}
+
+	relationships := append(realms, root...)
+
+	for _, group := range defaultGroups {
+		groupId := getDefaultGroupId(org, group.kind)
+
+		members, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+			ResourceType:       "group",
+			OptionalResourceId: groupId,
+		})
+		if err != nil {
+			return api.DeprovisionOrganization500JSONResponse(errorBody(500, err.Error())), nil
+		}
+		relationships = append(relationships, members...)
+
+		for _, role := range group.roles {
+			for _, bindingId := range []string{getRootBindingId(groupId, role), getTenantBindingId(groupId, role)} {
+				bindings, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+					ResourceType:       "role_binding",
+					OptionalResourceId: bindingId,
+				})
+				if err != nil {
+					return api.DeprovisionOrganization500JSONResponse(errorBody(500, err.Error())), nil
+				}
+				relationships = append(relationships, bindings...)
+			}
+		}
+	}
+
+	for _, relation := range []string{"user_grant", tenantRelation} {
+		rootRelationships, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+			ResourceType:       "workspace",
+			OptionalResourceId: rootWorkspace,
+			OptionalRelation:   relation,
+		})
+		if err != nil {
+			return api.DeprovisionOrganization500JSONResponse(errorBody(500, err.Error())), nil
+		}
+		relationships = append(relationships, rootRelationships...)
+	}
+
+	updates := make([]*v1.RelationshipUpdate, len(relationships))
+	for i, relationship := range relationships {
+		updates[i] = &v1.RelationshipUpdate{
+			Operation:    v1.RelationshipUpdate_OPERATION_DELETE,
+			Relationship: relationship,
+		}
+	}
+
+	entry := auditEntry(ctx, "deprovisionOrganization", auditTargets("organization", org)...)
+	entry.OrgId = org
+	_, err = p.writeRelationships(ctx, entry, updates)
+	if err != nil {
+		return api.DeprovisionOrganization500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	for _, group := range defaultGroups {
+		if err := p.Metadata.Delete("group", getDefaultGroupId(org, group.kind)); err != nil {
+			return api.DeprovisionOrganization500JSONResponse(errorBody(500, err.Error())), nil
+		}
+	}
+	if err := p.Metadata.Delete("workspace", rootWorkspace); err != nil {
+		return api.DeprovisionOrganization500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	return api.DeprovisionOrganization204Response{}, nil
+}
+
+func getDefaultGroup(kind string) defaultGroup {
+	for _, group := range defaultGroups {
+		if group.kind == kind {
+			return group
+		}
+	}
+
+	panic("unknown default group " + kind)
+}
+
+// getDefaultGroupId derives the id of one of an organization's default groups, e.g. its platform_default group.
+func getDefaultGroupId(org, kind string) string {
+	return uuid.NewSHA1(defaultGroupNamespace, []byte(org+"/"+kind)).String()
+}
+
+func getRootBindingId(groupId, role string) string {
+	return groupId + "_" + role
+}
+
+// getTenantBindingId names a binding of a customized platform default group to a system role, the tenant's own copy of
+// the binding provisioning writes, see customizeDefaultGroup.
+func getTenantBindingId(groupId, role string) string {
+	return getRootBindingId(groupId+"-tenant", role)
+}
+
+// rootBindingUpdates binds a group to a role at the organization's root workspace, as is done for default groups and
+// for system roles, which have no role bindings of their own.
+func rootBindingUpdates(operation v1.RelationshipUpdate_Operation, org, groupId, role string) []*v1.RelationshipUpdate {
+	return bindingUpdates(operation, org, getRootBindingId(groupId, role), groupId, role)
+}
+
+// tenantBindingUpdates binds a customized platform default group to a system role as rootBindingUpdates does, under the
+// tenant's own binding id.
+func tenantBindingUpdates(operation v1.RelationshipUpdate_Operation, org, groupId, role string) []*v1.RelationshipUpdate {
+	return bindingUpdates(operation, org, getTenantBindingId(groupId, role), groupId, role)
+}
+
+func bindingUpdates(operation v1.RelationshipUpdate_Operation, org, bindingId, groupId, role string) []*v1.RelationshipUpdate {
+	return []*v1.RelationshipUpdate{
+		createRelationshipUpdate(operation, "role_binding", bindingId, "granted", "role", role),
+		createSubjectSetRelationshipUpdate(operation, "role_binding", bindingId, "subject", "group", groupId, "member"),
+		createRelationshipUpdate(operation, "workspace", getRootWorkspace(org), "user_grant", "role_binding", bindingId),
+	}
+}
+
+// isSystemRole reports whether a role is one of the roles defined in schema/spicedb_bootstrap.yaml.
+func isSystemRole(role string) bool {
+	if role == userAccessAdministratorRole {
+		return true
+	}
+
+	_, ok := permissionForSystemRole(role)
+	return ok
+}
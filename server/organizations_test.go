@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+func TestProvisionOrganizationIsIdempotent(t *testing.T) {
+	p, spicedb := newTestServer(t)
+
+	var first, second api.ProvisionOrganization200JSONResponse
+	for _, result := range []*api.ProvisionOrganization200JSONResponse{&first, &second} {
+		resp, err := p.ProvisionOrganization(context.Background(), api.ProvisionOrganizationRequestObject{OrgId: "acme", Body: &api.OrganizationIn{}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		*result = resp.(api.ProvisionOrganization200JSONResponse)
+	}
+
+	if first != second {
+		t.Errorf("provisioning twice gave different results: %+v, %+v", first, second)
+	}
+	if first.RootWorkspace != "acme_root" || first.Realm != "redhat" {
+		t.Errorf("unexpected organization: %+v", first)
+	}
+
+	tuples := spicedb.tuples()
+	expected := []string{
+		"organization:acme#realm@realm:redhat",
+		"workspace:acme_root#parent@organization:acme",
+		"workspace:acme_root#tenant@organization:acme",
+		"group:" + first.AdminDefaultGroup.String() + "#tenant@organization:acme",
+		"role_binding:" + first.AdminDefaultGroup.String() + "_" + userAccessAdministratorRole + "#subject@group:" + first.AdminDefaultGroup.String() + "#member",
+		"workspace:acme_root#user_grant@role_binding:" + first.PlatformDefaultGroup.String() + "_" + permissionsToSystemRoles["inventory:hosts:read"],
+	}
+	for _, tuple := range expected {
+		if !slices.Contains(tuples, tuple) {
+			t.Errorf("missing relationship %s", tuple)
+		}
+	}
+	if len(tuples) != 14 {
+		t.Errorf("expected 14 relationships, got %d: %v", len(tuples), tuples)
+	}
+
+	if metadata, ok, _ := p.Metadata.Get("group", first.PlatformDefaultGroup.String()); !ok || metadata.Name != "Default access" {
+		t.Errorf("unexpected platform default group metadata: %+v", metadata)
+	}
+}
+
+func TestDeprovisionOrganization(t *testing.T) {
+	p, spicedb := newTestServer(t)
+
+	if _, err := p.ProvisionOrganization(context.Background(), api.ProvisionOrganizationRequestObject{OrgId: "acme", Body: &api.OrganizationIn{}}); err != nil {
+		t.Fatal(err)
+	}
+
+	spicedb.write(t, v1.RelationshipUpdate_OPERATION_TOUCH, "workspace:ws1#parent@workspace:acme_root")
+	resp, _ := p.DeprovisionOrganization(context.Background(), api.DeprovisionOrganizationRequestObject{OrgId: "acme"})
+	if _, ok := resp.(api.DeprovisionOrganization409JSONResponse); !ok {
+		t.Fatalf("expected 409 response while a workspace remains, got %T", resp)
+	}
+
+	spicedb.write(t, v1.RelationshipUpdate_OPERATION_DELETE, "workspace:ws1#parent@workspace:acme_root")
+	resp, _ = p.DeprovisionOrganization(context.Background(), api.DeprovisionOrganizationRequestObject{OrgId: "acme"})
+	if _, ok := resp.(api.DeprovisionOrganization204Response); !ok {
+		t.Fatalf("expected 204 response, got %T", resp)
+	}
+	if tuples := spicedb.tuples(); len(tuples) != 0 {
+		t.Errorf("expected no relationships after deprovisioning, got %v", tuples)
+	}
+
+	resp, _ = p.DeprovisionOrganization(context.Background(), api.DeprovisionOrganizationRequestObject{OrgId: "acme"})
+	if _, ok := resp.(api.DeprovisionOrganization404JSONResponse); !ok {
+		t.Errorf("expected 404 response, got %T", resp)
+	}
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+const defaultLimit = 10
+
+// pageBounds resolves the optional limit/offset query parameters against a result set of the given size.
+func pageBounds(total int, limit, offset *int) (start, end, pageSize int) {
+	pageSize = defaultLimit
+	if limit != nil && *limit > 0 {
+		pageSize = *limit
+	}
+
+	if offset != nil && *offset > 0 {
+		start = *offset
+	}
+	if start > total {
+		start = total
+	}
+
+	end = start + pageSize
+	if end > total {
+		end = total
+	}
+
+	return
+}
+
+// paginationLinks builds the first/previous/next/last links RBAC v1 returns alongside paginated data, to path under
+// the base path the API is served at.
+func (p *PrbacSpicedbServer) paginationLinks(path string, total, start, pageSize int) *api.PaginationLinks {
+	link := func(offset int) *string {
+		l := fmt.Sprintf("%s%s?limit=%d&offset=%d", p.BasePath, path, pageSize, offset)
+		return &l
+	}
+
+	lastOffset := 0
+	if total > 0 {
+		lastOffset = ((total - 1) / pageSize) * pageSize
+	}
+
+	links := &api.PaginationLinks{
+		First: link(0),
+		Last:  link(lastOffset),
+	}
+
+	if start > 0 {
+		previous := start - pageSize
+		if previous < 0 {
+			previous = 0
+		}
+		links.Previous = link(previous)
+	}
+	if start+pageSize < total {
+		links.Next = link(start + pageSize)
+	}
+
+	return links
+}
+
+func paginationMeta(total int) *api.PaginationMeta {
+	count := int64(total)
+	return &api.PaginationMeta{Count: &count}
+}
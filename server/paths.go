@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"slices"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+)
+
+// grantPath is one way a permission on a resource is granted: a role binding in the resource's workspace or one of
+// its ancestors, binding a subject to a role that has one of the role relations the permission is computed from.
+type grantPath struct {
+	Workspace   string
+	RoleBinding string
+	Role        string
+	Relation    string
+	Subject     *v1.SubjectReference
+}
+
+// getGrantPaths finds every path through which a permission on a resource is granted, by reading the relationships
+// SpiceDB walks to evaluate it. It does not evaluate the subjects; paths to a group are returned as they are.
+func (p *PrbacSpicedbServer) getGrantPaths(ctx context.Context, definitions map[string]schemaDefinition, resourceType, resourceId, permission string) ([]grantPath, error) {
+	relations := workspaceRelations(definitions, resourceType, permission)
+
+	workspaces, err := p.getWorkspaceChain(ctx, resourceType, resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []grantPath
+	for _, workspace := range workspaces {
+		bindings, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+			ResourceType:       "workspace",
+			OptionalResourceId: workspace,
+			OptionalRelation:   "user_grant",
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, binding := range bindings {
+			bindingPaths, err := p.getBindingGrantPaths(ctx, binding.GetSubject().GetObject().GetObjectId(), relations)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, path := range bindingPaths {
+				path.Workspace = workspace
+				paths = append(paths, path)
+			}
+		}
+	}
+
+	return paths, nil
+}
+
+// getBindingGrantPaths returns a path for each subject of a role binding and each of the relations that the roles it
+// grants have. The paths have no workspace set.
+func (p *PrbacSpicedbServer) getBindingGrantPaths(ctx context.Context, bindingId string, relations []string) ([]grantPath, error) {
+	binding, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+		ResourceType:       "role_binding",
+		OptionalResourceId: bindingId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []string
+	var subjects []*v1.SubjectReference
+	for _, relationship := range binding {
+		switch relationship.GetRelation() {
+		case "granted":
+			roles = append(roles, relationship.GetSubject().GetObject().GetObjectId())
+		case "subject":
+			subjects = append(subjects, relationship.GetSubject())
+		}
+	}
+
+	var paths []grantPath
+	for _, role := range roles {
+		roleRelations, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+			ResourceType:       "role",
+			OptionalResourceId: role,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, roleRelation := range roleRelations {
+			if !slices.Contains(relations, roleRelation.GetRelation()) {
+				continue
+			}
+
+			for _, subject := range subjects {
+				paths = append(paths, grantPath{RoleBinding: bindingId, Role: role, Relation: roleRelation.GetRelation(), Subject: subject})
+			}
+		}
+	}
+
+	return paths, nil
+}
+
+// getWorkspaceChain lists the workspaces whose role bindings apply to a resource: the workspace it is in, followed
+// by that workspace's ancestors. For a workspace, the chain starts with the workspace itself.
+func (p *PrbacSpicedbServer) getWorkspaceChain(ctx context.Context, resourceType, resourceId string) ([]string, error) {
+	workspaces := []string{resourceId}
+	if resourceType != "workspace" {
+		var err error
+		if workspaces, err = p.getResourceWorkspaces(ctx, resourceType, resourceId); err != nil {
+			return nil, err
+		}
+	}
+
+	var chain []string
+	for _, workspace := range workspaces {
+		chain = append(chain, workspace)
+
+		ancestors, err := p.getWorkspaceAncestors(ctx, workspace)
+		if err != nil {
+			return nil, err
+		}
+		for _, ancestor := range ancestors {
+			chain = append(chain, ancestor.Id)
+		}
+	}
+
+	return chain, nil
+}
+
+// getGroupMembers lists the users in a group, including those in groups nested inside it.
+func (p *PrbacSpicedbServer) getGroupMembers(ctx context.Context, groupId string) ([]string, error) {
+	var users []string
+
+	visited := map[string]bool{groupId: true}
+	queue := []string{groupId}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+
+		members, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+			ResourceType:       "group",
+			OptionalResourceId: next,
+			OptionalRelation:   "member",
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range members {
+			subject := member.GetSubject().GetObject()
+			switch {
+			case subject.GetObjectType() == "user" && !slices.Contains(users, subject.GetObjectId()):
+				users = append(users, subject.GetObjectId())
+			case subject.GetObjectType() == "group" && !visited[subject.GetObjectId()]:
+				visited[subject.GetObjectId()] = true
+				queue = append(queue, subject.GetObjectId())
+			}
+		}
+	}
+
+	return users, nil
+}
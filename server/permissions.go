@@ -0,0 +1,172 @@
+package server
+
+import (
+	"sort"
+	"strings"
+)
+
+// rbacApplications are the RBAC v1 application names that role relations in the schema are generated from.
+// They are needed to split a flattened relation such as "cost_management_cost_model_read" back into its
+// application, resource type and verb, as both the application and resource type may contain underscores.
+var rbacApplications = []string{
+	"advisor",
+	"approval",
+	"automation-analytics",
+	"catalog",
+	"compliance",
+	"config-manager",
+	"content",
+	"content-sources",
+	"cost-management",
+	"drift",
+	"integrations",
+	"inventory",
+	"malware-detection",
+	"migration-analytics",
+	"notifications",
+	"ocp-advisor",
+	"openshift",
+	"patch",
+	"playbook-dispatcher",
+	"policies",
+	"provisioning",
+	"rbac",
+	"remediations",
+	"ros",
+	"sources",
+	"subscriptions",
+	"tasks",
+	"vulnerability",
+}
+
+// permissionFromRelation translates a role relation (e.g. "inventory_hosts_read") back into the RBAC v1
+// permission it was created from (e.g. "inventory:hosts:read").
+//
+// cleanNameForSchemaCompatibility is lossy, so permissions we know about (from services.json and the system
+// roles) are matched exactly first; anything else is split using the known application names.
+func (p *PrbacSpicedbServer) permissionFromRelation(relation string) (string, bool) {
+	for _, permission := range p.knownPermissions() {
+		if cleanNameForSchemaCompatibility(permission) == relation {
+			return permission, true
+		}
+	}
+
+	applications := make([]string, len(rbacApplications))
+	copy(applications, rbacApplications)
+	sort.Slice(applications, func(i, j int) bool { return len(applications[i]) > len(applications[j]) })
+
+	for _, application := range applications {
+		prefix := cleanNameForSchemaCompatibility(application) + "_"
+		if !strings.HasPrefix(relation, prefix) {
+			continue
+		}
+
+		rest := strings.TrimPrefix(relation, prefix)
+		separator := strings.LastIndex(rest, "_")
+		if separator <= 0 || separator == len(rest)-1 {
+			return "", false
+		}
+
+		return application + ":" + wildcardFromRelation(rest[:separator]) + ":" + wildcardFromRelation(rest[separator+1:]), true
+	}
+
+	return "", false
+}
+
+// spicedbPermission translates an RBAC v1 permission (e.g. "inventory:hosts:write") into the permission it is checked
+// as on a type in the schema. On workspaces that is the flattened role relation ("inventory_hosts_write"); on
+// workspace-scoped types such as inventory/hosts it is the verb ("write"), or the verb of a filter configured for the
+// permission and type in services.json. It fails if the type has no such permission, or if the permission is not about the type.
+func (p *PrbacSpicedbServer) spicedbPermission(definitions map[string]schemaDefinition, resourceType, permission string) (string, bool) {
+	definition, ok := definitions[resourceType]
+	if !ok {
+		return "", false
+	}
+
+	var candidates []string
+
+	application, key, _ := strings.Cut(permission, ":")
+	if servicePermission, ok := p.services()[application][key]; ok {
+		for _, filter := range servicePermission.filters() {
+			if filter.ResourceType == resourceType {
+				candidates = append(candidates, filter.Verb)
+			}
+		}
+		candidates = append(candidates, servicePermission.Permission)
+	}
+
+	candidates = append(candidates, cleanNameForSchemaCompatibility(permission))
+
+	if parts := strings.Split(permission, ":"); len(parts) == 3 && parts[2] != "*" {
+		typeName := strings.ReplaceAll(resourceType, "/", "_")
+		appliesToType := typeName == cleanNameForSchemaCompatibility(parts[0]+":"+parts[1]) ||
+			(parts[1] == "*" && strings.HasPrefix(resourceType, cleanNameForSchemaCompatibility(parts[0])+"/"))
+
+		if appliesToType {
+			candidates = append(candidates, parts[2])
+		}
+	}
+
+	for _, candidate := range candidates {
+		if _, ok := definition.Permissions[candidate]; ok {
+			return candidate, true
+		}
+	}
+
+	return "", false
+}
+
+// knownPermissions lists the RBAC v1 permissions this server has been configured with.
+func (p *PrbacSpicedbServer) knownPermissions() []string {
+	var permissions []string
+
+	for service, servicePermissions := range p.services() {
+		for key := range servicePermissions {
+			permissions = append(permissions, service+":"+key)
+		}
+	}
+
+	for permission := range permissionsToSystemRoles {
+		permissions = append(permissions, permission)
+	}
+
+	sort.Strings(permissions)
+
+	return permissions
+}
+
+// permissionForSystemRole finds the permission a system role was registered for in permissionsToSystemRoles.
+func permissionForSystemRole(roleId string) (string, bool) {
+	for permission, systemRole := range permissionsToSystemRoles {
+		if systemRole == roleId {
+			return permission, true
+		}
+	}
+
+	return "", false
+}
+
+// getRoleName names a role for display: roles created through the API by the name they were created with, and
+// system roles after the permission they were registered for.
+func (p *PrbacSpicedbServer) getRoleName(roleId string) string {
+	if metadata, ok := p.getMetadata("role", roleId); ok && metadata.Name != "" {
+		return metadata.Name
+	}
+
+	if roleId == userAccessAdministratorRole {
+		return "User Access administrator"
+	}
+	if permission, ok := permissionForSystemRole(roleId); ok {
+		return "System role for " + permission
+	}
+
+	return roleId
+}
+
+func wildcardFromRelation(name string) string {
+	if name == "all" {
+		return "*"
+	}
+
+	return name
+}
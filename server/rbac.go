@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+// rbacPageSize is the page size asked of the legacy RBAC service, which otherwise returns 10 results per page.
+const rbacPageSize = 1000
+
+// RbacClient reads from the legacy RBAC service, as the caller, by passing on the caller's identity header.
+type RbacClient struct {
+	BaseURL    string // e.g. http://rbac-service:8080/api/rbac/v1
+	HTTPClient *http.Client
+}
+
+// rbacStatusError is a response from the legacy RBAC service other than 200.
+type rbacStatusError struct {
+	Status int
+	Body   string
+}
+
+func (e *rbacStatusError) Error() string {
+	return fmt.Sprintf("legacy RBAC service returned %d: %s", e.Status, e.Body)
+}
+
+// rbacAccess is an Access as the legacy RBAC service returns it. Values of "in" filters are lists in newer versions of
+// the service and comma-separated strings in older ones, and null stands for ungrouped inventory hosts, so values are
+// kept undecoded.
+type rbacAccess struct {
+	Permission          string `json:"permission"`
+	ResourceDefinitions []struct {
+		AttributeFilter struct {
+			Key       string          `json:"key"`
+			Operation string          `json:"operation"`
+			Value     json.RawMessage `json:"value"`
+		} `json:"attributeFilter"`
+	} `json:"resourceDefinitions"`
+}
+
+type rbacAccessPage struct {
+	Data  []rbacAccess `json:"data"`
+	Links struct {
+		Next *string `json:"next"`
+	} `json:"links"`
+}
+
+// GetPrincipalAccess reads the access of a principal from the legacy RBAC service, following its pages to the end.
+func (c *RbacClient) GetPrincipalAccess(ctx context.Context, params api.GetPrincipalAccessParams) ([]api.Access, error) {
+	query := url.Values{}
+	query.Set("application", params.Application)
+	if params.Username != nil {
+		query.Set("username", *params.Username)
+	}
+	query.Set("limit", fmt.Sprint(rbacPageSize))
+
+	next, err := url.Parse(strings.TrimSuffix(c.BaseURL, "/") + "/access/?" + query.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	accesses := make([]api.Access, 0)
+	for next != nil {
+		var page rbacAccessPage
+		if err := c.get(ctx, next, &page); err != nil {
+			return nil, err
+		}
+
+		for _, access := range page.Data {
+			accesses = append(accesses, access.toAccess())
+		}
+
+		if page.Links.Next == nil || *page.Links.Next == "" || len(page.Data) == 0 {
+			break
+		}
+
+		// Links are relative to the service's host, e.g. /api/rbac/v1/access/?...&offset=1000
+		link, err := url.Parse(*page.Links.Next)
+		if err != nil {
+			return nil, err
+		}
+		next = next.ResolveReference(link)
+	}
+
+	return accesses, nil
+}
+
+func (c *RbacClient) get(ctx context.Context, url *url.URL, into interface{}) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if identity, ok := identityFromContext(ctx); ok && identity.header != "" {
+		request.Header.Set(identityHeader, identity.header)
+	}
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return &rbacStatusError{Status: response.StatusCode, Body: string(body)}
+	}
+
+	return json.NewDecoder(response.Body).Decode(into)
+}
+
+// toAccess converts an access from the legacy RBAC service, joining list values of "in" filters with commas.
+func (a rbacAccess) toAccess() api.Access {
+	access := api.Access{Permission: a.Permission, ResourceDefinitions: []api.ResourceDefinition{}}
+
+	for _, definition := range a.ResourceDefinitions {
+		filter := definition.AttributeFilter
+
+		var value string
+		var values []*string
+		if err := json.Unmarshal(filter.Value, &values); err == nil {
+			parts := make([]string, len(values))
+			for i, v := range values {
+				parts[i] = rbacFilterValue(v)
+			}
+			value = strings.Join(parts, ",")
+		} else {
+			var single *string
+			_ = json.Unmarshal(filter.Value, &single)
+			value = rbacFilterValue(single)
+		}
+
+		access.ResourceDefinitions = append(access.ResourceDefinitions, api.ResourceDefinition{
+			AttributeFilter: api.ResourceDefinitionFilter{
+				Key:       filter.Key,
+				Operation: api.ResourceDefinitionFilterOperation(filter.Operation),
+				Value:     value,
+			},
+		})
+	}
+
+	return access
+}
+
+func rbacFilterValue(value *string) string {
+	if value == nil {
+		return "null"
+	}
+
+	return *value
+}
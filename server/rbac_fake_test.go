@@ -0,0 +1,124 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// fakeRbac is a stand-in for the legacy RBAC service's access endpoint. Responses are given as raw JSON data entries,
+// so tests can use forms this service never returns, such as list values of "in" filters.
+type fakeRbac struct {
+	mu       sync.Mutex
+	access   map[string][]json.RawMessage // by username
+	pageSize int                          // overrides the limit asked for, to exercise pagination
+	headers  []http.Header                // of every request received
+	hang     chan struct{}                // when set, requests are answered once it is closed, or given up on
+
+	server *httptest.Server
+}
+
+// newFakeRbac serves the fake at a local URL ending in /api/rbac/v1, as the legacy service is configured.
+func newFakeRbac(t *testing.T) *fakeRbac {
+	t.Helper()
+
+	f := &fakeRbac{access: make(map[string][]json.RawMessage)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/rbac/v1/access/", f.serveAccess)
+	f.server = httptest.NewServer(mux)
+	t.Cleanup(f.server.Close)
+
+	return f
+}
+
+func (f *fakeRbac) url() string {
+	return f.server.URL + "/api/rbac/v1"
+}
+
+func (f *fakeRbac) grant(username string, access ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, a := range access {
+		f.access[username] = append(f.access[username], json.RawMessage(a))
+	}
+}
+
+func (f *fakeRbac) serveAccess(w http.ResponseWriter, r *http.Request) {
+	if f.hang != nil {
+		select {
+		case <-f.hang:
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.headers = append(f.headers, r.Header.Clone())
+
+	query := r.URL.Query()
+	if query.Get("application") == "" {
+		http.Error(w, `{"errors": [{"status": "400", "detail": "application is required"}]}`, http.StatusBadRequest)
+		return
+	}
+
+	// As the legacy service does, answer for the caller unless another principal is asked about
+	username := query.Get("username")
+	if username == "" {
+		identity, err := parseIdentity(r.Header.Get(identityHeader))
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		username = identity.Username
+	}
+	data := f.access[username]
+
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	if limit == 0 {
+		limit = 10
+	}
+	if f.pageSize != 0 {
+		limit = f.pageSize
+	}
+	offset, _ := strconv.Atoi(query.Get("offset"))
+
+	page := data[min(offset, len(data)):min(offset+limit, len(data))]
+
+	var next *string
+	if offset+limit < len(data) {
+		query.Set("offset", strconv.Itoa(offset+limit))
+		link := r.URL.Path + "?" + query.Encode()
+		next = &link
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"meta":  map[string]int{"count": len(data), "limit": limit, "offset": offset},
+		"links": map[string]*string{"next": next},
+		"data":  page,
+	})
+}
+
+func rbacAccessJSON(permission string, filters ...string) string {
+	definitions := "[]"
+	if len(filters) != 0 {
+		definitions = "["
+		for i, filter := range filters {
+			if i > 0 {
+				definitions += ","
+			}
+			definitions += fmt.Sprintf(`{"attributeFilter": %s}`, filter)
+		}
+		definitions += "]"
+	}
+
+	return fmt.Sprintf(`{"permission": %q, "resourceDefinitions": %s}`, permission, definitions)
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+)
+
+// notImplemented is what the handlers this server does not implement yet panic with.
+const notImplemented = "implement me"
+
+// RecoveryMiddleware turns a handler's panic into an RBAC v1 error response, 501 for a handler not implemented yet and
+// 500 otherwise, so that one request failing does not take the connection down with it. A panic after the response
+// has started can only abort it.
+func RecoveryMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rw := &recoveryResponseWriter{ResponseWriter: w}
+
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+			if recovered == http.ErrAbortHandler {
+				panic(recovered)
+			}
+
+			if recovered == notImplemented {
+				fmt.Printf("[WARN] %s %s is not implemented\n", r.Method, r.URL.Path)
+			} else {
+				fmt.Printf("[ERROR] panic serving %s %s: %v\n%s", r.Method, r.URL.Path, recovered, debug.Stack())
+			}
+
+			if rw.wroteHeader {
+				panic(http.ErrAbortHandler)
+			}
+
+			status, detail := http.StatusInternalServerError, "internal server error"
+			if recovered == notImplemented {
+				status, detail = http.StatusNotImplemented, fmt.Sprintf("%s %s is not implemented", r.Method, r.URL.Path)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			json.NewEncoder(w).Encode(errorBody(status, detail))
+		}()
+
+		next.ServeHTTP(rw, r)
+	})
+}
+
+// recoveryResponseWriter records whether a response has started.
+type recoveryResponseWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+func (w *recoveryResponseWriter) WriteHeader(status int) {
+	w.wroteHeader = true
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *recoveryResponseWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *recoveryResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		w.wroteHeader = true
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+func TestRecoveryMiddleware(t *testing.T) {
+	r := chi.NewRouter()
+	r.Use(RecoveryMiddleware)
+	r.Get("/panic/", func(w http.ResponseWriter, r *http.Request) {
+		var services Services
+		services["app"] = Permission{} // assignment to a nil map
+	})
+	r.Get("/partial/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		panic("failed mid-response")
+	})
+	api.HandlerFromMux(api.NewStrictHandler(&PrbacSpicedbServer{Metadata: NewInMemoryMetadataStore()}, nil), r)
+
+	tests := []struct {
+		path   string
+		status int
+	}{
+		{"/cross-account-requests/", http.StatusNotImplemented},
+		{"/panic/", http.StatusInternalServerError},
+	}
+
+	for _, test := range tests {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, test.path, nil))
+
+		if rec.Code != test.status {
+			t.Errorf("%s: expected %d, got %d", test.path, test.status, rec.Code)
+		}
+
+		var body api.Error
+		if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || len(body.Errors) != 1 || body.Errors[0].Detail == nil {
+			t.Errorf("%s: expected an error document, got %q", test.path, rec.Body.String())
+		}
+	}
+
+	func() {
+		defer func() {
+			if recovered := recover(); recovered != http.ErrAbortHandler {
+				t.Errorf("expected a panic mid-response to abort it, got %v", recovered)
+			}
+		}()
+
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/partial/", nil))
+	}()
+}
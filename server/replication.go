@@ -0,0 +1,94 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DefaultReplicationRetryInterval is how long a Replicator waits before applying an event again after SpiceDB failed.
+const DefaultReplicationRetryInterval = 5 * time.Second
+
+// ChangeMessage is a message read from a ChangeStream.
+type ChangeMessage struct {
+	Topic     string
+	Partition int
+	Offset    int64
+	Key       []byte
+	Value     []byte
+}
+
+// ChangeStream is an ordered stream of change events, such as a Kafka topic read by a consumer group. Fetch returns
+// the next message, and Commit marks it, and every message before it in its partition, as done with, so that it is
+// not fetched again once the stream is reopened.
+type ChangeStream interface {
+	Fetch(ctx context.Context) (ChangeMessage, error)
+	Commit(ctx context.Context, message ChangeMessage) error
+}
+
+// Replicator keeps SpiceDB in step with the legacy RBAC service by applying the change events it publishes.
+//
+// Messages are applied one at a time, in the order the stream hands them over, which for Kafka is the order of each
+// partition; events are keyed by organization, so an organization's events are applied in the order they happened.
+// A message is only committed once its relationships are written, and a failed write is retried rather than skipped,
+// so that no later event of the organization is applied before it. After a restart, messages applied but not yet
+// committed are delivered again, which ApplyChangeEvent makes harmless.
+type Replicator struct {
+	Server        *PrbacSpicedbServer
+	Stream        ChangeStream
+	RetryInterval time.Duration
+}
+
+// Run applies messages until ctx is done, which is not reported as an error, or the stream fails.
+func (r *Replicator) Run(ctx context.Context) error {
+	for {
+		message, err := r.Stream.Fetch(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("fetching change event: %w", err)
+		}
+
+		if err := r.apply(ctx, message); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		if err := r.Stream.Commit(ctx, message); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("committing change event at %s/%d offset %d: %w", message.Topic, message.Partition, message.Offset, err)
+		}
+	}
+}
+
+// apply applies a message, retrying until it is applied or ctx is done. Messages that can never be applied are
+// logged and passed over, as retrying them would hold up every event behind them.
+func (r *Replicator) apply(ctx context.Context, message ChangeMessage) error {
+	retryInterval := r.RetryInterval
+	if retryInterval <= 0 {
+		retryInterval = DefaultReplicationRetryInterval
+	}
+
+	for {
+		err := r.Server.ApplyChangeEvent(ctx, message.Value)
+		if err == nil {
+			return nil
+		}
+
+		if isInvalidChangeEvent(err) {
+			fmt.Printf("[ERROR] Skipping change event at %s/%d offset %d: %v\n", message.Topic, message.Partition, message.Offset, err)
+			return nil
+		}
+
+		fmt.Printf("[WARN] Applying change event at %s/%d offset %d failed, retrying in %s: %v\n", message.Topic, message.Partition, message.Offset, retryInterval, err)
+
+		if !sleep(ctx, retryInterval) {
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,276 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// outboxMessage wraps an event as Debezium's outbox event router publishes it through the JSON converter, in a schema
+// envelope and with the payload column as a string.
+func outboxMessage(t *testing.T, aggregateType, aggregateId, eventType, payload string) string {
+	t.Helper()
+
+	// Debezium hands on JSON columns as strings
+	column, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encoded, err := json.Marshal(map[string]interface{}{
+		"schema": map[string]string{"type": "struct"},
+		"payload": ChangeEvent{
+			Id:            uuid.NewString(),
+			AggregateType: aggregateType,
+			AggregateId:   aggregateId,
+			Type:          eventType,
+			OrgId:         "acme",
+			Payload:       column,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(encoded)
+}
+
+// testChangeEvents creates a role and a group, adds members to the group and binds it to the role, then changes all
+// three: the role gains access filtered by workspace, the group gains a system role, and loses a member.
+func testChangeEvents(t *testing.T) []string {
+	return []string{
+		outboxMessage(t, "role", importedRole, "created", `{"uuid": "`+importedRole+`", "name": "Host operators", "access": [
+			{"permission": "inventory:hosts:read", "resourceDefinitions": []}
+		]}`),
+		outboxMessage(t, "group", importedGroup, "created", `{"uuid": "`+importedGroup+`", "name": "Operators"}`),
+		outboxMessage(t, "group", importedGroup, "principals_added", `{"principals": [{"username": "alice"}, {"username": "bob"}]}`),
+		outboxMessage(t, "policy", "5b2e8d1f-9c3a-4f6e-b7d4-2a1c0e9f8d44", "created", `{"name": "Operators policy", "group": "`+importedGroup+`", "roles": ["`+importedRole+`"]}`),
+		outboxMessage(t, "role", importedRole, "updated", `{"uuid": "`+importedRole+`", "name": "Host operators", "description": "Now writes hosts in ws1", "access": [
+			{"permission": "inventory:hosts:read", "resourceDefinitions": []},
+			{"permission": "inventory:hosts:write", "resourceDefinitions": [{"attributeFilter": {"key": "group.id", "operation": "equal", "value": "ws1"}}]}
+		]}`),
+		outboxMessage(t, "policy", "5b2e8d1f-9c3a-4f6e-b7d4-2a1c0e9f8d44", "updated", `{"name": "Operators policy", "group": "`+importedGroup+`", "roles": ["`+importedRole+`", "`+permissionsToSystemRoles["inventory:groups:write"]+`"]}`),
+		outboxMessage(t, "group", importedGroup, "principals_removed", `{"principals": [{"username": "bob"}]}`),
+	}
+}
+
+// replicatedWorkspace is the workspace of acme the role in testChangeEvents gains access to.
+var replicatedWorkspace = []string{"workspace:ws1#parent@workspace:acme_root", "workspace:ws1#tenant@organization:acme"}
+
+// runReplicator replicates from the stream until every message is fetched, or for at most timeout.
+func runReplicator(t *testing.T, p *PrbacSpicedbServer, stream *fakeChangeStream, timeout time.Duration) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	stream.drained = cancel
+
+	replicator := &Replicator{Server: p, Stream: stream, RetryInterval: time.Millisecond}
+	if err := replicator.Run(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReplicator(t *testing.T) {
+	p, spicedb := newTestServer(t, replicatedWorkspace...)
+	stream := newFakeChangeStream(testChangeEvents(t)...)
+
+	runReplicator(t, p, stream, 5*time.Second)
+
+	if !slices.Equal(stream.commits, []int64{0, 1, 2, 3, 4, 5, 6}) {
+		t.Errorf("unexpected commits %v", stream.commits)
+	}
+
+	groupsWrite := permissionsToSystemRoles["inventory:groups:write"]
+	tuples := spicedb.tuples()
+	for _, expected := range []string{
+		"role:" + importedRole + "#inventory_hosts_read@user:*",
+		"role_binding:" + importedRole + "#subject@group:" + importedGroup + "#member",
+		// Added by the role's update, and bound to the group bound to the role before it
+		"role_binding:" + importedRole + "_ws1#subject@group:" + importedGroup + "#member",
+		"workspace:acme_root#user_grant@role_binding:" + getRootBindingId(importedGroup, groupsWrite),
+		"group:" + importedGroup + "#member@user:alice",
+	} {
+		if !slices.Contains(tuples, expected) {
+			t.Errorf("missing relationship %s", expected)
+		}
+	}
+	if slices.Contains(tuples, "group:"+importedGroup+"#member@user:bob") {
+		t.Errorf("kept a removed member")
+	}
+
+	if metadata, _, _ := p.Metadata.Get("role", importedRole); metadata.Name != "Host operators" || metadata.Description != "Now writes hosts in ws1" {
+		t.Errorf("unexpected role metadata: %+v", metadata)
+	}
+	if metadata, _, _ := p.Metadata.Get("group", importedGroup); metadata.Name != "Operators" {
+		t.Errorf("unexpected group metadata: %+v", metadata)
+	}
+}
+
+func TestReplicatorRevokesAndDeletes(t *testing.T) {
+	p, spicedb := newTestServer(t, replicatedWorkspace...)
+	stream := newFakeChangeStream(testChangeEvents(t)...)
+	stream.publish(
+		outboxMessage(t, "role", importedRole, "updated", `{"uuid": "`+importedRole+`", "name": "Host operators", "access": [
+			{"permission": "inventory:hosts:write", "resourceDefinitions": []}
+		]}`),
+	)
+
+	runReplicator(t, p, stream, 5*time.Second)
+
+	tuples := spicedb.tuples()
+	if !slices.Contains(tuples, "role:"+importedRole+"#inventory_hosts_write@user:*") {
+		t.Errorf("missing the permission the role was updated with")
+	}
+	for _, tuple := range tuples {
+		if strings.Contains(tuple, "inventory_hosts_read") || strings.Contains(tuple, importedRole+"_ws1") {
+			t.Errorf("kept %s after the role no longer grants it", tuple)
+		}
+	}
+
+	stream.publish(
+		outboxMessage(t, "role", importedRole, "deleted", `{}`),
+		outboxMessage(t, "group", importedGroup, "deleted", `{}`),
+	)
+	stream.reopen()
+	runReplicator(t, p, stream, 5*time.Second)
+
+	for _, tuple := range spicedb.tuples() {
+		if strings.Contains(tuple, importedRole) || strings.Contains(tuple, importedGroup) {
+			t.Errorf("kept %s after the role and group were deleted", tuple)
+		}
+	}
+	if _, ok, _ := p.Metadata.Get("role", importedRole); ok {
+		t.Errorf("kept metadata of the deleted role")
+	}
+}
+
+func TestReplicatorCommitsOnlyAfterWriting(t *testing.T) {
+	p, spicedb := newTestServer(t, replicatedWorkspace...)
+	stream := newFakeChangeStream(testChangeEvents(t)...)
+
+	// SpiceDB is down for longer than the replicator runs
+	spicedb.failWrites = 1 << 30
+	runReplicator(t, p, stream, 50*time.Millisecond)
+
+	if len(stream.commits) != 0 || len(spicedb.tuples()) != len(replicatedWorkspace) {
+		t.Fatalf("committed %v without writing", stream.commits)
+	}
+
+	// Then recovers, after some more failures, and the consumer restarts from the last commit
+	spicedb.failWrites = 3
+	stream.reopen()
+	runReplicator(t, p, stream, 5*time.Second)
+
+	if !slices.Equal(stream.commits, []int64{0, 1, 2, 3, 4, 5, 6}) {
+		t.Errorf("unexpected commits %v", stream.commits)
+	}
+	if !slices.Contains(spicedb.tuples(), "group:"+importedGroup+"#member@user:alice") {
+		t.Errorf("events were not applied once SpiceDB recovered")
+	}
+}
+
+func TestReplicatorRedeliveryIsIdempotent(t *testing.T) {
+	p, spicedb := newTestServer(t, replicatedWorkspace...)
+	stream := newFakeChangeStream(testChangeEvents(t)...)
+
+	runReplicator(t, p, stream, 5*time.Second)
+	expected := spicedb.tuples()
+	slices.Sort(expected)
+
+	// The commits are lost, so everything is delivered again
+	stream.committed = -1
+	stream.reopen()
+	runReplicator(t, p, stream, 5*time.Second)
+
+	actual := spicedb.tuples()
+	slices.Sort(actual)
+	if !slices.Equal(expected, actual) {
+		t.Errorf("relationships differ after redelivery:\nexpected %v\ngot      %v", expected, actual)
+	}
+}
+
+func TestReplicatorSkipsInvalidEvents(t *testing.T) {
+	p, spicedb := newTestServer(t)
+	stream := newFakeChangeStream(
+		"not json",
+		outboxMessage(t, "workspace", "ws1", "created", `{}`),
+		// acme has no workspace ws1
+		outboxMessage(t, "role", importedRole, "created", `{"uuid": "`+importedRole+`", "name": "Host operators", "access": [
+			{"permission": "inventory:hosts:write", "resourceDefinitions": [{"attributeFilter": {"key": "group.id", "operation": "equal", "value": "ws1"}}]}
+		]}`),
+		outboxMessage(t, "group", importedGroup, "principals_added", `{"principals": [{"username": "alice"}]}`),
+	)
+
+	runReplicator(t, p, stream, 5*time.Second)
+
+	if !slices.Equal(stream.commits, []int64{0, 1, 2, 3}) {
+		t.Errorf("unexpected commits %v", stream.commits)
+	}
+	if tuples := spicedb.tuples(); !slices.Equal(tuples, []string{"group:" + importedGroup + "#member@user:alice"}) {
+		t.Errorf("unexpected relationships %v", tuples)
+	}
+}
+
+func TestReplicatorSkipsEventsForAnotherOrganization(t *testing.T) {
+	owners := []string{
+		"rbac/v1role:" + importedRole + "#tenant@organization:globex",
+		"group:" + importedGroup + "#tenant@organization:globex",
+	}
+	p, spicedb := newTestServer(t, append(owners, replicatedWorkspace...)...)
+	stream := newFakeChangeStream(testChangeEvents(t)...)
+
+	runReplicator(t, p, stream, 5*time.Second)
+
+	if !slices.Equal(stream.commits, []int64{0, 1, 2, 3, 4, 5, 6}) {
+		t.Errorf("unexpected commits %v", stream.commits)
+	}
+	if tuples := spicedb.tuples(); len(tuples) != len(owners)+len(replicatedWorkspace) {
+		t.Errorf("acme's events changed globex's role and group: %v", tuples)
+	}
+}
+
+func TestDecodeChangeEvent(t *testing.T) {
+	event := `{"id": "e1", "aggregatetype": "group", "aggregateid": "g1", "type": "deleted", "org_id": "acme", "payload": {}}`
+
+	for name, test := range map[string]struct {
+		message string
+		ok      bool
+	}{
+		"outbox row":          {event, true},
+		"schema envelope":     {`{"schema": {}, "payload": ` + event + `}`, true},
+		"string payload":      {`{"schema": {}, "payload": ` + quoteJSON(t, event) + `}`, true},
+		"capture of insert":   {`{"payload": {"op": "c", "before": null, "after": ` + event + `, "source": {"table": "outbox"}}}`, true},
+		"capture of deletion": {`{"payload": {"op": "d", "before": ` + event + `, "after": null, "source": {"table": "outbox"}}}`, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			decoded, ok, err := decodeChangeEvent([]byte(test.message))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok != test.ok || (ok && (decoded.AggregateId != "g1" || decoded.OrgId != "acme")) {
+				t.Errorf("unexpected event %+v, %t", decoded, ok)
+			}
+		})
+	}
+
+	if _, _, err := decodeChangeEvent([]byte(`{"aggregatetype": "group", "type": "deleted"}`)); err == nil {
+		t.Errorf("expected an error for an event without an org")
+	}
+}
+
+func quoteJSON(t *testing.T, value string) string {
+	t.Helper()
+
+	quoted, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(quoted)
+}
@@ -0,0 +1,243 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/merlante/prbac-spicedb/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (p *PrbacSpicedbServer) CreateResource(ctx context.Context, request api.CreateResourceRequestObject) (api.CreateResourceResponseObject, error) {
+	resource := *request.Body
+
+	definitions, err := readSchemaDefinitions(ctx, p.SpicedbClient)
+	if err != nil {
+		return api.CreateResource500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	if invalid, err := p.validateResource(ctx, definitions, resource); err != nil {
+		return api.CreateResource500JSONResponse(errorBody(500, err.Error())), nil
+	} else if invalid != "" {
+		return api.CreateResource400JSONResponse(errorBody(400, invalid)), nil
+	}
+
+	_, err = p.writeRelationships(ctx, auditEntry(ctx, "createResource", AuditTarget{Type: resource.Type, Id: resource.Id}), []*v1.RelationshipUpdate{
+		createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_CREATE, resource.Type, resource.Id, "workspace", "workspace", resource.Workspace),
+	}, &v1.Precondition{
+		Operation: v1.Precondition_OPERATION_MUST_NOT_MATCH,
+		Filter:    &v1.RelationshipFilter{ResourceType: resource.Type, OptionalResourceId: resource.Id, OptionalRelation: "workspace"},
+	})
+	if status.Code(err) == codes.FailedPrecondition {
+		// Registered already, which is only told to the organization it is registered in
+		if _, found, err := p.getResource(ctx, resource.Type, resource.Id); err != nil {
+			return api.CreateResource500JSONResponse(errorBody(500, err.Error())), nil
+		} else if found {
+			return api.CreateResource409JSONResponse(errorBody(409, "resource already registered: "+resource.Type+":"+resource.Id)), nil
+		}
+		return api.CreateResource400JSONResponse(errorBody(400, "resource id not available: "+resource.Type+":"+resource.Id)), nil
+	}
+	if err != nil {
+		return api.CreateResource500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	return api.CreateResource201JSONResponse(resource), nil
+}
+
+func (p *PrbacSpicedbServer) UpsertResources(ctx context.Context, request api.UpsertResourcesRequestObject) (api.UpsertResourcesResponseObject, error) {
+	definitions, err := readSchemaDefinitions(ctx, p.SpicedbClient)
+	if err != nil {
+		return api.UpsertResources500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	// Validate everything up front, so a bad entry leaves every resource where it was
+	updates := make([]*v1.RelationshipUpdate, 0)
+	seen := make(map[string]bool)
+	for i, resource := range request.Body.Data {
+		key := resource.Type + ":" + resource.Id
+		if seen[key] {
+			return api.UpsertResources400JSONResponse(errorBody(400, fmt.Sprintf("data[%d]: duplicate resource %s", i, key))), nil
+		}
+		seen[key] = true
+
+		if invalid, err := p.validateResource(ctx, definitions, resource); err != nil {
+			return api.UpsertResources500JSONResponse(errorBody(500, err.Error())), nil
+		} else if invalid != "" {
+			return api.UpsertResources400JSONResponse(errorBody(400, fmt.Sprintf("data[%d]: %s", i, invalid))), nil
+		}
+
+		existing, err := p.getResourceWorkspaces(ctx, resource.Type, resource.Id)
+		if err != nil {
+			return api.UpsertResources500JSONResponse(errorBody(500, err.Error())), nil
+		}
+
+		for _, workspace := range existing {
+			if workspace == resource.Workspace {
+				continue
+			}
+			if _, found, err := p.getWorkspace(ctx, workspace); err != nil {
+				return api.UpsertResources500JSONResponse(errorBody(500, err.Error())), nil
+			} else if !found {
+				return api.UpsertResources400JSONResponse(errorBody(400, fmt.Sprintf("data[%d]: resource id not available: %s", i, key))), nil
+			}
+
+			updates = append(updates, createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_DELETE, resource.Type, resource.Id, "workspace", "workspace", workspace))
+		}
+		updates = append(updates, createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, resource.Type, resource.Id, "workspace", "workspace", resource.Workspace))
+	}
+
+	targets := make([]AuditTarget, len(request.Body.Data))
+	for i, resource := range request.Body.Data {
+		targets[i] = AuditTarget{Type: resource.Type, Id: resource.Id}
+	}
+
+	//TODO: some sort of concurrency check is required here: this write is dependent upon results read above
+	_, err = p.writeRelationships(ctx, auditEntry(ctx, "upsertResources", targets...), updates)
+	if err != nil {
+		return api.UpsertResources500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	return api.UpsertResources200JSONResponse{Data: request.Body.Data}, nil
+}
+
+func (p *PrbacSpicedbServer) GetResource(ctx context.Context, request api.GetResourceRequestObject) (api.GetResourceResponseObject, error) {
+	resourceType := request.Namespace + "/" + request.Name
+
+	workspace, found, err := p.getResource(ctx, resourceType, request.Id)
+	if err != nil {
+		return api.GetResource500JSONResponse(errorBody(500, err.Error())), nil
+	}
+	if !found {
+		return api.GetResource404JSONResponse(errorBody(404, "resource not found: "+resourceType+":"+request.Id)), nil
+	}
+
+	return api.GetResource200JSONResponse{Type: resourceType, Id: request.Id, Workspace: workspace}, nil
+}
+
+func (p *PrbacSpicedbServer) MoveResource(ctx context.Context, request api.MoveResourceRequestObject) (api.MoveResourceResponseObject, error) {
+	resourceType := request.Namespace + "/" + request.Name
+
+	workspace, found, err := p.getResource(ctx, resourceType, request.Id)
+	if err != nil {
+		return api.MoveResource500JSONResponse(errorBody(500, err.Error())), nil
+	}
+	if !found {
+		return api.MoveResource404JSONResponse(errorBody(404, "resource not found: "+resourceType+":"+request.Id)), nil
+	}
+
+	resource := api.Resource{Type: resourceType, Id: request.Id, Workspace: request.Body.Workspace}
+	if resource.Workspace == workspace {
+		return api.MoveResource200JSONResponse(resource), nil
+	}
+
+	if _, found, err := p.getWorkspace(ctx, resource.Workspace); err != nil {
+		return api.MoveResource500JSONResponse(errorBody(500, err.Error())), nil
+	} else if !found {
+		return api.MoveResource400JSONResponse(errorBody(400, "workspace not found: "+resource.Workspace)), nil
+	}
+
+	_, err = p.writeRelationships(ctx, auditEntry(ctx, "moveResource", AuditTarget{Type: resourceType, Id: request.Id}), []*v1.RelationshipUpdate{
+		createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_DELETE, resourceType, request.Id, "workspace", "workspace", workspace),
+		createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, resourceType, request.Id, "workspace", "workspace", resource.Workspace),
+	})
+	if err != nil {
+		return api.MoveResource500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	return api.MoveResource200JSONResponse(resource), nil
+}
+
+func (p *PrbacSpicedbServer) DeleteResource(ctx context.Context, request api.DeleteResourceRequestObject) (api.DeleteResourceResponseObject, error) {
+	resourceType := request.Namespace + "/" + request.Name
+
+	workspace, found, err := p.getResource(ctx, resourceType, request.Id)
+	if err != nil {
+		return api.DeleteResource500JSONResponse(errorBody(500, err.Error())), nil
+	}
+	if !found {
+		return api.DeleteResource404JSONResponse(errorBody(404, "resource not found: "+resourceType+":"+request.Id)), nil
+	}
+
+	_, err = p.writeRelationships(ctx, auditEntry(ctx, "deleteResource", AuditTarget{Type: resourceType, Id: request.Id}), []*v1.RelationshipUpdate{
+		createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_DELETE, resourceType, request.Id, "workspace", "workspace", workspace),
+	})
+	if err != nil {
+		return api.DeleteResource500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	return api.DeleteResource204Response{}, nil
+}
+
+// validateResource checks that a resource's type is defined in the schema as workspace-scoped and that its workspace
+// belongs to the caller's organization. It returns a description of the problem, or an empty string if there is none.
+func (p *PrbacSpicedbServer) validateResource(ctx context.Context, definitions map[string]schemaDefinition, resource api.ResourceIn) (string, error) {
+	if resource.Id == "" {
+		return "resource id is required", nil
+	}
+
+	if !slices.Contains(workspaceScopedTypes(definitions), resource.Type) {
+		return "unknown resource type: " + resource.Type, nil
+	}
+
+	if _, found, err := p.getWorkspace(ctx, resource.Workspace); err != nil {
+		return "", err
+	} else if !found {
+		return "workspace not found: " + resource.Workspace, nil
+	}
+
+	return "", nil
+}
+
+// getResource returns the workspace a resource is registered in, only finding it if that workspace is part of the
+// caller's organization. Resources of types unknown to the schema are never found.
+func (p *PrbacSpicedbServer) getResource(ctx context.Context, resourceType, resourceId string) (string, bool, error) {
+	definitions, err := readSchemaDefinitions(ctx, p.SpicedbClient)
+	if err != nil || !slices.Contains(workspaceScopedTypes(definitions), resourceType) {
+		return "", false, err
+	}
+
+	workspaces, err := p.getResourceWorkspaces(ctx, resourceType, resourceId)
+	if err != nil || len(workspaces) == 0 {
+		return "", false, err
+	}
+
+	if _, found, err := p.getWorkspace(ctx, workspaces[0]); err != nil || !found {
+		return "", false, err
+	}
+
+	return workspaces[0], true, nil
+}
+
+// getResourceWorkspaces lists the workspaces a resource is registered in, regardless of organization. Resources
+// registered through this API are only ever in one workspace.
+func (p *PrbacSpicedbServer) getResourceWorkspaces(ctx context.Context, resourceType, resourceId string) ([]string, error) {
+	relationships, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+		ResourceType:       resourceType,
+		OptionalResourceId: resourceId,
+		OptionalRelation:   "workspace",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces := make([]string, len(relationships))
+	for i, relationship := range relationships {
+		workspaces[i] = relationship.GetSubject().GetObject().GetObjectId()
+	}
+
+	return workspaces, nil
+}
+
+// isInUserOrg reports whether a resource, which may itself be a workspace, belongs to the caller's organization.
+func (p *PrbacSpicedbServer) isInUserOrg(ctx context.Context, resourceType, resourceId string) (bool, error) {
+	if resourceType == "workspace" {
+		_, found, err := p.getWorkspace(ctx, resourceId)
+		return found, err
+	}
+
+	_, found, err := p.getResource(ctx, resourceType, resourceId)
+	return found, err
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+func TestCreateResource(t *testing.T) {
+	p, spicedb := newWorkspaceTestServer(t, "workspace:ws1#parent@workspace:aspian_root")
+
+	resp, err := p.CreateResource(context.Background(), api.CreateResourceRequestObject{Body: &api.ResourceIn{Type: "inventory/hosts", Id: "h1", Workspace: "ws1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := resp.(api.CreateResource201JSONResponse); !ok {
+		t.Fatalf("expected 201, got %+v", resp)
+	}
+	if !slices.Contains(spicedb.tuples(), "inventory/hosts:h1#workspace@workspace:ws1") {
+		t.Errorf("resource not registered: %v", spicedb.tuples())
+	}
+
+	resp, _ = p.CreateResource(context.Background(), api.CreateResourceRequestObject{Body: &api.ResourceIn{Type: "inventory/hosts", Id: "h1", Workspace: "aspian_root"}})
+	if _, ok := resp.(api.CreateResource409JSONResponse); !ok {
+		t.Errorf("expected 409 registering twice, got %+v", resp)
+	}
+}
+
+func TestCreateResourceIsValidatedAgainstSchema(t *testing.T) {
+	p, _ := newWorkspaceTestServer(t, "workspace:other_root#parent@organization:other")
+
+	for _, resource := range []api.ResourceIn{
+		{Type: "inventory/unknown", Id: "h1", Workspace: "aspian_root"},
+		{Type: "workspace", Id: "ws2", Workspace: "aspian_root"},
+		{Type: "inventory/hosts", Id: "h1", Workspace: "other_root"},
+		{Type: "inventory/hosts", Id: "", Workspace: "aspian_root"},
+	} {
+		resp, _ := p.CreateResource(context.Background(), api.CreateResourceRequestObject{Body: &resource})
+		if _, ok := resp.(api.CreateResource400JSONResponse); !ok {
+			t.Errorf("expected 400 for %+v, got %+v", resource, resp)
+		}
+	}
+}
+
+func TestMoveResource(t *testing.T) {
+	p, spicedb := newWorkspaceTestServer(t, "workspace:ws1#parent@workspace:aspian_root", "inventory/hosts:h1#workspace@workspace:aspian_root")
+
+	resp, err := p.MoveResource(context.Background(), api.MoveResourceRequestObject{Namespace: "inventory", Name: "hosts", Id: "h1", Body: &api.ResourceMove{Workspace: "ws1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if moved := resp.(api.MoveResource200JSONResponse); moved.Workspace != "ws1" {
+		t.Errorf("unexpected resource: %+v", moved)
+	}
+
+	tuples := spicedb.tuples()
+	if !slices.Contains(tuples, "inventory/hosts:h1#workspace@workspace:ws1") || slices.Contains(tuples, "inventory/hosts:h1#workspace@workspace:aspian_root") {
+		t.Errorf("resource not moved: %v", tuples)
+	}
+}
+
+func TestResourcesInOtherOrganizationsAreNotFound(t *testing.T) {
+	p, _ := newWorkspaceTestServer(t, "workspace:other_root#parent@organization:other", "inventory/hosts:h1#workspace@workspace:other_root")
+
+	resp, _ := p.GetResource(context.Background(), api.GetResourceRequestObject{Namespace: "inventory", Name: "hosts", Id: "h1"})
+	if _, ok := resp.(api.GetResource404JSONResponse); !ok {
+		t.Errorf("expected 404, got %+v", resp)
+	}
+
+	deleted, _ := p.DeleteResource(context.Background(), api.DeleteResourceRequestObject{Namespace: "inventory", Name: "hosts", Id: "h1"})
+	if _, ok := deleted.(api.DeleteResource404JSONResponse); !ok {
+		t.Errorf("expected 404, got %+v", deleted)
+	}
+
+	// Registering it again must not tell that another organization has it
+	created, _ := p.CreateResource(context.Background(), api.CreateResourceRequestObject{Body: &api.ResourceIn{Type: "inventory/hosts", Id: "h1", Workspace: "aspian_root"}})
+	if _, ok := created.(api.CreateResource400JSONResponse); !ok {
+		t.Errorf("expected 400, got %+v", created)
+	}
+}
+
+func TestUpsertResources(t *testing.T) {
+	p, spicedb := newWorkspaceTestServer(t, "workspace:ws1#parent@workspace:aspian_root", "inventory/hosts:h1#workspace@workspace:aspian_root")
+
+	resp, err := p.UpsertResources(context.Background(), api.UpsertResourcesRequestObject{Body: &api.ResourceBulkIn{Data: []api.ResourceIn{
+		{Type: "inventory/hosts", Id: "h1", Workspace: "ws1"},
+		{Type: "inventory/hosts", Id: "h2", Workspace: "ws1"},
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := resp.(api.UpsertResources200JSONResponse); !ok {
+		t.Fatalf("expected 200, got %+v", resp)
+	}
+
+	tuples := spicedb.tuples()
+	for _, tuple := range []string{"inventory/hosts:h1#workspace@workspace:ws1", "inventory/hosts:h2#workspace@workspace:ws1"} {
+		if !slices.Contains(tuples, tuple) {
+			t.Errorf("missing relationship %s", tuple)
+		}
+	}
+	if slices.Contains(tuples, "inventory/hosts:h1#workspace@workspace:aspian_root") {
+		t.Errorf("h1 was not moved: %v", tuples)
+	}
+}
+
+func TestUpsertResourcesWritesNothingIfAnyIsInvalid(t *testing.T) {
+	p, spicedb := newWorkspaceTestServer(t)
+	before := spicedb.tuples()
+
+	resp, _ := p.UpsertResources(context.Background(), api.UpsertResourcesRequestObject{Body: &api.ResourceBulkIn{Data: []api.ResourceIn{
+		{Type: "inventory/hosts", Id: "h1", Workspace: "aspian_root"},
+		{Type: "inventory/hosts", Id: "h2", Workspace: "missing"},
+	}}})
+	if _, ok := resp.(api.UpsertResources400JSONResponse); !ok {
+		t.Fatalf("expected 400, got %+v", resp)
+	}
+
+	if !slices.Equal(before, spicedb.tuples()) {
+		t.Errorf("relationships changed: %v", spicedb.tuples())
+	}
+}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/authzed/authzed-go/v1"
+)
+
+// schemaDefinition is the subset of a SpiceDB definition this server needs to reason about.
+type schemaDefinition struct {
+	Relations   map[string]string // relation name -> allowed subject types, e.g. "workspace" -> "workspace"
+	Permissions map[string]string // permission name -> expression, e.g. "read" -> "workspace->inventory_hosts_read"
+}
+
+var (
+	definitionPattern = regexp.MustCompile(`^definition\s+(\S+)\s*\{`)
+	relationPattern   = regexp.MustCompile(`^relation\s+(\w+)\s*:\s*(.+)$`)
+	permissionPattern = regexp.MustCompile(`^permission\s+(\w+)\s*=\s*(.*)$`)
+	arrowPattern      = regexp.MustCompile(`(\w+)->(\w+)`)
+)
+
+// readSchemaDefinitions reads the schema currently loaded into SpiceDB.
+func readSchemaDefinitions(ctx context.Context, client *authzed.Client) (map[string]schemaDefinition, error) {
+	resp, err := client.ReadSchema(ctx, &v1.ReadSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return parseSchemaDefinitions(resp.GetSchemaText()), nil
+}
+
+// parseSchemaDefinitions does a line-based parse of a schema. It is not a full schema parser, but the
+// generated schema keeps one relation or permission per line, which is all we rely on.
+func parseSchemaDefinitions(schema string) map[string]schemaDefinition {
+	definitions := make(map[string]schemaDefinition)
+
+	var current *schemaDefinition
+	scanner := bufio.NewScanner(strings.NewReader(schema))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if comment := strings.Index(line, "//"); comment != -1 {
+			line = line[:comment]
+		}
+		line = strings.TrimSpace(line)
+
+		if match := definitionPattern.FindStringSubmatch(line); match != nil {
+			definition := schemaDefinition{Relations: make(map[string]string), Permissions: make(map[string]string)}
+			definitions[match[1]] = definition
+			current = &definition
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		if match := relationPattern.FindStringSubmatch(line); match != nil {
+			current.Relations[match[1]] = strings.TrimSpace(match[2])
+		} else if match := permissionPattern.FindStringSubmatch(line); match != nil {
+			current.Permissions[match[1]] = strings.TrimSpace(match[2])
+		}
+	}
+
+	return definitions
+}
+
+// workspaceScopedTypes lists the definitions that can be placed in a workspace, i.e. have `relation workspace: workspace`.
+func workspaceScopedTypes(definitions map[string]schemaDefinition) []string {
+	var types []string
+	for name, definition := range definitions {
+		if definition.Relations["workspace"] == "workspace" {
+			types = append(types, name)
+		}
+	}
+
+	sort.Strings(types)
+
+	return types
+}
+
+// workspaceRelations lists the workspace permissions a permission on a type is computed from, which are also the names
+// of the role relations that grant it. On workspaces, that is the permission itself; on workspace-scoped types, it
+// is every workspace->... arrow in the permission's expression.
+func workspaceRelations(definitions map[string]schemaDefinition, resourceType, permission string) []string {
+	if resourceType == "workspace" {
+		return []string{permission}
+	}
+
+	var relations []string
+	for _, arrow := range arrowPattern.FindAllStringSubmatch(definitions[resourceType].Permissions[permission], -1) {
+		if arrow[1] == "workspace" {
+			relations = append(relations, arrow[2])
+		}
+	}
+
+	return relations
+}
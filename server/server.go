@@ -2,10 +2,12 @@ package server
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"io"
+	"runtime/debug"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
 	"github.com/authzed/authzed-go/v1"
@@ -20,9 +22,13 @@ type Filter struct {
 	Verb         string `json:"verb"`
 }
 
+// ResourcePerm maps an RBAC v1 permission to the schema: to a permission on the root workspace, and to the attribute
+// filters under which it may be granted on some resources only. A permission with more than one filter, e.g. on
+// services and on inventory groups, lists them under filters.
 type ResourcePerm struct {
-	Permission string `json:"permission"`
-	Filter     Filter `json:"filter"`
+	Permission string   `json:"permission"`
+	Filter     Filter   `json:"filter"`
+	Filters    []Filter `json:"filters,omitempty"`
 }
 
 type Permission map[string]ResourcePerm
@@ -30,8 +36,20 @@ type Permission map[string]ResourcePerm
 type Services map[string]Permission
 
 type PrbacSpicedbServer struct {
-	RbacServices  Services
+	RbacServices  Services // the initial services mapping; read through services(), replace with SetRbacServices
+	BasePath      string   // the API is served under, e.g. DefaultBasePath, which links to other pages start with
 	SpicedbClient *authzed.Client
+	Metadata      MetadataStore
+	Webhooks      *WebhookNotifier // nil if tenants cannot register webhooks
+	Audit         AuditLog         // nil if writes are not recorded
+	Consistency   ConsistencyMode  // of checks and lookups, ConsistencyMinimizeLatency if empty
+
+	servicesMu      sync.RWMutex
+	servicesVersion string
+	servicesLoaded  time.Time
+
+	writtenMu sync.Mutex
+	written   *v1.ZedToken // of the last write, for ConsistencyAtLeastAsFresh
 }
 
 var permissionsToSystemRoles = map[string]string{
@@ -44,34 +62,32 @@ var permissionsToSystemRoles = map[string]string{
 func (p *PrbacSpicedbServer) GetPrincipalAccess(ctx context.Context, request api.GetPrincipalAccessRequestObject) (api.GetPrincipalAccessResponseObject, error) {
 	resp := api.GetPrincipalAccess200JSONResponse{}
 
-	// TODO: the following would ordinarily be extracted from the request headers
-	userOrg := "aspian"
-	rootWorkspace := userOrg + "_root"
+	rootWorkspace := getRootWorkspace(getUserOrg(ctx))
+
+	username := getPrincipalUsername(ctx, request.Params.Username)
+	if username == "" {
+		return api.GetPrincipalAccess401Response{}, nil
+	}
+
+	subjects := getPrincipalSubjects(ctx, username)
 
-	servicePermissions := p.RbacServices[request.Params.Application]
+	servicePermissions := p.services()[request.Params.Application]
 	for key := range servicePermissions {
 		servicePermission := servicePermissions[key]
 
 		// Step 1: If this user has checkpermission on root workspace, they get permission with no attribute filters
 
-		r, err := p.SpicedbClient.CheckPermission(ctx, &v1.CheckPermissionRequest{
-			Resource: &v1.ObjectReference{
-				ObjectType: "workspace",
-				ObjectId:   rootWorkspace,
-			},
-			Permission: servicePermission.Permission,
-			Subject: &v1.SubjectReference{Object: &v1.ObjectReference{
-				ObjectType: "user",
-				ObjectId:   *request.Params.Username,
-			}},
-		})
+		hasPermission, err := p.checkPermissionForAnySubject(ctx, &v1.ObjectReference{
+			ObjectType: "workspace",
+			ObjectId:   rootWorkspace,
+		}, servicePermission.Permission, subjects)
 
 		if err != nil {
-			fmt.Errorf("spicedb error: %v", err)
+			fmt.Printf("[ERROR] spicedb error: %v\n", err)
 			return api.GetPrincipalAccess500JSONResponse{}, err
 		}
 
-		if r.Permissionship == v1.CheckPermissionResponse_PERMISSIONSHIP_HAS_PERMISSION {
+		if hasPermission {
 			permTuple := request.Params.Application + ":" + key // of the form "playbook-dispatcher:run:read"
 
 			resp.Data = append(resp.Data, api.Access{Permission: permTuple})
@@ -79,74 +95,54 @@ func (p *PrbacSpicedbServer) GetPrincipalAccess(ctx context.Context, request api
 			continue // generic permission granted, so no need to look at attribute filters
 		}
 
-		// STEP 2: If they don't have unrestricted permission, check for attribute filtered permissions
+		// STEP 2: If they don't have unrestricted permission, check for attribute filtered permissions, each filter
+		// giving an access of its own
 
-		boundResourceType := servicePermission.Filter.ResourceType
-		permission := servicePermission.Filter.Verb
+		for _, servicePermissionFilter := range servicePermission.filters() {
+			boundResourceType := servicePermissionFilter.ResourceType
+			permission := servicePermissionFilter.Verb
 
-		lrClient, err := p.SpicedbClient.LookupResources(ctx, &v1.LookupResourcesRequest{
-			ResourceObjectType: boundResourceType,
-			Permission:         permission,
-			Subject: &v1.SubjectReference{
-				Object: &v1.ObjectReference{
-					ObjectType: "user",
-					ObjectId:   *request.Params.Username,
-				},
-			},
-		})
-
-		if err != nil {
-			fmt.Errorf("spicedb error: %v", err)
-			return api.GetPrincipalAccess500JSONResponse{}, err
-		}
+			boundResources, err := p.lookupResourcesForAnySubject(ctx, boundResourceType, permission, subjects) // e.g. service or inventory group
 
-		var boundResources []string
-		for {
-			next, err := lrClient.Recv()
-			if errors.Is(err, io.EOF) {
-				break
-			}
 			if err != nil {
-				fmt.Errorf("spicedb error: %v", err)
+				fmt.Printf("[ERROR] spicedb error: %v\n", err)
 				return api.GetPrincipalAccess500JSONResponse{}, err
 			}
 
-			boundResources = append(boundResources, next.GetResourceObjectId()) // e.g. service or inventory group
-		}
-
-		// STEP 2a: see if the user permission can be matched to the scope
-		if len(boundResources) == 0 {
-			continue
-		}
+			// STEP 2a: see if the user permission can be matched to the scope
+			if len(boundResources) == 0 {
+				continue
+			}
 
-		var resourceDefinitions []api.ResourceDefinition
+			var resourceDefinitions []api.ResourceDefinition
 
-		for _, resource := range boundResources {
-			operator := servicePermission.Filter.Operator
+			for _, resource := range boundResources {
+				operator := servicePermissionFilter.Operator
 
-			if strings.EqualFold("equal", operator) {
-				filter := api.ResourceDefinitionFilter{
-					Key:       servicePermission.Filter.Name,
-					Operation: api.ResourceDefinitionFilterOperation(operator),
-					Value:     resource,
-				}
+				if strings.EqualFold("equal", operator) {
+					filter := api.ResourceDefinitionFilter{
+						Key:       servicePermissionFilter.Name,
+						Operation: api.ResourceDefinitionFilterOperation(operator),
+						Value:     resource,
+					}
 
-				resourceDefinitions = append(resourceDefinitions, api.ResourceDefinition{AttributeFilter: filter})
+					resourceDefinitions = append(resourceDefinitions, api.ResourceDefinition{AttributeFilter: filter})
 
-			} else {
-				fmt.Errorf("unsupported PRBAC operator: %s", operator)
-				continue
+				} else {
+					fmt.Printf("[INFO] unsupported PRBAC operator: %s\n", operator)
+					continue
+				}
 			}
-		}
 
-		if len(resourceDefinitions) != 0 {
-			// We can make an attribute filter for each containing thing (service, inventory group) the user has access to
-			permTuple := request.Params.Application + ":" + key // of the form "playbook-dispatcher:run:read"
+			if len(resourceDefinitions) != 0 {
+				// We can make an attribute filter for each containing thing (service, inventory group) the user has access to
+				permTuple := request.Params.Application + ":" + key // of the form "playbook-dispatcher:run:read"
 
-			resp.Data = append(resp.Data, api.Access{
-				Permission:          permTuple,
-				ResourceDefinitions: resourceDefinitions,
-			})
+				resp.Data = append(resp.Data, api.Access{
+					Permission:          permTuple,
+					ResourceDefinitions: resourceDefinitions,
+				})
+			}
 		}
 	}
 
@@ -204,35 +200,24 @@ func (*PrbacSpicedbServer) UpdateGroup(ctx context.Context, request api.UpdateGr
 }
 
 func (p *PrbacSpicedbServer) DeletePrincipalFromGroup(ctx context.Context, request api.DeletePrincipalFromGroupRequestObject) (api.DeletePrincipalFromGroupResponseObject, error) {
-	userNames := strings.Split(request.Params.Usernames, ",")
-	updates := make([]*v1.RelationshipUpdate, len(userNames))
-	for i, username := range userNames {
-		updates[i] = &v1.RelationshipUpdate{
-			Operation: v1.RelationshipUpdate_OPERATION_DELETE,
-			Relationship: &v1.Relationship{
-				Resource: &v1.ObjectReference{
-					ObjectType: "group",
-					ObjectId:   request.Uuid.String(),
-				},
-				Relation: "member",
-				Subject: &v1.SubjectReference{
-					Object: &v1.ObjectReference{
-						ObjectType: "user",
-						ObjectId:   username, // TODO: needs to be an ID not a username
-					},
-				},
-			},
-		}
+	if ownership, err := p.getGroupOwnership(ctx, getUserOrg(ctx), request.Uuid.String()); err != nil {
+		return api.DeletePrincipalFromGroup500JSONResponse(errorBody(500, err.Error())), nil
+	} else if ownership != groupOwned {
+		return api.DeletePrincipalFromGroup404JSONResponse(notFoundBody("group not found: " + request.Uuid.String())), nil
 	}
 
-	_, err := p.SpicedbClient.WriteRelationships(ctx, &v1.WriteRelationshipsRequest{
-		Updates: updates,
-	})
+	usernames := strings.Split(request.Params.Usernames, ",")
+	updates := groupPrincipalUpdates(v1.RelationshipUpdate_OPERATION_DELETE, request.Uuid.String(), usernames)
+
+	entry := auditEntry(ctx, "deletePrincipalFromGroup", append(auditTargets("group", request.Uuid.String()), auditTargets("principal", usernames...)...)...)
+	_, err := p.writeRelationships(ctx, entry, updates)
 
 	if err != nil {
 		return api.DeletePrincipalFromGroup500JSONResponse{}, err
 	}
 
+	p.notify(ctx, WebhookEvent{Type: api.GroupPrincipalsRemoved, Group: request.Uuid.String(), Principals: usernames})
+
 	return api.DeletePrincipalFromGroup204Response{}, nil
 }
 
@@ -242,41 +227,78 @@ func (*PrbacSpicedbServer) GetPrincipalsFromGroup(ctx context.Context, request a
 }
 
 func (p *PrbacSpicedbServer) AddPrincipalToGroup(ctx context.Context, request api.AddPrincipalToGroupRequestObject) (api.AddPrincipalToGroupResponseObject, error) {
-	updates := make([]*v1.RelationshipUpdate, len(request.Body.Principals))
+	usernames := make([]string, len(request.Body.Principals))
 	for i, principal := range request.Body.Principals {
-		updates[i] = &v1.RelationshipUpdate{
-			Operation: v1.RelationshipUpdate_OPERATION_TOUCH,
-			Relationship: &v1.Relationship{
+		usernames[i] = principal.Username
+	}
 
-				Resource: &v1.ObjectReference{
-					ObjectType: "group",
-					ObjectId:   request.Uuid.String(),
-				},
-				Relation: "member",
-				Subject: &v1.SubjectReference{
-					Object: &v1.ObjectReference{
-						ObjectType: "user",
-						ObjectId:   principal.Username, // TODO: needs to be an ID not a username
-					},
-				},
-			},
-		}
+	ownership, err := p.getGroupOwnership(ctx, getUserOrg(ctx), request.Uuid.String())
+	if err != nil {
+		return api.AddPrincipalToGroup500JSONResponse(errorBody(500, err.Error())), nil
+	}
+	if ownership == groupNotFound {
+		return api.AddPrincipalToGroup404JSONResponse(notFoundBody("group not found: " + request.Uuid.String())), nil
 	}
 
-	_, err := p.SpicedbClient.WriteRelationships(ctx, &v1.WriteRelationshipsRequest{
-		Updates: updates,
-	})
+	updates := groupPrincipalUpdates(v1.RelationshipUpdate_OPERATION_TOUCH, request.Uuid.String(), usernames)
+
+	var preconditions []*v1.Precondition
+	if ownership == groupUnclaimed {
+		claim, precondition := groupClaim(getUserOrg(ctx), request.Uuid.String())
+		updates, preconditions = append(updates, claim), append(preconditions, precondition)
+	}
+
+	entry := auditEntry(ctx, "addPrincipalToGroup", append(auditTargets("group", request.Uuid.String()), auditTargets("principal", usernames...)...)...)
+	_, err = p.writeRelationships(ctx, entry, updates, preconditions...)
 
 	if err != nil {
 		return api.AddPrincipalToGroup500JSONResponse{}, err
 	}
 
+	p.notifyPrincipalsAdded(ctx, request.Uuid.String(), usernames)
+
 	return api.AddPrincipalToGroup200JSONResponse{}, nil
 }
 
-func (*PrbacSpicedbServer) DeleteRoleFromGroup(ctx context.Context, request api.DeleteRoleFromGroupRequestObject) (api.DeleteRoleFromGroupResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
+func (p *PrbacSpicedbServer) DeleteRoleFromGroup(ctx context.Context, request api.DeleteRoleFromGroupRequestObject) (api.DeleteRoleFromGroupResponseObject, error) {
+	groupId := request.Uuid.String()
+	roles := strings.Split(request.Params.Roles, ",")
+
+	if ownership, err := p.getGroupOwnership(ctx, getUserOrg(ctx), groupId); err != nil {
+		return api.DeleteRoleFromGroup500JSONResponse(errorBody(500, err.Error())), nil
+	} else if ownership != groupOwned {
+		return api.DeleteRoleFromGroup404JSONResponse(errorBody(404, "group not found: "+groupId)), nil
+	}
+	if unowned, err := p.findUnownedRole(ctx, getUserOrg(ctx), roles); err != nil {
+		return api.DeleteRoleFromGroup500JSONResponse(errorBody(500, err.Error())), nil
+	} else if unowned != "" {
+		return api.DeleteRoleFromGroup404JSONResponse(errorBody(404, "role not found: "+unowned)), nil
+	}
+
+	if err := p.customizeDefaultGroup(ctx, groupId); err != nil {
+		return api.DeleteRoleFromGroup500JSONResponse{}, err
+	}
+
+	updates := make([]*v1.RelationshipUpdate, 0)
+
+	for _, role := range roles {
+		bindings, err := p.getRoleBindingIds(ctx, role)
+		if err != nil {
+			return api.DeleteRoleFromGroup500JSONResponse{}, err
+		}
+
+		updates = append(updates, groupRoleUpdates(v1.RelationshipUpdate_OPERATION_DELETE, getUserOrg(ctx), groupId, role, bindings)...)
+	}
+
+	//TODO: some sort of concurrency check is required here: this write is dependent upon results read above
+	entry := auditEntry(ctx, "deleteRoleFromGroup", append(auditTargets("group", groupId), auditTargets("role", roles...)...)...)
+	_, err := p.writeRelationships(ctx, entry, updates)
+
+	if err != nil {
+		return api.DeleteRoleFromGroup500JSONResponse{}, err
+	}
+
+	return api.DeleteRoleFromGroup204Response{}, nil
 }
 
 func (*PrbacSpicedbServer) ListRolesForGroup(ctx context.Context, request api.ListRolesForGroupRequestObject) (api.ListRolesForGroupResponseObject, error) {
@@ -285,61 +307,53 @@ func (*PrbacSpicedbServer) ListRolesForGroup(ctx context.Context, request api.Li
 }
 
 func (p *PrbacSpicedbServer) AddRoleToGroup(ctx context.Context, request api.AddRoleToGroupRequestObject) (api.AddRoleToGroupResponseObject, error) {
-	updates := make([]*v1.RelationshipUpdate, 0)
+	roles := make([]string, len(request.Body.Roles))
+	for i, role := range request.Body.Roles {
+		roles[i] = role.String()
+	}
 
-	for _, role := range request.Body.Roles {
-		client, err := p.SpicedbClient.LookupSubjects(ctx, &v1.LookupSubjectsRequest{
-			Resource: &v1.ObjectReference{
-				ObjectType: "rbac/v1role",
-				ObjectId:   role.String(),
-			},
-			Permission:            "binding",
-			SubjectObjectType:     "role_binding",
-			OptionalConcreteLimit: 0,
-		})
+	ownership, err := p.getGroupOwnership(ctx, getUserOrg(ctx), request.Uuid.String())
+	if err != nil {
+		return api.AddRoleToGroup500JSONResponse(errorBody(500, err.Error())), nil
+	}
+	if ownership == groupNotFound {
+		return api.AddRoleToGroup404JSONResponse(errorBody(404, "group not found: "+request.Uuid.String())), nil
+	}
+	if unowned, err := p.findUnownedRole(ctx, getUserOrg(ctx), roles); err != nil {
+		return api.AddRoleToGroup500JSONResponse(errorBody(500, err.Error())), nil
+	} else if unowned != "" {
+		return api.AddRoleToGroup404JSONResponse(errorBody(404, "role not found: "+unowned)), nil
+	}
 
+	if err := p.customizeDefaultGroup(ctx, request.Uuid.String()); err != nil {
+		return api.AddRoleToGroup500JSONResponse{}, err
+	}
+
+	updates := make([]*v1.RelationshipUpdate, 0)
+
+	for _, role := range roles {
+		bindings, err := p.getRoleBindingIds(ctx, role)
 		if err != nil {
 			return nil, err
 		}
 
-		for true {
-			result, err := client.Recv()
-			if err != nil {
-				if err == io.EOF {
-					break
-				} else {
-					return nil, err
-				}
-			}
+		updates = append(updates, groupRoleUpdates(v1.RelationshipUpdate_OPERATION_TOUCH, getUserOrg(ctx), request.Uuid.String(), role, bindings)...)
+	}
 
-			updates = append(updates, &v1.RelationshipUpdate{
-				Operation: v1.RelationshipUpdate_OPERATION_TOUCH,
-				Relationship: &v1.Relationship{
-					Resource: &v1.ObjectReference{
-						ObjectType: "role_binding",
-						ObjectId:   result.Subject.SubjectObjectId,
-					},
-					Relation: "subject",
-					Subject: &v1.SubjectReference{
-						Object: &v1.ObjectReference{
-							ObjectType: "group",
-							ObjectId:   request.Uuid.String(),
-						},
-						OptionalRelation: "member",
-					},
-				},
-			})
-		}
+	var preconditions []*v1.Precondition
+	if ownership == groupUnclaimed {
+		claim, precondition := groupClaim(getUserOrg(ctx), request.Uuid.String())
+		updates, preconditions = append(updates, claim), append(preconditions, precondition)
 	}
 
 	//TODO: some sort of concurrency check is required here: this write is dependent upon results read above
-	_, err := p.SpicedbClient.WriteRelationships(ctx, &v1.WriteRelationshipsRequest{
-		Updates: updates,
-	})
+	entry := auditEntry(ctx, "addRoleToGroup", append(auditTargets("group", request.Uuid.String()), auditTargets("role", roles...)...)...)
+	_, err = p.writeRelationships(ctx, entry, updates, preconditions...)
 
 	if err != nil {
 		return api.AddRoleToGroup500JSONResponse{}, err
 	}
+	p.notify(ctx, WebhookEvent{Type: api.GroupRolesAdded, Group: request.Uuid.String(), Roles: roles})
 
 	return api.AddRoleToGroup200JSONResponse{}, nil
 }
@@ -390,9 +404,13 @@ func (*PrbacSpicedbServer) ListRoles(ctx context.Context, request api.ListRolesR
 }
 
 func (p *PrbacSpicedbServer) CreateRole(ctx context.Context, request api.CreateRoleRequestObject) (api.CreateRoleResponseObject, error) {
-	// TODO: the following would ordinarily be extracted from the request headers
-	userOrg := "aspian"
-	rootWorkspace := userOrg + "_root"
+	rootWorkspace := getRootWorkspace(getUserOrg(ctx))
+
+	if workspaceId, err := p.findUnownedWorkspace(ctx, getUserOrg(ctx), request.Body.Access, nil); err != nil {
+		return nil, err
+	} else if workspaceId != "" {
+		return api.CreateRole404JSONResponse(errorBody(404, "workspace not found: "+workspaceId)), nil
+	}
 
 	id, err := uuid.NewUUID()
 	if err != nil {
@@ -401,48 +419,32 @@ func (p *PrbacSpicedbServer) CreateRole(ctx context.Context, request api.CreateR
 
 	roleId := id.String()
 
-	// Create corresponding role and rolebinding
-	updates := make([]*v1.RelationshipUpdate, 4)
+	updates, unhandled := roleUpdates(rootWorkspace, roleId, request.Body.Access)
+	for _, description := range unhandled {
+		fmt.Printf("[INFO] %s in role %s\n", description, request.Body.Name)
+	}
+	updates = append(updates, tenantUpdate(v1.RelationshipUpdate_OPERATION_CREATE, "rbac/v1role", roleId, getUserOrg(ctx)))
 
-	updates[0] = createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "role_binding", roleId, "granted", "role", roleId)
-	updates[1] = createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "workspace", rootWorkspace, "user_grant", "role_binding", roleId)
-	updates[2] = createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "rbac/v1role", roleId, "role", "role", roleId)
-	updates[3] = createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "rbac/v1role", roleId, "binding", "role_binding", roleId)
+	_, err = p.writeRelationships(ctx, auditEntry(ctx, "createRole", auditTargets("role", roleId)...), updates)
 
-	for _, access := range request.Body.Access {
-		if access.ResourceDefinitions == nil {
-			//Add converted role permissions
-			convertedPermission := cleanNameForSchemaCompatibility(access.Permission)
-			updates = append(updates, createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "role", roleId, convertedPermission, "user", "*"))
-		} else {
-			for _, definition := range access.ResourceDefinitions {
-				filter := definition.AttributeFilter
-
-				switch filter.Key {
-				case "group.id":
-					if role, ok := permissionsToSystemRoles[access.Permission]; ok {
-						bindingId := roleId + "_" + filter.Value //TODO: value can be an array, but the generated API doesn't accept it.
-
-						updates = append(updates, createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "workspace", filter.Value, "parent", "workspace", rootWorkspace))
-						updates = append(updates, createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "workspace", filter.Value, "user_grant", "role_binding", bindingId))
-						updates = append(updates, createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "rbac/v1role", roleId, "binding", "role_binding", bindingId))
-						updates = append(updates, createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "role_binding", bindingId, "granted", "role", role))
-					}
-				default:
-					fmt.Printf("[INFO] Unhandled resource definition for permission %s in role %s, key: %s\n", access.Permission, request.Body.Name, filter.Key)
-				}
-			}
-		}
+	if err != nil {
+		return nil, err
 	}
 
-	_, err = p.SpicedbClient.WriteRelationships(ctx, &v1.WriteRelationshipsRequest{
-		Updates: updates,
+	now := time.Now().UTC()
+	err = p.Metadata.Put("role", roleId, Metadata{
+		Name:        request.Body.Name,
+		Description: valueOrEmpty(request.Body.Description),
+		Created:     now,
+		Modified:    now,
 	})
-
 	if err != nil {
 		return nil, err
 	}
 
+	p.notify(ctx, WebhookEvent{Type: api.RoleCreated, Role: roleId, Name: request.Body.Name})
+	p.notifyRoleWritePermissions(ctx, roleId, request.Body.Name, request.Body.Access, nil)
+
 	return api.CreateRole201JSONResponse{
 		Uuid: id,
 	}, nil
@@ -463,104 +465,367 @@ func (*PrbacSpicedbServer) PatchRole(ctx context.Context, request api.PatchRoleR
 	panic("implement me")
 }
 
-func (*PrbacSpicedbServer) UpdateRole(ctx context.Context, request api.UpdateRoleRequestObject) (api.UpdateRoleResponseObject, error) {
-	//TODO implement me
-	//Needs to perform similar writes to CreateRole ~plus~:
-	//* Any no longer used permissions need to be revoked, see AddGroupToRole for how to find existing role_bindings
-	//* Any new role_bindings that need to be created need their subject to be copied from the original role_binding subject
-	panic("implement me")
+func (p *PrbacSpicedbServer) UpdateRole(ctx context.Context, request api.UpdateRoleRequestObject) (api.UpdateRoleResponseObject, error) {
+	roleId := request.Uuid.String()
+
+	if isSystemRole(roleId) {
+		return api.UpdateRole403JSONResponse(forbiddenBody("system roles cannot be updated")), nil
+	}
+
+	if owned, err := p.ownsRole(ctx, getUserOrg(ctx), roleId); err != nil {
+		return api.UpdateRole500JSONResponse(errorBody(500, err.Error())), nil
+	} else if !owned {
+		return api.UpdateRole404JSONResponse(errorBody(404, "role not found: "+roleId)), nil
+	}
+
+	roleRelationships, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+		ResourceType:       "rbac/v1role",
+		OptionalResourceId: roleId,
+	})
+	if err != nil {
+		return api.UpdateRole500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	if len(roleRelationships) == 0 {
+		return api.UpdateRole404JSONResponse(errorBody(404, "role not found: "+roleId)), nil
+	}
+
+	previous, err := p.getRoleAccesses(ctx, roleId, roleRelationships)
+	if err != nil {
+		return api.UpdateRole500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	if workspaceId, err := p.findUnownedWorkspace(ctx, getUserOrg(ctx), request.Body.Access, nil); err != nil {
+		return api.UpdateRole500JSONResponse(errorBody(500, err.Error())), nil
+	} else if workspaceId != "" {
+		return api.UpdateRole404JSONResponse(errorBody(404, "workspace not found: "+workspaceId)), nil
+	}
+
+	updates, unhandled, err := p.roleReplaceUpdates(ctx, getUserOrg(ctx), roleId, request.Body.Access)
+	if err != nil {
+		return api.UpdateRole500JSONResponse(errorBody(500, err.Error())), nil
+	}
+	for _, description := range unhandled {
+		fmt.Printf("[INFO] %s in role %s\n", description, request.Body.Name)
+	}
+
+	//TODO: some sort of concurrency check is required here: this write is dependent upon results read above
+	_, err = p.writeRelationships(ctx, auditEntry(ctx, "updateRole", auditTargets("role", roleId)...), uniqueUpdates(updates))
+	if err != nil {
+		return api.UpdateRole500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	p.putChangedMetadata("role", roleId, request.Body.Name, valueOrEmpty(request.Body.Description))
+
+	p.notify(ctx, WebhookEvent{Type: api.RoleUpdated, Role: roleId, Name: request.Body.Name})
+	p.notifyRoleWritePermissions(ctx, roleId, request.Body.Name, request.Body.Access, previous)
+
+	return api.UpdateRole200Response{}, nil
 }
 
 func (p *PrbacSpicedbServer) GetRoleAccess(ctx context.Context, request api.GetRoleAccessRequestObject) (api.GetRoleAccessResponseObject, error) {
-	// TODO: Not fully tested/implemented -- see discussion in getPRBACPermsFromSpicedbPerms
+	roleId := request.Uuid.String() // assume that the uuid form is the form that we are storing in spicedb
+
+	if owned, err := p.ownsRole(ctx, getUserOrg(ctx), roleId); err != nil {
+		return api.GetRoleAccess500JSONResponse(errorBody(500, err.Error())), nil
+	} else if !owned {
+		return api.GetRoleAccess404JSONResponse(errorBody(404, "role not found: "+roleId)), nil
+	}
 
-	resp := api.GetRoleAccess200JSONResponse{}
+	roleRelationships, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+		ResourceType:       "rbac/v1role",
+		OptionalResourceId: roleId,
+	})
+	if err != nil {
+		return api.GetRoleAccess500JSONResponse(errorBody(500, err.Error())), nil
+	}
 
-	role := request.Uuid // assume that the uuid form is the form that we are storing in spicedb
+	if len(roleRelationships) == 0 {
+		return api.GetRoleAccess404JSONResponse(errorBody(404, "role not found: "+roleId)), nil
+	}
 
-	rClient, err := p.SpicedbClient.ReadRelationships(ctx, &v1.ReadRelationshipsRequest{
-		RelationshipFilter: &v1.RelationshipFilter{
-			ResourceType:       "role",
-			OptionalResourceId: role.String(),
+	accesses, err := p.getRoleAccesses(ctx, roleId, roleRelationships)
+	if err != nil {
+		return api.GetRoleAccess500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	start, end, pageSize := pageBounds(len(accesses), request.Params.Limit, request.Params.Offset)
+
+	return api.GetRoleAccess200JSONResponse{
+		Data:  accesses[start:end],
+		Links: p.paginationLinks("/roles/"+roleId+"/access/", len(accesses), start, pageSize),
+		Meta:  paginationMeta(len(accesses)),
+	}, nil
+}
+
+func (p *PrbacSpicedbServer) GetStatus(ctx context.Context, request api.GetStatusRequestObject) (api.GetStatusResponseObject, error) {
+	status := api.Status{ApiVersion: 1}
+
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range info.Settings {
+			if setting.Key == "vcs.revision" && setting.Value != "" {
+				commit := setting.Value[:min(len(setting.Value), 7)]
+				status.Commit = &commit
+			}
+		}
+	}
+
+	p.servicesMu.RLock()
+	defer p.servicesMu.RUnlock()
+
+	if p.servicesVersion != "" {
+		version, loaded := p.servicesVersion, p.servicesLoaded
+		status.ServicesVersion, status.ServicesLoaded = &version, &loaded
+	}
+
+	return api.GetStatus200JSONResponse(status), nil
+}
+
+// getRoleAccesses reconstructs the Access list of a role from the relationships CreateRole writes:
+//   - unrestricted permissions are relations on the role itself, i.e. role:<roleId>#<permission>@user:*
+//   - group.id attribute filters are role_bindings <roleId>_<workspace> granting a system role on that workspace
+func (p *PrbacSpicedbServer) getRoleAccesses(ctx context.Context, roleId string, roleRelationships []*v1.Relationship) ([]api.Access, error) {
+	accesses := make([]api.Access, 0)
+
+	permissionRelationships, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+		ResourceType:       "role",
+		OptionalResourceId: roleId,
+		OptionalSubjectFilter: &v1.SubjectFilter{
+			SubjectType: "user",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, relationship := range permissionRelationships {
+		permission, ok := p.permissionFromRelation(relationship.GetRelation())
+		if !ok {
+			fmt.Printf("[INFO] Unmapped relation %s on role %s\n", relationship.GetRelation(), roleId)
+			continue
+		}
+
+		accesses = append(accesses, api.Access{
+			Permission:          permission,
+			ResourceDefinitions: []api.ResourceDefinition{},
+		})
+	}
+
+	filteredAccesses := make(map[string]*api.Access)
+
+	for _, relationship := range roleRelationships {
+		bindingId := relationship.GetSubject().GetObject().GetObjectId()
+		if relationship.GetRelation() != "binding" || bindingId == roleId {
+			continue // the binding named after the role carries the unrestricted permissions handled above
+		}
+
+		permissions, err := p.getBindingPermissions(ctx, bindingId)
+		if err != nil {
+			return nil, err
+		}
+
+		workspaces, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+			ResourceType:     "workspace",
+			OptionalRelation: "user_grant",
 			OptionalSubjectFilter: &v1.SubjectFilter{
-				SubjectType: "user",
+				SubjectType:       "role_binding",
+				OptionalSubjectId: bindingId,
 			},
-		},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, permission := range permissions {
+			access, ok := filteredAccesses[permission]
+			if !ok {
+				access = &api.Access{Permission: permission}
+				filteredAccesses[permission] = access
+			}
+
+			for _, workspace := range workspaces {
+				access.ResourceDefinitions = append(access.ResourceDefinitions, api.ResourceDefinition{
+					AttributeFilter: api.ResourceDefinitionFilter{
+						Key:       "group.id",
+						Operation: api.Equal,
+						Value:     workspace.GetResource().GetObjectId(),
+					},
+				})
+			}
+		}
+	}
+
+	for _, access := range filteredAccesses {
+		if len(access.ResourceDefinitions) != 0 {
+			accesses = append(accesses, *access)
+		}
+	}
+
+	sort.SliceStable(accesses, func(i, j int) bool {
+		if accesses[i].Permission != accesses[j].Permission {
+			return accesses[i].Permission < accesses[j].Permission
+		}
+		return len(accesses[i].ResourceDefinitions) < len(accesses[j].ResourceDefinitions)
 	})
 
+	for _, access := range accesses {
+		sort.Slice(access.ResourceDefinitions, func(i, j int) bool {
+			return access.ResourceDefinitions[i].AttributeFilter.Value < access.ResourceDefinitions[j].AttributeFilter.Value
+		})
+	}
+
+	return accesses, nil
+}
+
+// getBindingPermissions translates the roles granted by a role_binding back into RBAC v1 permissions.
+func (p *PrbacSpicedbServer) getBindingPermissions(ctx context.Context, bindingId string) ([]string, error) {
+	grants, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+		ResourceType:       "role_binding",
+		OptionalResourceId: bindingId,
+		OptionalRelation:   "granted",
+	})
 	if err != nil {
-		fmt.Errorf("spicedb error: %v", err)
-		return api.GetRoleAccess500JSONResponse{}, err
+		return nil, err
 	}
 
-	var relationships []string
-	for {
-		next, err := rClient.Recv()
-		if errors.Is(err, io.EOF) {
-			break
+	var permissions []string
+	for _, grant := range grants {
+		grantedRole := grant.GetSubject().GetObject().GetObjectId()
+
+		if permission, ok := permissionForSystemRole(grantedRole); ok {
+			permissions = append(permissions, permission)
+			continue
 		}
+
+		// Not a system role we know of, so fall back to the permissions the role itself carries
+		relationships, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+			ResourceType:       "role",
+			OptionalResourceId: grantedRole,
+			OptionalSubjectFilter: &v1.SubjectFilter{
+				SubjectType: "user",
+			},
+		})
 		if err != nil {
-			fmt.Errorf("spicedb error: %v", err)
-			return api.GetRoleAccess500JSONResponse{}, err
+			return nil, err
+		}
+
+		for _, relationship := range relationships {
+			if permission, ok := p.permissionFromRelation(relationship.GetRelation()); ok {
+				permissions = append(permissions, permission)
+			}
 		}
+	}
+
+	return permissions, nil
+}
 
-		relationships = append(relationships, next.GetRelationship().GetRelation())
+// roleUpdates translates an RBAC v1 role into relationships: a role and a role_binding of the same id granting it at
+// the root workspace, with unrestricted permissions as relations on the role itself, and for each group.id attribute
+// filter, a role_binding <roleId>_<workspace> granting the matching system role on that workspace. It also describes
+// any access it has no translation for.
+func roleUpdates(rootWorkspace, roleId string, accesses []api.Access) ([]*v1.RelationshipUpdate, []string) {
+	updates := []*v1.RelationshipUpdate{
+		createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "role_binding", roleId, "granted", "role", roleId),
+		createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "workspace", rootWorkspace, "user_grant", "role_binding", roleId),
+		createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "rbac/v1role", roleId, "role", "role", roleId),
+		createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "rbac/v1role", roleId, "binding", "role_binding", roleId),
 	}
 
-	resp.Data = p.getPRBACPermsFromSpicedbPerms(relationships)
+	var unhandled []string
 
-	return resp, nil
+	for _, access := range accesses {
+		// RBAC v1 itself returns unrestricted access with an empty list of resource definitions
+		if len(access.ResourceDefinitions) == 0 {
+			//Add converted role permissions
+			convertedPermission := cleanNameForSchemaCompatibility(access.Permission)
+			updates = append(updates, createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "role", roleId, convertedPermission, "user", "*"))
+			continue
+		}
+
+		for _, definition := range access.ResourceDefinitions {
+			filter := definition.AttributeFilter
+
+			switch filter.Key {
+			case "group.id":
+				role, ok := permissionsToSystemRoles[access.Permission]
+				if !ok {
+					unhandled = append(unhandled, fmt.Sprintf("No system role for permission %s filtered by group.id", access.Permission))
+					continue
+				}
+
+				bindingId := roleId + "_" + filter.Value //TODO: value can be an array, but the generated API doesn't accept it.
+
+				updates = append(updates, createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "workspace", filter.Value, "user_grant", "role_binding", bindingId))
+				updates = append(updates, createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "rbac/v1role", roleId, "binding", "role_binding", bindingId))
+				updates = append(updates, createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, "role_binding", bindingId, "granted", "role", role))
+			default:
+				unhandled = append(unhandled, fmt.Sprintf("Unhandled resource definition for permission %s, key: %s", access.Permission, filter.Key))
+			}
+		}
+	}
+
+	return updates, unhandled
 }
 
-func (*PrbacSpicedbServer) GetStatus(ctx context.Context, request api.GetStatusRequestObject) (api.GetStatusResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
+// roleBindingIds lists the role_bindings among updates written for a role by roleUpdates.
+func roleBindingIds(updates []*v1.RelationshipUpdate) []string {
+	var bindings []string
+	for _, update := range updates {
+		relationship := update.GetRelationship()
+		if relationship.GetResource().GetObjectType() == "rbac/v1role" && relationship.GetRelation() == "binding" {
+			bindings = append(bindings, relationship.GetSubject().GetObject().GetObjectId())
+		}
+	}
+
+	return bindings
 }
 
-func (p *PrbacSpicedbServer) getPRBACPermsFromSpicedbPerms(spicedbPerms []string) (accesses []api.Access) {
-	var spiceToPRbacMapping map[string]string
+// getRoleBindingIds lists the role_bindings of an RBAC v1 role. System roles have none.
+func (p *PrbacSpicedbServer) getRoleBindingIds(ctx context.Context, roleId string) ([]string, error) {
+	relationships, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+		ResourceType:       "rbac/v1role",
+		OptionalResourceId: roleId,
+		OptionalRelation:   "binding",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bindings := make([]string, len(relationships))
+	for i, relationship := range relationships {
+		bindings[i] = relationship.GetSubject().GetObject().GetObjectId()
+	}
 
-	for service, permission := range p.RbacServices {
-		for rbacPerm, resourcePerm := range permission {
-			spiceDbPerm := resourcePerm.Permission
+	return bindings, nil
+}
 
-			spiceToPRbacMapping[spiceDbPerm] = service + ":" + rbacPerm
+// groupRoleUpdates adds a group to, or with OPERATION_DELETE removes it from, the subjects of each of a role's bindings.
+// System roles have no bindings of their own, so the group has one at the root workspace instead. The tenant's
+// platform default group, which it only changes once customized, has its own apart from those provisioning writes.
+func groupRoleUpdates(operation v1.RelationshipUpdate_Operation, org, groupId, roleId string, bindings []string) []*v1.RelationshipUpdate {
+	if len(bindings) == 0 && isSystemRole(roleId) {
+		if groupId == getDefaultGroupId(org, "platform_default") {
+			return tenantBindingUpdates(operation, org, groupId, roleId)
 		}
+		return rootBindingUpdates(operation, org, groupId, roleId)
 	}
 
-	for _, spiceDbPerm := range spicedbPerms {
-		rbacPerm, mappingFound := spiceToPRbacMapping[spiceDbPerm]
-
-		if mappingFound {
-			accesses = append(accesses, api.Access{
-				Permission: rbacPerm,
-				// TODO: anything for resource definitions?
-
-				// Discussion:
-				// Without adding roles to the filter in the config (see below), it's not clear how we would be able to attach resourceDefinitions to a role.
-				// However, adding roles may also imply changes in spicedb, bringing up a host of consistency issues we'd like to avoid.
-				//
-				//{
-				//	"playbook-dispatcher": {
-				//		"run:read": {
-				//			"permission": "dispatcher_view_runs",
-				//			"filter": {
-				//				"roles": ["task_admin", "remediations_admin"],
-				//				"name": "service",
-				//				"operator": "equal",
-				//				"resourceType": "dispatcher/service",
-				//				"verb": "view"
-				//			}
-				//		}
-				//	}
-				//}
-
-				// But before we do something like this, we want to step back and see if this endpoint enjoys enough usage to warrant it.
-			})
-		}
+	updates := make([]*v1.RelationshipUpdate, len(bindings))
+	for i, binding := range bindings {
+		updates[i] = createSubjectSetRelationshipUpdate(operation, "role_binding", binding, "subject", "group", groupId, "member")
+	}
+
+	return updates
+}
+
+// groupPrincipalUpdates adds principals to, or with OPERATION_DELETE removes them from, the members of a group.
+func groupPrincipalUpdates(operation v1.RelationshipUpdate_Operation, groupId string, usernames []string) []*v1.RelationshipUpdate {
+	updates := make([]*v1.RelationshipUpdate, len(usernames))
+	for i, username := range usernames {
+		updates[i] = createRelationshipUpdate(operation, "group", groupId, "member", "user", username) // TODO: needs to be an ID not a username
 	}
 
-	return
+	return updates
 }
 
 func cleanNameForSchemaCompatibility(name string) string { //Taken from schema translator
@@ -576,7 +841,7 @@ func cleanNameForSchemaCompatibility(name string) string { //Taken from schema t
 
 func createRelationshipUpdate(operation v1.RelationshipUpdate_Operation, objectType, objectId, relation, subjectType, subjectId string) *v1.RelationshipUpdate {
 	return &v1.RelationshipUpdate{
-		Operation: v1.RelationshipUpdate_OPERATION_TOUCH,
+		Operation: operation,
 		Relationship: &v1.Relationship{
 			Resource: &v1.ObjectReference{
 				ObjectType: objectType,
@@ -592,3 +857,10 @@ func createRelationshipUpdate(operation v1.RelationshipUpdate_Operation, objectT
 		},
 	}
 }
+
+func createSubjectSetRelationshipUpdate(operation v1.RelationshipUpdate_Operation, objectType, objectId, relation, subjectType, subjectId, subjectRelation string) *v1.RelationshipUpdate {
+	update := createRelationshipUpdate(operation, objectType, objectId, relation, subjectType, subjectId)
+	update.Relationship.Subject.OptionalRelation = subjectRelation
+
+	return update
+}
@@ -0,0 +1,207 @@
+package server
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+func TestGetRoleAccessUnrestricted(t *testing.T) {
+	roleId := "3a7bd5a4-7507-11ee-8c9d-0242ac170005"
+	p, _ := newTestServer(t,
+		"role_binding:"+roleId+"#granted@role:"+roleId,
+		"workspace:aspian_root#user_grant@role_binding:"+roleId,
+		"rbac/v1role:"+roleId+"#role@role:"+roleId,
+		"rbac/v1role:"+roleId+"#binding@role_binding:"+roleId,
+		"rbac/v1role:"+roleId+"#tenant@organization:aspian",
+		"role:"+roleId+"#playbook_dispatcher_run_read@user:*",
+		"role:"+roleId+"#inventory_hosts_write@user:*",
+		"role:"+roleId+"#cost_management_cost_model_all@user:*",
+	)
+
+	resp, err := p.GetRoleAccess(context.Background(), api.GetRoleAccessRequestObject{Uuid: uuid.MustParse(roleId)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	page, ok := resp.(api.GetRoleAccess200JSONResponse)
+	if !ok {
+		t.Fatalf("expected 200 response, got %T", resp)
+	}
+
+	expected := []api.Access{
+		{Permission: "cost-management:cost_model:*", ResourceDefinitions: []api.ResourceDefinition{}},
+		{Permission: "inventory:hosts:write", ResourceDefinitions: []api.ResourceDefinition{}},
+		{Permission: "playbook-dispatcher:run:read", ResourceDefinitions: []api.ResourceDefinition{}},
+	}
+	if !reflect.DeepEqual(page.Data, expected) {
+		t.Errorf("unexpected access: %+v", page.Data)
+	}
+	if *page.Meta.Count != 3 {
+		t.Errorf("expected count 3, got %d", *page.Meta.Count)
+	}
+}
+
+func TestGetRoleAccessWithResourceDefinitions(t *testing.T) {
+	p, _ := newTestServer(t,
+		"workspace:ws1#parent@workspace:aspian_root",
+		"workspace:ws1#tenant@organization:aspian",
+		"workspace:ws2#parent@workspace:aspian_root",
+		"workspace:ws2#tenant@organization:aspian",
+	)
+
+	created, err := p.CreateRole(context.Background(), api.CreateRoleRequestObject{Body: &api.RoleIn{
+		Name: "hosts",
+		Access: []api.Access{
+			{Permission: "inventory:groups:read"},
+			{Permission: "inventory:hosts:read", ResourceDefinitions: []api.ResourceDefinition{
+				{AttributeFilter: api.ResourceDefinitionFilter{Key: "group.id", Operation: api.Equal, Value: "ws2"}},
+				{AttributeFilter: api.ResourceDefinitionFilter{Key: "group.id", Operation: api.Equal, Value: "ws1"}},
+			}},
+			{Permission: "inventory:hosts:write", ResourceDefinitions: []api.ResourceDefinition{
+				{AttributeFilter: api.ResourceDefinitionFilter{Key: "group.id", Operation: api.Equal, Value: "ws1"}},
+			}},
+		},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := p.GetRoleAccess(context.Background(), api.GetRoleAccessRequestObject{Uuid: created.(api.CreateRole201JSONResponse).Uuid})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	groupFilter := func(value string) api.ResourceDefinition {
+		return api.ResourceDefinition{AttributeFilter: api.ResourceDefinitionFilter{Key: "group.id", Operation: api.Equal, Value: value}}
+	}
+	expected := []api.Access{
+		{Permission: "inventory:groups:read", ResourceDefinitions: []api.ResourceDefinition{}},
+		{Permission: "inventory:hosts:read", ResourceDefinitions: []api.ResourceDefinition{groupFilter("ws1"), groupFilter("ws2")}},
+		{Permission: "inventory:hosts:write", ResourceDefinitions: []api.ResourceDefinition{groupFilter("ws1")}},
+	}
+	if data := resp.(api.GetRoleAccess200JSONResponse).Data; !reflect.DeepEqual(data, expected) {
+		t.Errorf("unexpected access: %+v", data)
+	}
+}
+
+func TestGetRoleAccessPagination(t *testing.T) {
+	roleId := "3a7bd5a4-7507-11ee-8c9d-0242ac170005"
+	p, _ := newTestServer(t,
+		"rbac/v1role:"+roleId+"#binding@role_binding:"+roleId,
+		"rbac/v1role:"+roleId+"#tenant@organization:aspian",
+		"role:"+roleId+"#inventory_hosts_read@user:*",
+		"role:"+roleId+"#inventory_hosts_write@user:*",
+		"role:"+roleId+"#inventory_groups_read@user:*",
+	)
+
+	limit, offset := 2, 2
+	resp, err := p.GetRoleAccess(context.Background(), api.GetRoleAccessRequestObject{
+		Uuid:   uuid.MustParse(roleId),
+		Params: api.GetRoleAccessParams{Limit: &limit, Offset: &offset},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	page := resp.(api.GetRoleAccess200JSONResponse)
+	if len(page.Data) != 1 || page.Data[0].Permission != "inventory:hosts:write" {
+		t.Errorf("unexpected page: %+v", page.Data)
+	}
+	if *page.Meta.Count != 3 {
+		t.Errorf("expected count 3, got %d", *page.Meta.Count)
+	}
+	if page.Links.Next != nil || *page.Links.Previous != "/api/rbac/v1/roles/"+roleId+"/access/?limit=2&offset=0" {
+		t.Errorf("unexpected links: %+v", page.Links)
+	}
+
+	p.BasePath = "/rbac"
+	resp, err = p.GetRoleAccess(context.Background(), api.GetRoleAccessRequestObject{
+		Uuid:   uuid.MustParse(roleId),
+		Params: api.GetRoleAccessParams{Limit: &limit, Offset: &offset},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if links := resp.(api.GetRoleAccess200JSONResponse).Links; *links.First != "/rbac/roles/"+roleId+"/access/?limit=2&offset=0" {
+		t.Errorf("expected links under the base path served at, got %+v", links)
+	}
+}
+
+func TestGetRoleAccessNotFound(t *testing.T) {
+	p, _ := newTestServer(t)
+
+	resp, err := p.GetRoleAccess(context.Background(), api.GetRoleAccessRequestObject{Uuid: uuid.New()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := resp.(api.GetRoleAccess404JSONResponse); !ok {
+		t.Errorf("expected 404 response, got %T", resp)
+	}
+}
+
+func TestUpdateRole(t *testing.T) {
+	p, spicedb := newTestServer(t, "workspace:ws1#parent@workspace:aspian_root", "workspace:ws1#tenant@organization:aspian")
+
+	created, err := p.CreateRole(context.Background(), api.CreateRoleRequestObject{Body: &api.RoleIn{
+		Name:   "hosts",
+		Access: []api.Access{{Permission: "inventory:hosts:read"}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	roleId := created.(api.CreateRole201JSONResponse).Uuid
+
+	groupId := uuid.New()
+	if _, err := p.AddRoleToGroup(context.Background(), api.AddRoleToGroupRequestObject{Uuid: groupId, Body: &api.GroupRoleIn{Roles: []uuid.UUID{roleId}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := p.UpdateRole(context.Background(), api.UpdateRoleRequestObject{Uuid: roleId, Body: &api.RoleWithAccess{
+		Name: "hosts writers",
+		Access: []api.Access{
+			{Permission: "inventory:hosts:write", ResourceDefinitions: []api.ResourceDefinition{
+				{AttributeFilter: api.ResourceDefinitionFilter{Key: "group.id", Operation: api.Equal, Value: "ws1"}},
+			}},
+		},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := resp.(api.UpdateRole200Response); !ok {
+		t.Fatalf("expected 200 response, got %T", resp)
+	}
+
+	access, err := p.GetRoleAccess(context.Background(), api.GetRoleAccessRequestObject{Uuid: roleId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []api.Access{{Permission: "inventory:hosts:write", ResourceDefinitions: []api.ResourceDefinition{
+		{AttributeFilter: api.ResourceDefinitionFilter{Key: "group.id", Operation: api.Equal, Value: "ws1"}},
+	}}}
+	if data := access.(api.GetRoleAccess200JSONResponse).Data; !reflect.DeepEqual(data, expected) {
+		t.Errorf("unexpected access: %+v", data)
+	}
+
+	// The group bound to the role is bound to the binding the update added
+	tuple := "role_binding:" + roleId.String() + "_ws1#subject@group:" + groupId.String() + "#member"
+	if !slices.Contains(spicedb.tuples(), tuple) {
+		t.Errorf("missing relationship %s", tuple)
+	}
+
+	if name := p.getRoleName(roleId.String()); name != "hosts writers" {
+		t.Errorf("unexpected role name %q", name)
+	}
+
+	if resp, _ := p.UpdateRole(context.Background(), api.UpdateRoleRequestObject{Uuid: uuid.New(), Body: &api.RoleWithAccess{Name: "missing"}}); !isResponse[api.UpdateRole404JSONResponse](resp) {
+		t.Errorf("expected 404 response, got %T", resp)
+	}
+	if resp, _ := p.UpdateRole(context.Background(), api.UpdateRoleRequestObject{Uuid: uuid.MustParse(userAccessAdministratorRole), Body: &api.RoleWithAccess{Name: "admin"}}); !isResponse[api.UpdateRole403JSONResponse](resp) {
+		t.Errorf("expected 403 response for a system role, got %T", resp)
+	}
+}
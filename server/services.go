@@ -0,0 +1,322 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ServiceMappingSource loads the Services mapping of RBAC v1 permissions to the schema, validated.
+type ServiceMappingSource interface {
+	Load() (Services, error)
+}
+
+// ServicesFile is a single JSON file mapping every application, e.g. services.json:
+//
+//	{"playbook-dispatcher": {"run:read": {"permission": "dispatcher_view_runs", "filter": {...}}}}
+type ServicesFile struct {
+	Path string
+}
+
+func (s *ServicesFile) Load() (Services, error) {
+	var services Services
+	if err := decodeServicesFile(s.Path, &services); err != nil {
+		return nil, err
+	}
+
+	if err := validateServices(services); err != nil {
+		return nil, fmt.Errorf("%s: %w", s.Path, err)
+	}
+
+	return services, nil
+}
+
+// ServicesDirectory is a directory of one JSON file per application, named after the application, e.g.
+// playbook-dispatcher.json holding {"run:read": {...}}, so that each application's team can own its file. Files not
+// ending in .json, and hidden files, are ignored.
+type ServicesDirectory struct {
+	Path string
+}
+
+func (s *ServicesDirectory) Load() (Services, error) {
+	return loadServicesDirectory(s.Path)
+}
+
+// ConfigMapServices is a Kubernetes ConfigMap mounted as a directory, with a key per application as ServicesDirectory
+// has a file per application. Kubernetes updates a mounted ConfigMap by swapping the ..data link to a new snapshot of
+// every key, so the keys are read through that link, to see either all of an update or none of it.
+type ConfigMapServices struct {
+	Path string
+}
+
+func (s *ConfigMapServices) Load() (Services, error) {
+	snapshot := filepath.Join(s.Path, "..data")
+	if _, err := os.Stat(snapshot); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		snapshot = s.Path // not mounted by Kubernetes, e.g. in development
+	}
+
+	return loadServicesDirectory(snapshot)
+}
+
+// LayeredServices combines sources, an application mapped by a later source overriding its mapping by earlier ones
+// entirely, e.g. a base services.json overridden per application by a directory its teams own.
+type LayeredServices []ServiceMappingSource
+
+func (s LayeredServices) Load() (Services, error) {
+	services := make(Services)
+
+	for _, source := range s {
+		layer, err := source.Load()
+		if err != nil {
+			return nil, err
+		}
+
+		for application, permissions := range layer {
+			services[application] = permissions
+		}
+	}
+
+	return services, nil
+}
+
+func loadServicesDirectory(path string) (Services, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	services := make(Services)
+	var errs []error
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, ".") || filepath.Ext(name) != ".json" {
+			continue
+		}
+
+		// ConfigMap keys are links to the files of the current snapshot, so stat follows them
+		file := filepath.Join(path, name)
+		if info, err := os.Stat(file); err != nil {
+			return nil, err
+		} else if info.IsDir() {
+			continue
+		}
+
+		application := strings.TrimSuffix(name, ".json")
+
+		var permissions Permission
+		if err := decodeServicesFile(file, &permissions); err != nil {
+			return nil, err
+		}
+		if err := validateServices(Services{application: permissions}); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", file, err))
+			continue
+		}
+
+		services[application] = permissions
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return services, nil
+}
+
+// decodeServicesFile decodes a JSON file, refusing fields the mapping does not have, so that a misspelt field is an
+// error rather than a permission silently missing its filter.
+func decodeServicesFile(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return nil
+}
+
+var (
+	// permissionKeyPattern matches the resource:verb part of an RBAC v1 permission, e.g. run:read
+	permissionKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_*-]+:[A-Za-z0-9_*-]+$`)
+	// schemaIdentifierPattern matches SpiceDB relation and permission names
+	schemaIdentifierPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,62}[a-z0-9]$`)
+	// schemaTypePattern matches SpiceDB definition names, with or without a namespace, e.g. dispatcher/service
+	schemaTypePattern = regexp.MustCompile(`^([a-z][a-z0-9_]{1,62}[a-z0-9]/)?[a-z][a-z0-9_]{1,62}[a-z0-9]$`)
+)
+
+// validateServices checks that every permission of a mapping names a schema permission, and that each of its filters
+// is complete and uses an operator this server can answer with. It returns every problem found, not only the first.
+func validateServices(services Services) error {
+	var errs []error
+
+	applications := make([]string, 0, len(services))
+	for application := range services {
+		applications = append(applications, application)
+	}
+	sort.Strings(applications)
+
+	for _, application := range applications {
+		if application == "" || strings.Contains(application, ":") {
+			errs = append(errs, fmt.Errorf("invalid application name %q", application))
+			continue
+		}
+
+		keys := make([]string, 0, len(services[application]))
+		for key := range services[application] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			permission := application + ":" + key
+			if !permissionKeyPattern.MatchString(key) {
+				errs = append(errs, fmt.Errorf("%s: permission must be of the form <application>:<resource>:<verb>", permission))
+				continue
+			}
+
+			resourcePerm := services[application][key]
+			if !schemaIdentifierPattern.MatchString(resourcePerm.Permission) {
+				errs = append(errs, fmt.Errorf("%s: invalid schema permission %q", permission, resourcePerm.Permission))
+			}
+
+			for i, filter := range resourcePerm.filters() {
+				if err := validateFilter(filter); err != nil {
+					errs = append(errs, fmt.Errorf("%s: filter %d: %w", permission, i, err))
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateFilter(filter Filter) error {
+	switch {
+	case filter.Name == "":
+		return errors.New("name is required")
+	case !strings.EqualFold(filter.Operator, "equal"):
+		return fmt.Errorf("unsupported operator %q, expected equal", filter.Operator)
+	case !schemaTypePattern.MatchString(filter.ResourceType):
+		return fmt.Errorf("invalid resource type %q", filter.ResourceType)
+	case !schemaIdentifierPattern.MatchString(filter.Verb):
+		return fmt.Errorf("invalid verb %q", filter.Verb)
+	}
+
+	return nil
+}
+
+// filters lists the attribute filters of a permission: those under filters, and the one under filter, if any.
+func (r ResourcePerm) filters() []Filter {
+	if r.Filter == (Filter{}) {
+		return r.Filters
+	}
+
+	return append([]Filter{r.Filter}, r.Filters...)
+}
+
+// DefaultServicesReloadInterval is how often a ServicesReloader looks for changes to the services mapping.
+const DefaultServicesReloadInterval = 30 * time.Second
+
+// services returns the services mapping in use. Handlers read it concurrently with a ServicesReloader replacing it, so
+// the map returned is never changed, only replaced.
+func (p *PrbacSpicedbServer) services() Services {
+	p.servicesMu.RLock()
+	defer p.servicesMu.RUnlock()
+
+	return p.RbacServices
+}
+
+func (p *PrbacSpicedbServer) servicesVersionInUse() string {
+	p.servicesMu.RLock()
+	defer p.servicesMu.RUnlock()
+
+	return p.servicesVersion
+}
+
+// SetRbacServices replaces the services mapping in use, recording its version for GetStatus.
+func (p *PrbacSpicedbServer) SetRbacServices(services Services, version string) {
+	p.servicesMu.Lock()
+	defer p.servicesMu.Unlock()
+
+	p.RbacServices = services
+	p.servicesVersion = version
+	p.servicesLoaded = time.Now().UTC()
+}
+
+// ServicesVersion identifies a services mapping by its content, so that reloading an unchanged mapping is recognized
+// whichever files it came from.
+func ServicesVersion(services Services) string {
+	data, err := json.Marshal(services) // map keys are sorted, so equal mappings encode alike
+	if err != nil {
+		panic(err) // a Services always encodes
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:6])
+}
+
+// ServicesReloader keeps a server's services mapping up to date with its source, by loading it again every Interval.
+// A mapping that fails to load or validate is reported and the one in use is kept, so a bad edit never takes effect.
+type ServicesReloader struct {
+	Server   *PrbacSpicedbServer
+	Source   ServiceMappingSource
+	Interval time.Duration
+}
+
+// Reload loads the mapping and swaps it in if it has changed. It returns whether it did.
+func (r *ServicesReloader) Reload() (bool, error) {
+	services, err := r.Source.Load()
+	if err != nil {
+		return false, err
+	}
+
+	version := ServicesVersion(services)
+	if version == r.Server.servicesVersionInUse() {
+		return false, nil
+	}
+
+	r.Server.SetRbacServices(services, version)
+	return true, nil
+}
+
+// Run reloads the mapping until ctx is done, which is not reported as an error.
+func (r *ServicesReloader) Run(ctx context.Context) error {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = DefaultServicesReloadInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if reloaded, err := r.Reload(); err != nil {
+			fmt.Printf("[ERROR] services mapping not reloaded, keeping version %s: %v\n", r.Server.servicesVersionInUse(), err)
+		} else if reloaded {
+			fmt.Printf("[INFO] Reloaded services mapping, now version %s\n", r.Server.servicesVersionInUse())
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+const dispatcherServices = `{"run:read": {"permission": "dispatcher_view_runs", "filter": {"name": "service", "operator": "equal", "resourceType": "dispatcher/service", "verb": "view"}}}`
+
+func writeServicesFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestServicesFile(t *testing.T) {
+	services, err := (&ServicesFile{Path: "../services.json"}).Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if services["playbook-dispatcher"]["run:read"].Permission != "dispatcher_view_runs" {
+		t.Errorf("unexpected services %+v", services)
+	}
+}
+
+func TestServicesValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		mapping string
+		invalid string // part of the expected error, empty if the mapping is valid
+	}{
+		{"filter", `{"app": {"run:read": {"permission": "app_run_read", "filter": {"name": "service", "operator": "equal", "resourceType": "app/service", "verb": "view"}}}}`, ""},
+		{"filters", `{"app": {"run:read": {"permission": "app_run_read", "filters": [
+			{"name": "service", "operator": "equal", "resourceType": "app/service", "verb": "view"},
+			{"name": "group.id", "operator": "equal", "resourceType": "workspace", "verb": "app_run_read"}
+		]}}}`, ""},
+		{"no filter", `{"app": {"run:read": {"permission": "app_run_read"}}}`, ""},
+		{"unknown field", `{"app": {"run:read": {"permission": "app_run_read", "filtre": {}}}}`, "unknown field"},
+		{"permission key", `{"app": {"run": {"permission": "app_run_read"}}}`, "app:run: permission must be of the form"},
+		{"schema permission", `{"app": {"run:read": {"permission": "app:run:read"}}}`, "invalid schema permission"},
+		{"operator", `{"app": {"run:read": {"permission": "app_run_read", "filter": {"name": "service", "operator": "in", "resourceType": "app/service", "verb": "view"}}}}`, `unsupported operator "in"`},
+		{"second filter", `{"app": {"run:read": {"permission": "app_run_read", "filter": {"name": "service", "operator": "equal", "resourceType": "app/service", "verb": "view"}, "filters": [{"operator": "equal", "resourceType": "app/service", "verb": "view"}]}}}`, "filter 1: name is required"},
+		{"resource type", `{"app": {"run:read": {"permission": "app_run_read", "filter": {"name": "service", "operator": "equal", "resourceType": "App Service", "verb": "view"}}}}`, "invalid resource type"},
+	}
+
+	for _, test := range tests {
+		path := filepath.Join(t.TempDir(), "services.json")
+		writeServicesFiles(t, filepath.Dir(path), map[string]string{"services.json": test.mapping})
+
+		_, err := (&ServicesFile{Path: path}).Load()
+		switch {
+		case test.invalid == "" && err != nil:
+			t.Errorf("%s: expected the mapping to be valid, got %v", test.name, err)
+		case test.invalid != "" && (err == nil || !strings.Contains(err.Error(), test.invalid)):
+			t.Errorf("%s: expected an error about %q, got %v", test.name, test.invalid, err)
+		}
+	}
+}
+
+func TestServicesDirectoryOverrides(t *testing.T) {
+	base := filepath.Join(t.TempDir(), "services.json")
+	writeServicesFiles(t, filepath.Dir(base), map[string]string{"services.json": `{
+		"playbook-dispatcher": {"run:write": {"permission": "dispatcher_write_runs"}},
+		"inventory": {"hosts:read": {"permission": "inventory_hosts_read"}}
+	}`})
+
+	dir := t.TempDir()
+	writeServicesFiles(t, dir, map[string]string{
+		"playbook-dispatcher.json": dispatcherServices,
+		".hidden.json":             `not json`,
+		"README.md":                `Each team owns the file named after its application.`,
+	})
+
+	services, err := LayeredServices{&ServicesFile{Path: base}, &ServicesDirectory{Path: dir}}.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(services) != 2 || len(services["inventory"]) != 1 {
+		t.Errorf("expected the applications of both sources, got %+v", services)
+	}
+	if dispatcher := services["playbook-dispatcher"]; len(dispatcher) != 1 || dispatcher["run:read"].Filter.Verb != "view" {
+		t.Errorf("expected the directory to override the application, got %+v", dispatcher)
+	}
+
+	writeServicesFiles(t, dir, map[string]string{"approval.json": `{"requests:read": {"permission": "approval requests"}}`})
+	if _, err := (&ServicesDirectory{Path: dir}).Load(); err == nil || !strings.Contains(err.Error(), "approval.json") {
+		t.Errorf("expected an error naming the invalid file, got %v", err)
+	}
+}
+
+func TestConfigMapServices(t *testing.T) {
+	// Laid out as the kubelet mounts a ConfigMap: keys linking to a timestamped snapshot through ..data
+	dir := t.TempDir()
+	snapshot := filepath.Join(dir, "..2024_03_01_12_00_00.000000001")
+	if err := os.Mkdir(snapshot, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	writeServicesFiles(t, snapshot, map[string]string{"playbook-dispatcher.json": dispatcherServices})
+	if err := os.Symlink(filepath.Base(snapshot), filepath.Join(dir, "..data")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join("..data", "playbook-dispatcher.json"), filepath.Join(dir, "playbook-dispatcher.json")); err != nil {
+		t.Fatal(err)
+	}
+
+	services, err := (&ConfigMapServices{Path: dir}).Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(services) != 1 || services["playbook-dispatcher"]["run:read"].Permission != "dispatcher_view_runs" {
+		t.Errorf("unexpected services %+v", services)
+	}
+}
+
+func TestGetPrincipalAccessWithFilters(t *testing.T) {
+	spicedb := newFakeSpiceDB(t)
+	spicedb.permissions = []string{
+		"dispatcher/service:remediations#view@user:alice",
+		"workspace:ws1#dispatcher_view_runs@user:alice",
+	}
+	p := &PrbacSpicedbServer{RbacServices: Services{"playbook-dispatcher": Permission{"run:read": ResourcePerm{
+		Permission: "dispatcher_view_runs",
+		Filters: []Filter{
+			{Name: "service", Operator: "equal", ResourceType: "dispatcher/service", Verb: "view"},
+			{Name: "group.id", Operator: "equal", ResourceType: "workspace", Verb: "dispatcher_view_runs"},
+		},
+	}}}, SpicedbClient: spicedb.client(), Metadata: NewInMemoryMetadataStore()}
+
+	username := "alice"
+	resp, err := p.GetPrincipalAccess(context.Background(), api.GetPrincipalAccessRequestObject{
+		Params: api.GetPrincipalAccessParams{Application: "playbook-dispatcher", Username: &username},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := resp.(api.GetPrincipalAccess200JSONResponse).Data
+	if len(data) != 2 {
+		t.Fatalf("expected an access for each filter, got %+v", data)
+	}
+	for i, expected := range []api.ResourceDefinitionFilter{
+		{Key: "service", Operation: "equal", Value: "remediations"},
+		{Key: "group.id", Operation: "equal", Value: "ws1"},
+	} {
+		if definitions := data[i].ResourceDefinitions; len(definitions) != 1 || definitions[0].AttributeFilter != expected {
+			t.Errorf("expected access %d to be filtered by %+v, got %+v", i, expected, data[i])
+		}
+	}
+}
+
+func TestServicesReloader(t *testing.T) {
+	dir := t.TempDir()
+	writeServicesFiles(t, dir, map[string]string{"playbook-dispatcher.json": dispatcherServices})
+
+	p := &PrbacSpicedbServer{Metadata: NewInMemoryMetadataStore()}
+	reloader := &ServicesReloader{Server: p, Source: &ServicesDirectory{Path: dir}}
+
+	status := func() api.Status {
+		resp, err := p.GetStatus(context.Background(), api.GetStatusRequestObject{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return api.Status(resp.(api.GetStatus200JSONResponse))
+	}
+
+	if reloaded, err := reloader.Reload(); err != nil || !reloaded {
+		t.Fatalf("expected the first load to be swapped in, got %t %v", reloaded, err)
+	}
+	first := status()
+	if first.ApiVersion != 1 || first.ServicesVersion == nil || *first.ServicesVersion != ServicesVersion(p.services()) || first.ServicesLoaded == nil {
+		t.Fatalf("unexpected status %+v", first)
+	}
+
+	if reloaded, err := reloader.Reload(); err != nil || reloaded {
+		t.Errorf("expected an unchanged mapping not to be swapped in, got %t %v", reloaded, err)
+	}
+
+	writeServicesFiles(t, dir, map[string]string{"approval.json": `{"requests:read": {"permission": "approval_requests_read", "filter": {"operator": "equal"}}}`})
+	if _, err := reloader.Reload(); err == nil {
+		t.Fatal("expected the invalid mapping to fail to load")
+	}
+	if _, ok := p.services()["approval"]; ok || *status().ServicesVersion != *first.ServicesVersion {
+		t.Errorf("expected the mapping in use to be kept after a failed reload")
+	}
+
+	writeServicesFiles(t, dir, map[string]string{"approval.json": `{"requests:read": {"permission": "approval_requests_read"}}`})
+	if reloaded, err := reloader.Reload(); err != nil || !reloaded {
+		t.Fatalf("expected the fixed mapping to be swapped in, got %t %v", reloaded, err)
+	}
+	if _, ok := p.services()["approval"]; !ok || *status().ServicesVersion == *first.ServicesVersion {
+		t.Errorf("expected the new mapping and version to be in use, got %+v", status())
+	}
+}
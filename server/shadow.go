@@ -0,0 +1,225 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+// DefaultShadowTimeout is how long the legacy RBAC service is given to answer a shadowed read.
+const DefaultShadowTimeout = 5 * time.Second
+
+// ShadowPrimary names the backend whose answers a ShadowServer returns.
+type ShadowPrimary string
+
+const (
+	ShadowPrimaryPrbac ShadowPrimary = "prbac"
+	ShadowPrimaryRbac  ShadowPrimary = "rbac"
+)
+
+// ShadowServer answers reads from both this service and the legacy RBAC service, returns the primary's answer, and
+// records any difference between the two. Everything it does not shadow is served by this service alone. When this
+// service is primary, its answer is returned at once and the legacy service is read and compared with it afterwards, so
+// that a slow legacy service slows no one down.
+type ShadowServer struct {
+	api.StrictServerInterface
+
+	Rbac     *RbacClient
+	Primary  ShadowPrimary
+	Recorder MismatchRecorder
+	Timeout  time.Duration // for the legacy service to answer in, DefaultShadowTimeout if 0
+
+	comparisons sync.WaitGroup
+}
+
+// ShadowMismatch describes where this service and the legacy RBAC service disagreed. Access is compared in the
+// normalised form of normalizeAccesses.
+type ShadowMismatch struct {
+	Operation   string            `json:"operation"`
+	OrgId       string            `json:"org_id"`
+	Username    string            `json:"username,omitempty"`
+	Params      map[string]string `json:"params,omitempty"`
+	OnlyInPrbac []string          `json:"only_in_prbac,omitempty"`
+	OnlyInRbac  []string          `json:"only_in_rbac,omitempty"`
+	PrbacError  string            `json:"prbac_error,omitempty"`
+	RbacError   string            `json:"rbac_error,omitempty"`
+}
+
+// MismatchRecorder keeps the mismatches a ShadowServer finds.
+type MismatchRecorder interface {
+	RecordMismatch(mismatch ShadowMismatch)
+}
+
+// LogMismatchRecorder writes mismatches to stdout as JSON.
+type LogMismatchRecorder struct{}
+
+func (LogMismatchRecorder) RecordMismatch(mismatch ShadowMismatch) {
+	encoded, _ := json.Marshal(mismatch)
+	fmt.Printf("[WARN] shadow mismatch: %s\n", encoded)
+}
+
+// NewShadowServer shadows prbac with the legacy RBAC service at rbacURL, logging mismatches.
+func NewShadowServer(prbac api.StrictServerInterface, rbacURL string, primary ShadowPrimary) (*ShadowServer, error) {
+	if primary != ShadowPrimaryPrbac && primary != ShadowPrimaryRbac {
+		return nil, fmt.Errorf("unknown shadow primary %q, expected %q or %q", primary, ShadowPrimaryPrbac, ShadowPrimaryRbac)
+	}
+
+	return &ShadowServer{
+		StrictServerInterface: prbac,
+		Rbac:                  &RbacClient{BaseURL: rbacURL},
+		Primary:               primary,
+		Recorder:              LogMismatchRecorder{},
+	}, nil
+}
+
+// Wait waits for comparisons under way to be recorded.
+func (s *ShadowServer) Wait() {
+	s.comparisons.Wait()
+}
+
+func (s *ShadowServer) timeout() time.Duration {
+	if s.Timeout <= 0 {
+		return DefaultShadowTimeout
+	}
+	return s.Timeout
+}
+
+func (s *ShadowServer) GetPrincipalAccess(ctx context.Context, request api.GetPrincipalAccessRequestObject) (api.GetPrincipalAccessResponseObject, error) {
+	if s.Primary == ShadowPrimaryPrbac {
+		resp, err := s.StrictServerInterface.GetPrincipalAccess(ctx, request)
+
+		// The legacy service is read once the caller has been answered, so its context must outlive the request's
+		s.comparisons.Add(1)
+		go func() {
+			defer s.comparisons.Done()
+
+			rbacCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.timeout())
+			defer cancel()
+
+			accesses, rbacErr := s.Rbac.GetPrincipalAccess(rbacCtx, request.Params)
+			s.compareAccess(ctx, request, resp, err, accesses, rbacErr)
+		}()
+
+		return resp, err
+	}
+
+	type prbacResult struct {
+		resp api.GetPrincipalAccessResponseObject
+		err  error
+	}
+
+	// This service is read alongside the legacy one rather than after it, so shadowing adds as little latency as it can
+	prbacDone := make(chan prbacResult, 1)
+	go func() {
+		resp, err := s.StrictServerInterface.GetPrincipalAccess(ctx, request)
+		prbacDone <- prbacResult{resp, err}
+	}()
+
+	rbacCtx, cancel := context.WithTimeout(ctx, s.timeout())
+	defer cancel()
+
+	accesses, rbacErr := s.Rbac.GetPrincipalAccess(rbacCtx, request.Params)
+	prbac := <-prbacDone
+	s.compareAccess(ctx, request, prbac.resp, prbac.err, accesses, rbacErr)
+
+	if rbacErr != nil {
+		return api.GetPrincipalAccess500JSONResponse(errorBody(500, rbacErr.Error())), nil
+	}
+
+	count := int64(len(accesses))
+	return api.GetPrincipalAccess200JSONResponse{Data: accesses, Meta: &api.PaginationMeta{Count: &count}}, nil
+}
+
+// compareAccess records where this service's answer to an access query, resp or err, differs from the legacy service's.
+func (s *ShadowServer) compareAccess(ctx context.Context, request api.GetPrincipalAccessRequestObject, resp api.GetPrincipalAccessResponseObject, err error, rbacAccesses []api.Access, rbacErr error) {
+	mismatch := ShadowMismatch{
+		Operation: "getPrincipalAccess",
+		OrgId:     getUserOrg(ctx),
+		Username:  getPrincipalUsername(ctx, request.Params.Username),
+		Params:    map[string]string{"application": request.Params.Application},
+	}
+
+	var prbacAccesses []api.Access
+	switch r := resp.(type) {
+	case api.GetPrincipalAccess200JSONResponse:
+		prbacAccesses = r.Data
+	default:
+		mismatch.PrbacError = fmt.Sprintf("%T", resp)
+		if err != nil {
+			mismatch.PrbacError = err.Error()
+		}
+	}
+	if rbacErr != nil {
+		mismatch.RbacError = rbacErr.Error()
+	}
+
+	if mismatch.PrbacError == "" && mismatch.RbacError == "" {
+		mismatch.OnlyInPrbac, mismatch.OnlyInRbac = diffAccesses(prbacAccesses, rbacAccesses)
+	}
+	if mismatch.PrbacError != "" || mismatch.RbacError != "" || len(mismatch.OnlyInPrbac) != 0 || len(mismatch.OnlyInRbac) != 0 {
+		s.Recorder.RecordMismatch(mismatch)
+	}
+}
+
+// diffAccesses compares two Access lists in normalised form, returning what is only in the first and only in the second.
+func diffAccesses(a, b []api.Access) ([]string, []string) {
+	normalizedA, normalizedB := normalizeAccesses(a), normalizeAccesses(b)
+
+	var onlyA, onlyB []string
+	for entry := range normalizedA {
+		if !normalizedB[entry] {
+			onlyA = append(onlyA, entry)
+		}
+	}
+	for entry := range normalizedB {
+		if !normalizedA[entry] {
+			onlyB = append(onlyB, entry)
+		}
+	}
+	sort.Strings(onlyA)
+	sort.Strings(onlyB)
+
+	return onlyA, onlyB
+}
+
+// normalizeAccesses reduces an Access list to what it grants, so that lists granting the same are equal however they
+// are ordered and grouped: a permission without resource definitions is granted unrestricted, as "inventory:hosts:read",
+// and overrides any restricted grants of it; otherwise each value it is granted for is one entry, as
+// "inventory:hosts:read group.id=ws1", whether it came from an equal filter or from the list of values of an in filter.
+func normalizeAccesses(accesses []api.Access) map[string]bool {
+	unrestricted := make(map[string]bool)
+	for _, access := range accesses {
+		if len(access.ResourceDefinitions) == 0 {
+			unrestricted[access.Permission] = true
+		}
+	}
+
+	normalized := make(map[string]bool)
+	for _, access := range accesses {
+		if unrestricted[access.Permission] {
+			normalized[access.Permission] = true
+			continue
+		}
+
+		for _, definition := range access.ResourceDefinitions {
+			filter := definition.AttributeFilter
+
+			values := []string{filter.Value}
+			if strings.EqualFold(string(filter.Operation), string(api.In)) {
+				values = strings.Split(filter.Value, ",")
+			}
+
+			for _, value := range values {
+				normalized[fmt.Sprintf("%s %s=%s", access.Permission, filter.Key, strings.TrimSpace(value))] = true
+			}
+		}
+	}
+
+	return normalized
+}
@@ -0,0 +1,218 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+type recordingMismatchRecorder struct {
+	mismatches []ShadowMismatch
+}
+
+func (r *recordingMismatchRecorder) RecordMismatch(mismatch ShadowMismatch) {
+	r.mismatches = append(r.mismatches, mismatch)
+}
+
+// newShadowTestServer shadows a server granting alice playbook-dispatcher:run:read on the remediations and
+// config-manager services with the given fake of the legacy RBAC service.
+func newShadowTestServer(t *testing.T, rbac *fakeRbac, primary ShadowPrimary) (*ShadowServer, *recordingMismatchRecorder) {
+	t.Helper()
+
+	prbac, spicedb := newTestServer(t)
+	spicedb.permissions = []string{
+		"dispatcher/service:remediations#view@user:alice",
+		"dispatcher/service:config-manager#view@user:alice",
+	}
+	prbac.RbacServices = testServices
+
+	shadow, err := NewShadowServer(prbac, rbac.url(), primary)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := &recordingMismatchRecorder{}
+	shadow.Recorder = recorder
+
+	return shadow, recorder
+}
+
+// getShadowedAccess reads alice's access through the shadow server, once any comparison is recorded.
+func getShadowedAccess(t *testing.T, shadow *ShadowServer) []api.Access {
+	t.Helper()
+
+	accesses := requestShadowedAccess(t, shadow)
+	shadow.Wait()
+
+	return accesses
+}
+
+func requestShadowedAccess(t *testing.T, shadow *ShadowServer) []api.Access {
+	t.Helper()
+
+	// parsed from a header, for the legacy RBAC service to be passed it
+	header := base64.StdEncoding.EncodeToString([]byte(`{"identity": {"org_id": "acme", "user": {"username": "alice"}}}`))
+	identity, err := parseIdentity(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.WithValue(context.Background(), identityContextKey{}, identity)
+
+	resp, err := shadow.GetPrincipalAccess(ctx, api.GetPrincipalAccessRequestObject{
+		Params: api.GetPrincipalAccessParams{Application: "playbook-dispatcher"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	page, ok := resp.(api.GetPrincipalAccess200JSONResponse)
+	if !ok {
+		t.Fatalf("expected 200, got %T", resp)
+	}
+
+	return page.Data
+}
+
+func TestShadowMatchingAccess(t *testing.T) {
+	rbac := newFakeRbac(t)
+	// The same grants as this service's, split across roles, with one granted twice and in a list value
+	rbac.grant("alice",
+		rbacAccessJSON("playbook-dispatcher:run:read", `{"key": "service", "operation": "in", "value": ["config-manager", "remediations"]}`),
+		rbacAccessJSON("playbook-dispatcher:run:read", `{"key": "service", "operation": "equal", "value": "remediations"}`),
+	)
+	shadow, recorder := newShadowTestServer(t, rbac, ShadowPrimaryPrbac)
+
+	getShadowedAccess(t, shadow)
+
+	if len(recorder.mismatches) != 0 {
+		t.Errorf("unexpected mismatches: %+v", recorder.mismatches)
+	}
+	if len(rbac.headers) != 1 || rbac.headers[0].Get(identityHeader) == "" {
+		t.Errorf("identity header not passed on to the legacy RBAC service")
+	}
+}
+
+func TestShadowMismatchReturnsPrimary(t *testing.T) {
+	rbac := newFakeRbac(t)
+	rbac.grant("alice",
+		rbacAccessJSON("playbook-dispatcher:run:read", `{"key": "service", "operation": "equal", "value": "remediations"}`),
+		rbacAccessJSON("playbook-dispatcher:run:write"),
+	)
+	shadow, recorder := newShadowTestServer(t, rbac, ShadowPrimaryPrbac)
+
+	accesses := getShadowedAccess(t, shadow)
+	if len(accesses) != 1 || len(accesses[0].ResourceDefinitions) != 2 {
+		t.Errorf("expected this service's answer, got %+v", accesses)
+	}
+
+	expected := ShadowMismatch{
+		Operation:   "getPrincipalAccess",
+		OrgId:       "acme",
+		Username:    "alice",
+		Params:      map[string]string{"application": "playbook-dispatcher"},
+		OnlyInPrbac: []string{"playbook-dispatcher:run:read service=config-manager"},
+		OnlyInRbac:  []string{"playbook-dispatcher:run:write"},
+	}
+	if len(recorder.mismatches) != 1 || !reflect.DeepEqual(recorder.mismatches[0], expected) {
+		t.Errorf("unexpected mismatches: %+v", recorder.mismatches)
+	}
+}
+
+func TestShadowRbacPrimary(t *testing.T) {
+	rbac := newFakeRbac(t)
+	rbac.pageSize = 1
+	rbac.grant("alice",
+		rbacAccessJSON("playbook-dispatcher:run:read", `{"key": "service", "operation": "equal", "value": "remediations"}`),
+		rbacAccessJSON("playbook-dispatcher:run:read", `{"key": "service", "operation": "equal", "value": "config-manager"}`),
+		rbacAccessJSON("playbook-dispatcher:run:write"),
+	)
+	shadow, recorder := newShadowTestServer(t, rbac, ShadowPrimaryRbac)
+
+	accesses := getShadowedAccess(t, shadow)
+	if len(accesses) != 3 || accesses[2].Permission != "playbook-dispatcher:run:write" {
+		t.Errorf("expected the legacy service's answer across all its pages, got %+v", accesses)
+	}
+	if len(rbac.headers) != 3 {
+		t.Errorf("expected 3 pages to be read, got %d", len(rbac.headers))
+	}
+	if len(recorder.mismatches) != 1 || !reflect.DeepEqual(recorder.mismatches[0].OnlyInRbac, []string{"playbook-dispatcher:run:write"}) {
+		t.Errorf("unexpected mismatches: %+v", recorder.mismatches)
+	}
+}
+
+func TestShadowRbacUnavailable(t *testing.T) {
+	rbac := newFakeRbac(t)
+	shadow, recorder := newShadowTestServer(t, rbac, ShadowPrimaryPrbac)
+	rbac.server.Close()
+
+	if accesses := getShadowedAccess(t, shadow); len(accesses) != 1 {
+		t.Errorf("expected this service's answer, got %+v", accesses)
+	}
+	if len(recorder.mismatches) != 1 || recorder.mismatches[0].RbacError == "" {
+		t.Errorf("unexpected mismatches: %+v", recorder.mismatches)
+	}
+}
+
+func TestShadowRbacSlow(t *testing.T) {
+	rbac := newFakeRbac(t)
+	rbac.hang = make(chan struct{})
+	shadow, recorder := newShadowTestServer(t, rbac, ShadowPrimaryPrbac)
+	shadow.Timeout = time.Minute
+
+	// Answered while the legacy service has yet to, and compared once it has
+	if accesses := requestShadowedAccess(t, shadow); len(accesses) != 1 {
+		t.Errorf("expected this service's answer, got %+v", accesses)
+	}
+	close(rbac.hang)
+	shadow.Wait()
+
+	if len(recorder.mismatches) != 1 || len(recorder.mismatches[0].OnlyInPrbac) != 2 {
+		t.Errorf("unexpected mismatches: %+v", recorder.mismatches)
+	}
+}
+
+func TestShadowRbacPrimaryTimeout(t *testing.T) {
+	rbac := newFakeRbac(t)
+	rbac.hang = make(chan struct{})
+	shadow, recorder := newShadowTestServer(t, rbac, ShadowPrimaryRbac)
+	shadow.Timeout = 10 * time.Millisecond
+
+	resp, err := shadow.GetPrincipalAccess(withIdentity(context.Background(), "acme", "alice", false), api.GetPrincipalAccessRequestObject{
+		Params: api.GetPrincipalAccessParams{Application: "playbook-dispatcher"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := resp.(api.GetPrincipalAccess500JSONResponse); !ok {
+		t.Errorf("expected 500 once the legacy service times out, got %T", resp)
+	}
+	if len(recorder.mismatches) != 1 || !strings.Contains(recorder.mismatches[0].RbacError, "deadline exceeded") {
+		t.Errorf("unexpected mismatches: %+v", recorder.mismatches)
+	}
+}
+
+func TestNormalizeAccesses(t *testing.T) {
+	normalized := normalizeAccesses([]api.Access{
+		{Permission: "inventory:hosts:read", ResourceDefinitions: []api.ResourceDefinition{
+			{AttributeFilter: api.ResourceDefinitionFilter{Key: "group.id", Operation: api.In, Value: "ws1, ws2"}},
+		}},
+		{Permission: "inventory:groups:read", ResourceDefinitions: []api.ResourceDefinition{
+			{AttributeFilter: api.ResourceDefinitionFilter{Key: "group.id", Operation: api.Equal, Value: "ws1"}},
+		}},
+		{Permission: "inventory:groups:read", ResourceDefinitions: []api.ResourceDefinition{}},
+	})
+
+	expected := map[string]bool{
+		"inventory:hosts:read group.id=ws1": true,
+		"inventory:hosts:read group.id=ws2": true,
+		"inventory:groups:read":             true,
+	}
+	if !reflect.DeepEqual(normalized, expected) {
+		t.Errorf("unexpected normalized access: %v", normalized)
+	}
+}
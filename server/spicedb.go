@@ -1,22 +1,299 @@
 package server
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
 	"github.com/authzed/authzed-go/v1"
 	"github.com/authzed/grpcutil"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// requestDebugInfoHeader asks SpiceDB to trace a check, and debugInfoTrailer carries the trace back, as used by
+	// `zed permission check --explain`
+	requestDebugInfoHeader = "io.spicedb.requestdebuginfo"
+	debugInfoTrailer       = "io.spicedb.respmeta.debuginfo"
 )
 
-func GetSpiceDbClient(endpoint string, presharedKey string) (*authzed.Client, error) {
-	var opts []grpc.DialOption
+// DefaultDialTimeout is how long GetSpiceDbClient waits for SpiceDB to answer before giving up.
+const DefaultDialTimeout = 10 * time.Second
+
+// SpiceDBConfig is how to reach SpiceDB. TLS is used when TLS is set or when a CA bundle or client certificate is
+// given, verifying SpiceDB against CAFile, or the system's CAs without one.
+type SpiceDBConfig struct {
+	Endpoint    string          `yaml:"endpoint"`
+	Token       string          `yaml:"token"`      // the preshared key
+	TokenFile   string          `yaml:"token_file"` // read into Token, e.g. from a mounted secret
+	TLS         bool            `yaml:"tls"`
+	CAFile      string          `yaml:"ca_file"`
+	CertFile    string          `yaml:"cert_file"` // client certificate, for SpiceDB behind mutual TLS
+	KeyFile     string          `yaml:"key_file"`
+	DialTimeout time.Duration   `yaml:"dial_timeout"`
+	Consistency ConsistencyMode `yaml:"consistency"`
+}
+
+func (c SpiceDBConfig) useTLS() bool {
+	return c.TLS || c.CAFile != "" || c.CertFile != ""
+}
+
+// GetSpiceDbClient connects to SpiceDB and waits, up to the config's DialTimeout, for it to answer, so that a
+// misconfigured endpoint or token fails startup rather than the first request. The connection is closed once the
+// client is no longer needed.
+func GetSpiceDbClient(config SpiceDBConfig) (*authzed.Client, *grpc.ClientConn, error) {
+	opts, err := spiceDbDialOptions(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn, err := grpc.Dial(config.Endpoint, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client := &authzed.Client{
+		SchemaServiceClient:      v1.NewSchemaServiceClient(conn),
+		PermissionsServiceClient: v1.NewPermissionsServiceClient(conn),
+		WatchServiceClient:       v1.NewWatchServiceClient(conn),
+	}
+
+	timeout := config.DialTimeout
+	if timeout <= 0 {
+		timeout = DefaultDialTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	// Any answer will do, including that there is no schema yet
+	if _, err := client.ReadSchema(ctx, &v1.ReadSchemaRequest{}, grpc.WaitForReady(true)); err != nil && status.Code(err) != codes.NotFound {
+		conn.Close()
+		return nil, nil, fmt.Errorf("connecting to SpiceDB at %s: %w", config.Endpoint, err)
+	}
+
+	return client, conn, nil
+}
+
+func spiceDbDialOptions(config SpiceDBConfig) ([]grpc.DialOption, error) {
+	opts := []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor(), metricsUnaryInterceptor),
+		grpc.WithChainStreamInterceptor(otelgrpc.StreamClientInterceptor(), metricsStreamInterceptor),
+	}
+
+	if !config.useTLS() {
+		return append(opts,
+			grpcutil.WithInsecureBearerToken(config.Token),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		), nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if config.CAFile != "" {
+		pem, err := os.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%s: no certificates found", config.CAFile)
+		}
+	}
+
+	if config.CertFile != "" || config.KeyFile != "" {
+		if config.CertFile == "" || config.KeyFile == "" {
+			return nil, errors.New("a client certificate needs both a certificate and a key file")
+		}
+
+		certificate, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return append(opts,
+		grpcutil.WithBearerToken(config.Token),
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+	), nil
+}
+
+// readRelationships drains a ReadRelationships stream for the given filter.
+func readRelationships(ctx context.Context, client *authzed.Client, filter *v1.RelationshipFilter) ([]*v1.Relationship, error) {
+	rClient, err := client.ReadRelationships(ctx, &v1.ReadRelationshipsRequest{
+		RelationshipFilter: filter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var relationships []*v1.Relationship
+	for {
+		next, err := rClient.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		relationships = append(relationships, next.GetRelationship())
+	}
+
+	return relationships, nil
+}
+
+// checkPermissionForAnySubject reports whether any of the subjects has the permission on the resource.
+func (p *PrbacSpicedbServer) checkPermissionForAnySubject(ctx context.Context, resource *v1.ObjectReference, permission string, subjects []*v1.SubjectReference) (allowed bool, err error) {
+	ctx, span := startSpan(ctx, "checkPermissionForAnySubject", permissionAttribute.String(permission),
+		resourceAttribute.String(resource.GetObjectType()+":"+resource.GetObjectId()), subjectsAttribute.Int(len(subjects)))
+	defer func() {
+		span.SetAttributes(allowedAttribute.Bool(allowed))
+		endSpan(span, err)
+	}()
+
+	for _, subject := range subjects {
+		r, err := p.SpicedbClient.CheckPermission(ctx, &v1.CheckPermissionRequest{
+			Resource:    resource,
+			Permission:  permission,
+			Subject:     subject,
+			Consistency: p.consistency(),
+		})
+		if err != nil {
+			return false, err
+		}
+
+		if r.Permissionship == v1.CheckPermissionResponse_PERMISSIONSHIP_HAS_PERMISSION {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// checkPermissionWithTrace runs a check with SpiceDB debug tracing enabled. The trace is nil if SpiceDB did not
+// return one.
+func (p *PrbacSpicedbServer) checkPermissionWithTrace(ctx context.Context, request *v1.CheckPermissionRequest) (*v1.CheckPermissionResponse, *v1.DebugInformation, error) {
+	var trailer metadata.MD
+	if request.Consistency == nil {
+		request.Consistency = p.consistency()
+	}
+
+	r, err := p.SpicedbClient.CheckPermission(metadata.AppendToOutgoingContext(ctx, requestDebugInfoHeader, "true"), request, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	encoded := trailer.Get(debugInfoTrailer)
+	if len(encoded) == 0 {
+		return r, nil, nil
+	}
+
+	debugInfo := &v1.DebugInformation{}
+	if err := protojson.Unmarshal([]byte(encoded[0]), debugInfo); err != nil {
+		return nil, nil, err
+	}
+
+	return r, debugInfo, nil
+}
+
+// lookupResourcesForAnySubject returns the ids of resources of the given type that any of the subjects has the
+// permission on, in the order they were first found.
+func (p *PrbacSpicedbServer) lookupResourcesForAnySubject(ctx context.Context, resourceType, permission string, subjects []*v1.SubjectReference) (resources []string, err error) {
+	ctx, span := startSpan(ctx, "lookupResourcesForAnySubject", permissionAttribute.String(permission),
+		resourceAttribute.String(resourceType), subjectsAttribute.Int(len(subjects)))
+	defer func() {
+		span.SetAttributes(resultsAttribute.Int(len(resources)))
+		endSpan(span, err)
+	}()
+
+	seen := make(map[string]bool)
+
+	for _, subject := range subjects {
+		lrClient, err := p.SpicedbClient.LookupResources(ctx, &v1.LookupResourcesRequest{
+			ResourceObjectType: resourceType,
+			Permission:         permission,
+			Subject:            subject,
+			Consistency:        p.consistency(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for {
+			next, err := lrClient.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			if !seen[next.GetResourceObjectId()] {
+				seen[next.GetResourceObjectId()] = true
+				resources = append(resources, next.GetResourceObjectId())
+			}
+		}
+	}
+
+	return resources, nil
+}
+
+// lookupSubjects returns the ids of the subjects of a type that have the permission on the resource. A wildcard
+// result, e.g. user:*, is not included in ids, but reported through wildcard along with the subjects excluded from it.
+func (p *PrbacSpicedbServer) lookupSubjects(ctx context.Context, resource *v1.ObjectReference, permission, subjectType, subjectRelation string) (ids []string, wildcard bool, excluded []string, err error) {
+	ctx, span := startSpan(ctx, "lookupSubjects", permissionAttribute.String(permission),
+		resourceAttribute.String(resource.GetObjectType()+":"+resource.GetObjectId()))
+	defer func() {
+		span.SetAttributes(resultsAttribute.Int(len(ids)))
+		endSpan(span, err)
+	}()
+
+	lsClient, err := p.SpicedbClient.LookupSubjects(ctx, &v1.LookupSubjectsRequest{
+		Resource:                resource,
+		Permission:              permission,
+		SubjectObjectType:       subjectType,
+		OptionalSubjectRelation: subjectRelation,
+		Consistency:             p.consistency(),
+	})
+	if err != nil {
+		return nil, false, nil, err
+	}
+
+	for {
+		next, err := lsClient.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, false, nil, err
+		}
 
-	opts = append(opts, grpc.WithBlock())
+		if next.GetSubject().GetSubjectObjectId() == "*" {
+			wildcard = true
+			for _, subject := range next.GetExcludedSubjects() {
+				excluded = append(excluded, subject.GetSubjectObjectId())
+			}
+			continue
+		}
 
-	opts = append(opts, grpcutil.WithInsecureBearerToken(presharedKey))
-	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		ids = append(ids, next.GetSubject().GetSubjectObjectId())
+	}
 
-	return authzed.NewClient(
-		endpoint,
-		opts...,
-	)
+	return ids, wildcard, excluded, nil
 }
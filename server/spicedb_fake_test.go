@@ -0,0 +1,416 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/authzed/authzed-go/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// fakeSpiceDB is an in-memory stand-in for the SpiceDB permissions service. It stores relationships verbatim and
+// does not evaluate the schema; calls that need schema evaluation are not implemented unless a test provides them.
+type fakeSpiceDB struct {
+	v1.PermissionsServiceClient
+	v1.SchemaServiceClient
+	v1.WatchServiceClient
+
+	mu            sync.Mutex
+	relationships []*v1.Relationship
+	schema        string
+	writes        int                 // WriteRelationships requests made
+	failWrites    int                 // WriteRelationships requests to fail, before writing, from now on
+	revisions     []*v1.WatchResponse // each write, as Watch reports it, changes through revision-<index+1>
+
+	// permissions stands in for schema evaluation: it lists the resource#permission@subject tuples that CheckPermission
+	// and LookupResources report as granted.
+	permissions []string
+}
+
+func newFakeSpiceDB(t *testing.T, tuples ...string) *fakeSpiceDB {
+	t.Helper()
+
+	f := &fakeSpiceDB{}
+	for _, tuple := range tuples {
+		f.relationships = append(f.relationships, parseTuple(t, tuple))
+	}
+
+	return f
+}
+
+func (f *fakeSpiceDB) client() *authzed.Client {
+	return &authzed.Client{PermissionsServiceClient: f, SchemaServiceClient: f, WatchServiceClient: f}
+}
+
+// newTestServer serves at DefaultBasePath from a fake SpiceDB holding the given relationships and testSchema, with
+// in-memory metadata.
+func newTestServer(t *testing.T, tuples ...string) (*PrbacSpicedbServer, *fakeSpiceDB) {
+	t.Helper()
+
+	spicedb := newFakeSpiceDB(t, tuples...)
+	spicedb.schema = testSchema
+
+	return &PrbacSpicedbServer{BasePath: DefaultBasePath, SpicedbClient: spicedb.client(), Metadata: NewInMemoryMetadataStore()}, spicedb
+}
+
+// withIdentity is ctx as IdentityMiddleware leaves it for a request by user of org, an org admin or not.
+func withIdentity(ctx context.Context, org, user string, admin bool) context.Context {
+	return context.WithValue(ctx, identityContextKey{}, Identity{OrgId: org, Username: user, IsOrgAdmin: admin})
+}
+
+func (f *fakeSpiceDB) ReadSchema(ctx context.Context, in *v1.ReadSchemaRequest, opts ...grpc.CallOption) (*v1.ReadSchemaResponse, error) {
+	return &v1.ReadSchemaResponse{SchemaText: f.schema}, nil
+}
+
+// tuples renders the stored relationships in resource:id#relation@subject:id form.
+func (f *fakeSpiceDB) tuples() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var tuples []string
+	for _, relationship := range f.relationships {
+		tuples = append(tuples, formatTuple(relationship))
+	}
+
+	return tuples
+}
+
+// write applies the same operation to each of the given tuples.
+func (f *fakeSpiceDB) write(t *testing.T, operation v1.RelationshipUpdate_Operation, tuples ...string) {
+	t.Helper()
+
+	var updates []*v1.RelationshipUpdate
+	for _, tuple := range tuples {
+		updates = append(updates, &v1.RelationshipUpdate{Operation: operation, Relationship: parseTuple(t, tuple)})
+	}
+
+	if _, err := f.WriteRelationships(context.Background(), &v1.WriteRelationshipsRequest{Updates: updates}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (f *fakeSpiceDB) ReadRelationships(ctx context.Context, in *v1.ReadRelationshipsRequest, opts ...grpc.CallOption) (v1.PermissionsService_ReadRelationshipsClient, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var responses []*v1.ReadRelationshipsResponse
+	for _, relationship := range f.relationships {
+		if matchesFilter(relationship, in.GetRelationshipFilter()) {
+			responses = append(responses, &v1.ReadRelationshipsResponse{Relationship: relationship})
+		}
+	}
+
+	return &fakeStream[v1.ReadRelationshipsResponse]{items: responses}, nil
+}
+
+func (f *fakeSpiceDB) WriteRelationships(ctx context.Context, in *v1.WriteRelationshipsRequest, opts ...grpc.CallOption) (*v1.WriteRelationshipsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.failWrites > 0 {
+		f.failWrites--
+		return nil, errors.New("fake write failure")
+	}
+
+	// As SpiceDB does, fail the whole write if a precondition does not hold or a relationship to create exists
+	for _, precondition := range in.GetOptionalPreconditions() {
+		matched := slices.ContainsFunc(f.relationships, func(relationship *v1.Relationship) bool {
+			return matchesFilter(relationship, precondition.GetFilter())
+		})
+		if matched != (precondition.GetOperation() == v1.Precondition_OPERATION_MUST_MATCH) {
+			return nil, status.Errorf(codes.FailedPrecondition, "unable to satisfy write precondition %s", precondition.GetOperation())
+		}
+	}
+	for _, update := range in.GetUpdates() {
+		if update.GetOperation() != v1.RelationshipUpdate_OPERATION_CREATE {
+			continue
+		}
+		if slices.ContainsFunc(f.relationships, func(relationship *v1.Relationship) bool {
+			return formatTuple(relationship) == formatTuple(update.GetRelationship())
+		}) {
+			return nil, status.Errorf(codes.AlreadyExists, "could not CREATE relationship %s, as it already existed", formatTuple(update.GetRelationship()))
+		}
+	}
+
+	f.writes++
+	f.revisions = append(f.revisions, &v1.WatchResponse{
+		Updates:        in.GetUpdates(),
+		ChangesThrough: &v1.ZedToken{Token: fmt.Sprintf("revision-%d", len(f.revisions)+1)},
+	})
+	for _, update := range in.GetUpdates() {
+		index := -1
+		for i, relationship := range f.relationships {
+			if formatTuple(relationship) == formatTuple(update.GetRelationship()) {
+				index = i
+				break
+			}
+		}
+
+		switch update.GetOperation() {
+		case v1.RelationshipUpdate_OPERATION_CREATE, v1.RelationshipUpdate_OPERATION_TOUCH:
+			if index == -1 {
+				f.relationships = append(f.relationships, update.GetRelationship())
+			}
+		case v1.RelationshipUpdate_OPERATION_DELETE:
+			if index != -1 {
+				f.relationships = append(f.relationships[:index], f.relationships[index+1:]...)
+			}
+		}
+	}
+
+	return &v1.WriteRelationshipsResponse{WrittenAt: &v1.ZedToken{Token: fmt.Sprintf("token-%d", len(f.relationships))}}, nil
+}
+
+// Watch streams the writes after the start cursor, or after the writes made so far without one, waiting for more
+// until ctx is done. It reports every update written, not only those that changed anything.
+func (f *fakeSpiceDB) Watch(ctx context.Context, in *v1.WatchRequest, opts ...grpc.CallOption) (v1.WatchService_WatchClient, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	next := len(f.revisions)
+	if cursor := in.GetOptionalStartCursor(); cursor != nil {
+		revision, err := strconv.Atoi(strings.TrimPrefix(cursor.GetToken(), "revision-"))
+		if err != nil || revision > len(f.revisions) {
+			return nil, fmt.Errorf("unknown cursor %q", cursor.GetToken())
+		}
+		next = revision
+	}
+
+	return &fakeWatchStream{ctx: ctx, spicedb: f, next: next, objectTypes: in.GetOptionalObjectTypes()}, nil
+}
+
+type fakeWatchStream struct {
+	grpc.ClientStream
+
+	ctx         context.Context
+	spicedb     *fakeSpiceDB
+	next        int
+	objectTypes []string
+}
+
+func (s *fakeWatchStream) Recv() (*v1.WatchResponse, error) {
+	for {
+		s.spicedb.mu.Lock()
+		if s.next < len(s.spicedb.revisions) {
+			revision := s.spicedb.revisions[s.next]
+			s.next++
+			s.spicedb.mu.Unlock()
+
+			response := &v1.WatchResponse{ChangesThrough: revision.GetChangesThrough()}
+			for _, update := range revision.GetUpdates() {
+				if len(s.objectTypes) == 0 || slices.Contains(s.objectTypes, update.GetRelationship().GetResource().GetObjectType()) {
+					response.Updates = append(response.Updates, update)
+				}
+			}
+
+			return response, nil
+		}
+		s.spicedb.mu.Unlock()
+
+		select {
+		case <-s.ctx.Done():
+			return nil, s.ctx.Err()
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func (f *fakeSpiceDB) CheckPermission(ctx context.Context, in *v1.CheckPermissionRequest, opts ...grpc.CallOption) (*v1.CheckPermissionResponse, error) {
+	tuple := formatTuple(&v1.Relationship{Resource: in.GetResource(), Relation: in.GetPermission(), Subject: in.GetSubject()})
+
+	permissionship := v1.CheckPermissionResponse_PERMISSIONSHIP_NO_PERMISSION
+	for _, permission := range f.permissions {
+		if permission == tuple {
+			permissionship = v1.CheckPermissionResponse_PERMISSIONSHIP_HAS_PERMISSION
+		}
+	}
+
+	// Report a single-step trace when one is asked for, as SpiceDB would report a much longer one
+	if md, _ := metadata.FromOutgoingContext(ctx); len(md.Get(requestDebugInfoHeader)) != 0 {
+		result := v1.CheckDebugTrace_PERMISSIONSHIP_NO_PERMISSION
+		if permissionship == v1.CheckPermissionResponse_PERMISSIONSHIP_HAS_PERMISSION {
+			result = v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION
+		}
+
+		encoded, err := protojson.Marshal(&v1.DebugInformation{Check: &v1.CheckDebugTrace{
+			Resource:       in.GetResource(),
+			Permission:     in.GetPermission(),
+			PermissionType: v1.CheckDebugTrace_PERMISSION_TYPE_PERMISSION,
+			Subject:        in.GetSubject(),
+			Result:         result,
+		}})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, opt := range opts {
+			if trailer, ok := opt.(grpc.TrailerCallOption); ok {
+				*trailer.TrailerAddr = metadata.Pairs(debugInfoTrailer, string(encoded))
+			}
+		}
+	}
+
+	return &v1.CheckPermissionResponse{Permissionship: permissionship}, nil
+}
+
+func (f *fakeSpiceDB) LookupResources(ctx context.Context, in *v1.LookupResourcesRequest, opts ...grpc.CallOption) (v1.PermissionsService_LookupResourcesClient, error) {
+	var responses []*v1.LookupResourcesResponse
+	for _, permission := range f.permissions {
+		object, _, _ := strings.Cut(permission, "#")
+		objectType, objectId, _ := strings.Cut(object, ":")
+
+		lookedUp := &v1.Relationship{
+			Resource: &v1.ObjectReference{ObjectType: in.GetResourceObjectType(), ObjectId: objectId},
+			Relation: in.GetPermission(),
+			Subject:  in.GetSubject(),
+		}
+		if objectType == in.GetResourceObjectType() && formatTuple(lookedUp) == permission {
+			responses = append(responses, &v1.LookupResourcesResponse{
+				ResourceObjectId:  objectId,
+				Permissionship:    v1.LookupPermissionship_LOOKUP_PERMISSIONSHIP_HAS_PERMISSION,
+				AfterResultCursor: &v1.Cursor{Token: strconv.Itoa(len(responses) + 1)},
+			})
+		}
+	}
+
+	// Cursors are offsets into the results
+	if in.GetOptionalCursor() != nil {
+		offset, err := strconv.Atoi(in.GetOptionalCursor().GetToken())
+		if err != nil {
+			return nil, err
+		}
+		responses = responses[min(offset, len(responses)):]
+	}
+	if in.GetOptionalLimit() != 0 {
+		responses = responses[:min(int(in.GetOptionalLimit()), len(responses))]
+	}
+
+	return &fakeStream[v1.LookupResourcesResponse]{items: responses}, nil
+}
+
+func (f *fakeSpiceDB) LookupSubjects(ctx context.Context, in *v1.LookupSubjectsRequest, opts ...grpc.CallOption) (v1.PermissionsService_LookupSubjectsClient, error) {
+	resource := fmt.Sprintf("%s:%s#%s", in.GetResource().GetObjectType(), in.GetResource().GetObjectId(), in.GetPermission())
+
+	var responses []*v1.LookupSubjectsResponse
+	for _, permission := range f.permissions {
+		object, subject, _ := strings.Cut(permission, "@")
+		subjectObject, subjectRelation, _ := strings.Cut(subject, "#")
+		subjectType, subjectId, _ := strings.Cut(subjectObject, ":")
+
+		if object == resource && subjectType == in.GetSubjectObjectType() && subjectRelation == in.GetOptionalSubjectRelation() {
+			responses = append(responses, &v1.LookupSubjectsResponse{
+				Subject: &v1.ResolvedSubject{SubjectObjectId: subjectId, Permissionship: v1.LookupPermissionship_LOOKUP_PERMISSIONSHIP_HAS_PERMISSION},
+			})
+		}
+	}
+
+	return &fakeStream[v1.LookupSubjectsResponse]{items: responses}, nil
+}
+
+func matchesFilter(relationship *v1.Relationship, filter *v1.RelationshipFilter) bool {
+	if filter.GetResourceType() != "" && relationship.GetResource().GetObjectType() != filter.GetResourceType() {
+		return false
+	}
+	if filter.GetOptionalResourceId() != "" && relationship.GetResource().GetObjectId() != filter.GetOptionalResourceId() {
+		return false
+	}
+	if filter.GetOptionalRelation() != "" && relationship.GetRelation() != filter.GetOptionalRelation() {
+		return false
+	}
+
+	subjectFilter := filter.GetOptionalSubjectFilter()
+	if subjectFilter == nil {
+		return true
+	}
+
+	subject := relationship.GetSubject()
+	if subjectFilter.GetSubjectType() != "" && subject.GetObject().GetObjectType() != subjectFilter.GetSubjectType() {
+		return false
+	}
+	if subjectFilter.GetOptionalSubjectId() != "" && subject.GetObject().GetObjectId() != subjectFilter.GetOptionalSubjectId() {
+		return false
+	}
+	if subjectFilter.GetOptionalRelation() != nil && subject.GetOptionalRelation() != subjectFilter.GetOptionalRelation().GetRelation() {
+		return false
+	}
+
+	return true
+}
+
+func parseTuple(t *testing.T, tuple string) *v1.Relationship {
+	t.Helper()
+
+	resource, subject, ok := strings.Cut(tuple, "@")
+	if !ok {
+		t.Fatalf("invalid tuple %q", tuple)
+	}
+	object, relation, ok := strings.Cut(resource, "#")
+	if !ok {
+		t.Fatalf("invalid tuple %q", tuple)
+	}
+	subjectObject, subjectRelation, _ := strings.Cut(subject, "#")
+
+	return &v1.Relationship{
+		Resource: parseObject(t, object),
+		Relation: relation,
+		Subject: &v1.SubjectReference{
+			Object:           parseObject(t, subjectObject),
+			OptionalRelation: subjectRelation,
+		},
+	}
+}
+
+func parseObject(t *testing.T, object string) *v1.ObjectReference {
+	t.Helper()
+
+	objectType, objectId, ok := strings.Cut(object, ":")
+	if !ok {
+		t.Fatalf("invalid object %q", object)
+	}
+
+	return &v1.ObjectReference{ObjectType: objectType, ObjectId: objectId}
+}
+
+func formatTuple(relationship *v1.Relationship) string {
+	tuple := fmt.Sprintf("%s:%s#%s@%s:%s",
+		relationship.GetResource().GetObjectType(), relationship.GetResource().GetObjectId(),
+		relationship.GetRelation(),
+		relationship.GetSubject().GetObject().GetObjectType(), relationship.GetSubject().GetObject().GetObjectId())
+
+	if relationship.GetSubject().GetOptionalRelation() != "" {
+		tuple += "#" + relationship.GetSubject().GetOptionalRelation()
+	}
+
+	return tuple
+}
+
+// fakeStream replays a fixed list of responses to satisfy the generated gRPC server-streaming client interfaces.
+type fakeStream[T any] struct {
+	grpc.ClientStream
+
+	items []*T
+}
+
+func (s *fakeStream[T]) Recv() (*T, error) {
+	if len(s.items) == 0 {
+		return nil, io.EOF
+	}
+
+	next := s.items[0]
+	s.items = s.items[1:]
+
+	return next, nil
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeCertificate writes a self-signed certificate and its key as PEM files, returning their paths.
+func writeCertificate(t *testing.T, dir string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "spicedb"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return certFile, keyFile
+}
+
+func TestSpiceDbDialOptions(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeCertificate(t, dir)
+	notPem := filepath.Join(dir, "token")
+	if err := os.WriteFile(notPem, []byte("s3cret"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		config  SpiceDBConfig
+		invalid string // part of the expected error, empty if the config is valid
+	}{
+		{"insecure", SpiceDBConfig{Token: "s3cret"}, ""},
+		{"system CAs", SpiceDBConfig{Token: "s3cret", TLS: true}, ""},
+		{"CA bundle", SpiceDBConfig{Token: "s3cret", CAFile: certFile}, ""},
+		{"client certificate", SpiceDBConfig{Token: "s3cret", CAFile: certFile, CertFile: certFile, KeyFile: keyFile}, ""},
+		{"CA bundle without certificates", SpiceDBConfig{Token: "s3cret", CAFile: notPem}, "no certificates found"},
+		{"client certificate without key", SpiceDBConfig{Token: "s3cret", CertFile: certFile}, "both a certificate and a key file"},
+		{"missing CA bundle", SpiceDBConfig{Token: "s3cret", CAFile: filepath.Join(dir, "missing.crt")}, "no such file"},
+	}
+
+	for _, test := range tests {
+		_, err := spiceDbDialOptions(test.config)
+		switch {
+		case test.invalid == "" && err != nil:
+			t.Errorf("%s: expected the config to be valid, got %v", test.name, err)
+		case test.invalid != "" && (err == nil || !strings.Contains(err.Error(), test.invalid)):
+			t.Errorf("%s: expected an error about %q, got %v", test.name, test.invalid, err)
+		}
+	}
+}
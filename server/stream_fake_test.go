@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"sync"
+)
+
+// fakeChangeStream is an in-memory stand-in for a Kafka topic of a single partition read by a consumer group. Once
+// every message has been fetched, Fetch waits for ctx, calling drained first if set.
+type fakeChangeStream struct {
+	mu        sync.Mutex
+	messages  []ChangeMessage
+	next      int     // index of the next message to fetch
+	committed int64   // offset up to which messages are committed, or -1
+	commits   []int64 // offsets committed, in order
+	drained   func()
+}
+
+func newFakeChangeStream(values ...string) *fakeChangeStream {
+	s := &fakeChangeStream{committed: -1}
+	s.publish(values...)
+
+	return s
+}
+
+func (s *fakeChangeStream) publish(values ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, value := range values {
+		s.messages = append(s.messages, ChangeMessage{Topic: "outbox", Offset: int64(len(s.messages)), Key: []byte("acme"), Value: []byte(value)})
+	}
+}
+
+// reopen starts fetching again from the first message not committed, as a restarted consumer does.
+func (s *fakeChangeStream) reopen() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.next = int(s.committed + 1)
+}
+
+func (s *fakeChangeStream) Fetch(ctx context.Context) (ChangeMessage, error) {
+	s.mu.Lock()
+	if s.next < len(s.messages) {
+		message := s.messages[s.next]
+		s.next++
+		s.mu.Unlock()
+
+		return message, nil
+	}
+	drained := s.drained
+	s.mu.Unlock()
+
+	if drained != nil {
+		drained()
+	}
+	<-ctx.Done()
+
+	return ChangeMessage{}, ctx.Err()
+}
+
+func (s *fakeChangeStream) Commit(ctx context.Context, message ChangeMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.committed = message.Offset
+	s.commits = append(s.commits, message.Offset)
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+func (p *PrbacSpicedbServer) LookupAccessSubjects(ctx context.Context, request api.LookupAccessSubjectsRequestObject) (api.LookupAccessSubjectsResponseObject, error) {
+	params := request.Params
+
+	definitions, err := readSchemaDefinitions(ctx, p.SpicedbClient)
+	if err != nil {
+		return api.LookupAccessSubjects500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	permission, ok := p.spicedbPermission(definitions, params.ResourceType, params.Permission)
+	if !ok {
+		return api.LookupAccessSubjects400JSONResponse(errorBody(400, fmt.Sprintf("permission %s cannot be looked up on %s", params.Permission, params.ResourceType))), nil
+	}
+
+	if found, err := p.isInUserOrg(ctx, params.ResourceType, params.ResourceId); err != nil {
+		return api.LookupAccessSubjects500JSONResponse(errorBody(500, err.Error())), nil
+	} else if !found {
+		return api.LookupAccessSubjects404JSONResponse(errorBody(404, "resource not found: "+params.ResourceType+":"+params.ResourceId)), nil
+	}
+
+	resource := &v1.ObjectReference{ObjectType: params.ResourceType, ObjectId: params.ResourceId}
+
+	users, wildcard, excluded, err := p.lookupSubjects(ctx, resource, permission, "user", "")
+	if err != nil {
+		return api.LookupAccessSubjects500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	// Members of the default groups are implicit, so access through those groups is only found by looking up groups
+	groups, _, _, err := p.lookupSubjects(ctx, resource, permission, "group", "member")
+	if err != nil {
+		return api.LookupAccessSubjects500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	org := getUserOrg(ctx)
+	platformDefault := getDefaultGroupId(org, "platform_default")
+	adminDefault := getDefaultGroupId(org, "admin_default")
+
+	subjects := make([]api.AccessSubject, 0)
+	allUsers, orgAdmins := -1, -1
+
+	if wildcard || slices.Contains(groups, platformDefault) {
+		allUsers = len(subjects)
+		subject := api.AccessSubject{Kind: api.AllUsers}
+		if len(excluded) != 0 {
+			subject.ExcludedUsernames = &excluded
+		}
+		subjects = append(subjects, subject)
+	}
+	if slices.Contains(groups, adminDefault) {
+		orgAdmins = len(subjects)
+		subjects = append(subjects, api.AccessSubject{Kind: api.OrgAdmins})
+	}
+
+	slices.Sort(users)
+	usersIndex := make(map[string]int)
+	for _, user := range users {
+		username := user
+		usersIndex[user] = len(subjects)
+		subjects = append(subjects, api.AccessSubject{Kind: api.User, Username: &username})
+	}
+
+	if valueOrFalse(params.Paths) {
+		paths, err := p.getGrantPaths(ctx, definitions, params.ResourceType, params.ResourceId, permission)
+		if err != nil {
+			return api.LookupAccessSubjects500JSONResponse(errorBody(500, err.Error())), nil
+		}
+
+		addPath := func(index int, path api.AccessPath) {
+			if index == -1 {
+				return // SpiceDB found no access through this path, e.g. as the subject is excluded
+			}
+			if subjects[index].Paths == nil {
+				subjects[index].Paths = &[]api.AccessPath{}
+			}
+			*subjects[index].Paths = append(*subjects[index].Paths, path)
+		}
+
+		for _, path := range paths {
+			accessPath := p.toAccessPath(path)
+			subject := path.Subject.GetObject()
+
+			switch {
+			case subject.GetObjectType() == "user" && subject.GetObjectId() == "*":
+				addPath(allUsers, accessPath)
+			case subject.GetObjectType() == "user":
+				if index, ok := usersIndex[subject.GetObjectId()]; ok {
+					addPath(index, accessPath)
+				}
+			case subject.GetObjectType() == "group":
+				groupId := subject.GetObjectId()
+
+				switch groupId {
+				case platformDefault:
+					addPath(allUsers, accessPath)
+				case adminDefault:
+					addPath(orgAdmins, accessPath)
+				}
+
+				members, err := p.getGroupMembers(ctx, groupId)
+				if err != nil {
+					return api.LookupAccessSubjects500JSONResponse(errorBody(500, err.Error())), nil
+				}
+				for _, member := range members {
+					if index, ok := usersIndex[member]; ok {
+						addPath(index, accessPath)
+					}
+				}
+			}
+		}
+	}
+
+	return api.LookupAccessSubjects200JSONResponse{
+		Data: subjects,
+		Meta: *paginationMeta(len(subjects)),
+	}, nil
+}
+
+// toAccessPath renders a grant path in RBAC v1 terms, naming the role, the permission it grants and, for role
+// bindings to a group, the group.
+func (p *PrbacSpicedbServer) toAccessPath(path grantPath) api.AccessPath {
+	roleName := p.getRoleName(path.Role)
+
+	permission, ok := p.permissionFromRelation(path.Relation)
+	if !ok {
+		permission = path.Relation
+	}
+
+	accessPath := api.AccessPath{
+		Workspace:   path.Workspace,
+		RoleBinding: path.RoleBinding,
+		Role:        path.Role,
+		RoleName:    &roleName,
+		Permission:  permission,
+	}
+
+	if path.Subject.GetObject().GetObjectType() == "group" {
+		group := path.Subject.GetObject().GetObjectId()
+		accessPath.Group = &group
+	}
+
+	return accessPath
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+// newAccessTestServer sets up a host h1 in workspace ws1 beneath the root workspace. Role r1 grants writing hosts to
+// alice in ws1, and to group g1, which has bob and, through group g2, carol in it, in the root workspace. The
+// platform default group is granted writing hosts in the root workspace through a system role.
+func newAccessTestServer(t *testing.T) (*PrbacSpicedbServer, *fakeSpiceDB) {
+	platformDefault := getDefaultGroupId(defaultOrg, "platform_default")
+	systemRole := permissionsToSystemRoles["inventory:hosts:write"]
+
+	p, spicedb := newTestServer(t,
+		"workspace:aspian_root#parent@organization:aspian",
+		"workspace:ws1#parent@workspace:aspian_root",
+		"inventory/hosts:h1#workspace@workspace:ws1",
+		"role:r1#inventory_hosts_write@user:*",
+		"role:r1#inventory_hosts_read@user:*",
+		"role_binding:b1#granted@role:r1",
+		"role_binding:b1#subject@group:g1#member",
+		"workspace:aspian_root#user_grant@role_binding:b1",
+		"group:g1#member@user:bob",
+		"group:g1#member@group:g2#member",
+		"group:g2#member@user:carol",
+		"role_binding:b2#granted@role:r1",
+		"role_binding:b2#subject@user:alice",
+		"workspace:ws1#user_grant@role_binding:b2",
+		"role:"+systemRole+"#inventory_hosts_write@user:*",
+		"role_binding:pd#granted@role:"+systemRole,
+		"role_binding:pd#subject@group:"+platformDefault+"#member",
+		"workspace:aspian_root#user_grant@role_binding:pd",
+	)
+	spicedb.schema = checkTestSchema
+	spicedb.permissions = []string{
+		"inventory/hosts:h1#write@user:alice",
+		"inventory/hosts:h1#write@user:bob",
+		"inventory/hosts:h1#write@user:carol",
+		"inventory/hosts:h1#write@group:" + platformDefault + "#member",
+	}
+
+	p.RbacServices = testServices
+	p.Metadata.Put("role", "r1", Metadata{Name: "Host writers"})
+
+	return p, spicedb
+}
+
+func TestLookupAccessSubjects(t *testing.T) {
+	p, _ := newAccessTestServer(t)
+	platformDefault := getDefaultGroupId(defaultOrg, "platform_default")
+
+	paths := true
+	resp, err := p.LookupAccessSubjects(context.Background(), api.LookupAccessSubjectsRequestObject{Params: api.LookupAccessSubjectsParams{
+		ResourceType: "inventory/hosts", ResourceId: "h1", Permission: "inventory:hosts:write", Paths: &paths,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := resp.(api.LookupAccessSubjects200JSONResponse).Data
+	if len(data) != 4 {
+		t.Fatalf("expected 4 subjects, got %+v", data)
+	}
+
+	if data[0].Kind != api.AllUsers || data[0].Paths == nil || len(*data[0].Paths) != 1 {
+		t.Fatalf("unexpected subject: %+v", data[0])
+	}
+	if path := (*data[0].Paths)[0]; path.RoleBinding != "pd" || *path.Group != platformDefault || *path.RoleName != "System role for inventory:hosts:write" {
+		t.Errorf("unexpected path: %+v", path)
+	}
+
+	expected := []struct {
+		username, workspace, group string
+	}{
+		{"alice", "ws1", ""},
+		{"bob", "aspian_root", "g1"},
+		{"carol", "aspian_root", "g1"},
+	}
+	for i, e := range expected {
+		subject := data[i+1]
+		if subject.Kind != api.User || *subject.Username != e.username || subject.Paths == nil || len(*subject.Paths) != 1 {
+			t.Errorf("unexpected subject: %+v", subject)
+			continue
+		}
+
+		path := (*subject.Paths)[0]
+		if path.Workspace != e.workspace || path.Role != "r1" || *path.RoleName != "Host writers" || path.Permission != "inventory:hosts:write" {
+			t.Errorf("unexpected path for %s: %+v", e.username, path)
+		}
+		if (e.group == "" && path.Group != nil) || (e.group != "" && (path.Group == nil || *path.Group != e.group)) {
+			t.Errorf("unexpected group for %s: %+v", e.username, path.Group)
+		}
+	}
+}
+
+func TestLookupAccessSubjectsInOtherOrganization(t *testing.T) {
+	p, spicedb := newTestServer(t, "workspace:other_root#parent@organization:other")
+	spicedb.schema = checkTestSchema
+	p.RbacServices = testServices
+
+	resp, _ := p.LookupAccessSubjects(context.Background(), api.LookupAccessSubjectsRequestObject{Params: api.LookupAccessSubjectsParams{
+		ResourceType: "workspace", ResourceId: "other_root", Permission: "inventory:hosts:write",
+	}})
+	if _, ok := resp.(api.LookupAccessSubjects404JSONResponse); !ok {
+		t.Errorf("expected 404, got %+v", resp)
+	}
+}
@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/google/uuid"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+// tenantRelation relates groups, RBAC v1 roles and workspaces to the organization that owns them, e.g.
+// group:<groupId>#tenant@organization:<org>. It is written along with the object and is what handlers check before
+// touching an object a request names, so that one tenant cannot change another's groups and roles by their ids.
+const tenantRelation = "tenant"
+
+func tenantUpdate(operation v1.RelationshipUpdate_Operation, objectType, objectId, org string) *v1.RelationshipUpdate {
+	return createRelationshipUpdate(operation, objectType, objectId, tenantRelation, "organization", org)
+}
+
+// getTenant returns the organization that owns an object, or an empty string if no organization does.
+func (p *PrbacSpicedbServer) getTenant(ctx context.Context, objectType, objectId string) (string, error) {
+	relationships, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+		ResourceType:       objectType,
+		OptionalResourceId: objectId,
+		OptionalRelation:   tenantRelation,
+	})
+	if err != nil || len(relationships) == 0 {
+		return "", err
+	}
+	if len(relationships) > 1 {
+		return "", fmt.Errorf("%s %s has %d tenants", objectType, objectId, len(relationships))
+	}
+
+	return relationships[0].GetSubject().GetObject().GetObjectId(), nil
+}
+
+// groupOwnership is whether an organization may change a group.
+type groupOwnership int
+
+const (
+	groupNotFound  groupOwnership = iota // another organization's, or one with no owner on record
+	groupOwned                           // the organization's, including its default groups
+	groupUnclaimed                       // not yet in use by anyone; writing to it makes it the organization's
+)
+
+// getGroupOwnership finds whether a group belongs to an organization. Groups come into being when their first
+// principal or role is added, so a group nothing refers to yet may be claimed; the write that adds to it must then
+// record its owner.
+func (p *PrbacSpicedbServer) getGroupOwnership(ctx context.Context, org, groupId string) (groupOwnership, error) {
+	for _, group := range defaultGroups {
+		if groupId == getDefaultGroupId(org, group.kind) {
+			return groupOwned, nil
+		}
+	}
+	tenant, err := p.getTenant(ctx, "group", groupId)
+	if err != nil {
+		return groupNotFound, err
+	}
+	if tenant != "" {
+		if tenant == org {
+			return groupOwned, nil
+		}
+		return groupNotFound, nil
+	}
+
+	for _, filter := range []*v1.RelationshipFilter{
+		{ResourceType: "group", OptionalResourceId: groupId},
+		{ResourceType: "role_binding", OptionalRelation: "subject", OptionalSubjectFilter: &v1.SubjectFilter{SubjectType: "group", OptionalSubjectId: groupId}},
+	} {
+		relationships, err := readRelationships(ctx, p.SpicedbClient, filter)
+		if err != nil {
+			return groupNotFound, err
+		}
+		if len(relationships) != 0 {
+			return groupNotFound, nil
+		}
+	}
+
+	// A name-based id may be that of the default group of an organization yet to be provisioned, see
+	// getDefaultGroupId, which only that organization may claim
+	if id, err := uuid.Parse(groupId); err == nil && id.Version() == 5 {
+		return groupNotFound, nil
+	}
+
+	return groupUnclaimed, nil
+}
+
+// groupClaim returns what a write adding to an unclaimed group must include to claim it for an organization: the
+// group's owner, and a precondition failing the write should another organization claim it first.
+func groupClaim(org, groupId string) (*v1.RelationshipUpdate, *v1.Precondition) {
+	return tenantClaim("group", groupId, org)
+}
+
+// tenantClaim returns what a write creating an object must include to record its owner, and a precondition failing the
+// write should the object have an owner already.
+func tenantClaim(objectType, objectId, org string) (*v1.RelationshipUpdate, *v1.Precondition) {
+	return tenantUpdate(v1.RelationshipUpdate_OPERATION_CREATE, objectType, objectId, org), &v1.Precondition{
+		Operation: v1.Precondition_OPERATION_MUST_NOT_MATCH,
+		Filter:    &v1.RelationshipFilter{ResourceType: objectType, OptionalResourceId: objectId, OptionalRelation: tenantRelation},
+	}
+}
+
+// ownsRole reports whether an organization may use a role: one of its own, or a system role, which every organization
+// shares.
+func (p *PrbacSpicedbServer) ownsRole(ctx context.Context, org, roleId string) (bool, error) {
+	if isSystemRole(roleId) {
+		return true, nil
+	}
+
+	tenant, err := p.getTenant(ctx, "rbac/v1role", roleId)
+	return tenant != "" && tenant == org, err
+}
+
+// findUnownedRole returns the first of the roles an organization may not use, or an empty string if it may use them
+// all.
+func (p *PrbacSpicedbServer) findUnownedRole(ctx context.Context, org string, roleIds []string) (string, error) {
+	for _, roleId := range roleIds {
+		owned, err := p.ownsRole(ctx, org, roleId)
+		if err != nil {
+			return "", err
+		}
+		if !owned {
+			return roleId, nil
+		}
+	}
+
+	return "", nil
+}
+
+// findUnownedWorkspace returns the first workspace named by a group.id resource definition of a role that is not one of
+// the organization's own, or an empty string if they all are. The root workspace counts as unowned, as a role is bound
+// to it without any resource definition. known lists workspaces to take as the organization's even though they are not
+// written yet, such as those queued by an import.
+func (p *PrbacSpicedbServer) findUnownedWorkspace(ctx context.Context, org string, accesses []api.Access, known map[string]bool) (string, error) {
+	for _, access := range accesses {
+		for _, definition := range access.ResourceDefinitions {
+			filter := definition.AttributeFilter
+			if filter.Key != "group.id" || known[filter.Value] {
+				continue
+			}
+			if filter.Value == getRootWorkspace(org) {
+				return filter.Value, nil
+			}
+
+			_, found, err := p.getOrgWorkspace(ctx, org, filter.Value)
+			if err != nil {
+				return "", err
+			}
+			if !found {
+				return filter.Value, nil
+			}
+		}
+	}
+
+	return "", nil
+}
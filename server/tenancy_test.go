@@ -0,0 +1,184 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+func TestTenantIsolation(t *testing.T) {
+	p, spicedb := newTestServer(t, "workspace:acme_root#parent@organization:acme", "workspace:globex_root#parent@organization:globex")
+	acme := withIdentity(context.Background(), "acme", "alice", true)
+	globex := withIdentity(context.Background(), "globex", "mallory", true)
+
+	created, err := p.CreateRole(acme, api.CreateRoleRequestObject{Body: &api.RoleIn{Name: "hosts", Access: []api.Access{{Permission: "inventory:hosts:read"}}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	roleId := created.(api.CreateRole201JSONResponse).Uuid
+
+	groupId := uuid.New()
+	if _, err := p.AddPrincipalToGroup(acme, api.AddPrincipalToGroupRequestObject{Uuid: groupId, Body: &api.GroupPrincipalIn{
+		Principals: []api.PrincipalIn{{Username: "bob"}},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.AddRoleToGroup(acme, api.AddRoleToGroupRequestObject{Uuid: groupId, Body: &api.AddRoleToGroupJSONRequestBody{Roles: []uuid.UUID{roleId}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	workspace, err := p.CreateWorkspace(acme, api.CreateWorkspaceRequestObject{Body: &api.WorkspaceIn{Name: "Production"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	workspaceId := workspace.(api.CreateWorkspace201JSONResponse).Id
+
+	tuples := spicedb.tuples()
+	for _, tuple := range []string{
+		"group:" + groupId.String() + "#tenant@organization:acme",
+		"rbac/v1role:" + roleId.String() + "#tenant@organization:acme",
+		"workspace:" + workspaceId + "#tenant@organization:acme",
+	} {
+		if !slices.Contains(tuples, tuple) {
+			t.Errorf("missing owner %s", tuple)
+		}
+	}
+
+	globexGroup := uuid.New()
+	tests := []struct {
+		name string
+		call func() (bool, error)
+	}{
+		{"add a principal to another tenant's group", func() (bool, error) {
+			resp, err := p.AddPrincipalToGroup(globex, api.AddPrincipalToGroupRequestObject{Uuid: groupId, Body: &api.GroupPrincipalIn{Principals: []api.PrincipalIn{{Username: "mallory"}}}})
+			return isResponse[api.AddPrincipalToGroup404JSONResponse](resp), err
+		}},
+		{"remove a principal from another tenant's group", func() (bool, error) {
+			resp, err := p.DeletePrincipalFromGroup(globex, api.DeletePrincipalFromGroupRequestObject{Uuid: groupId, Params: api.DeletePrincipalFromGroupParams{Usernames: "bob"}})
+			return isResponse[api.DeletePrincipalFromGroup404JSONResponse](resp), err
+		}},
+		{"add a system role to another tenant's group", func() (bool, error) {
+			resp, err := p.AddRoleToGroup(globex, api.AddRoleToGroupRequestObject{Uuid: groupId, Body: &api.AddRoleToGroupJSONRequestBody{Roles: []uuid.UUID{uuid.MustParse(userAccessAdministratorRole)}}})
+			return isResponse[api.AddRoleToGroup404JSONResponse](resp), err
+		}},
+		{"add another tenant's role to a group", func() (bool, error) {
+			resp, err := p.AddRoleToGroup(globex, api.AddRoleToGroupRequestObject{Uuid: globexGroup, Body: &api.AddRoleToGroupJSONRequestBody{Roles: []uuid.UUID{roleId}}})
+			return isResponse[api.AddRoleToGroup404JSONResponse](resp), err
+		}},
+		{"remove a role from another tenant's group", func() (bool, error) {
+			resp, err := p.DeleteRoleFromGroup(globex, api.DeleteRoleFromGroupRequestObject{Uuid: groupId, Params: api.DeleteRoleFromGroupParams{Roles: roleId.String()}})
+			return isResponse[api.DeleteRoleFromGroup404JSONResponse](resp), err
+		}},
+		{"update another tenant's role", func() (bool, error) {
+			resp, err := p.UpdateRole(globex, api.UpdateRoleRequestObject{Uuid: roleId, Body: &api.RoleWithAccess{Name: "hosts", Access: []api.Access{{Permission: "inventory:hosts:write"}}}})
+			return isResponse[api.UpdateRole404JSONResponse](resp), err
+		}},
+		{"read another tenant's role", func() (bool, error) {
+			resp, err := p.GetRoleAccess(globex, api.GetRoleAccessRequestObject{Uuid: roleId})
+			return isResponse[api.GetRoleAccess404JSONResponse](resp), err
+		}},
+		{"read another tenant's workspace", func() (bool, error) {
+			resp, err := p.GetWorkspace(globex, api.GetWorkspaceRequestObject{Id: workspaceId})
+			return isResponse[api.GetWorkspace404JSONResponse](resp), err
+		}},
+		{"delete another tenant's workspace", func() (bool, error) {
+			resp, err := p.DeleteWorkspace(globex, api.DeleteWorkspaceRequestObject{Id: workspaceId})
+			return isResponse[api.DeleteWorkspace404JSONResponse](resp), err
+		}},
+		{"bind a role to another tenant's workspace", func() (bool, error) {
+			resp, err := p.CreateRole(globex, workspaceRole(workspaceId))
+			return isResponse[api.CreateRole404JSONResponse](resp), err
+		}},
+		{"bind a role to another tenant's root workspace", func() (bool, error) {
+			resp, err := p.CreateRole(globex, workspaceRole("acme_root"))
+			return isResponse[api.CreateRole404JSONResponse](resp), err
+		}},
+		{"bind a role to the root workspace by resource definition", func() (bool, error) {
+			resp, err := p.CreateRole(globex, workspaceRole("globex_root"))
+			return isResponse[api.CreateRole404JSONResponse](resp), err
+		}},
+	}
+
+	for _, test := range tests {
+		notFound, err := test.call()
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if !notFound {
+			t.Errorf("%s: expected 404 response", test.name)
+		}
+	}
+
+	if after := spicedb.tuples(); !slices.Equal(after, tuples) {
+		t.Errorf("expected another tenant to change nothing, got %v", after)
+	}
+}
+
+// workspaceRole is a request to create a role granting inventory:hosts:write in a workspace.
+func workspaceRole(workspaceId string) api.CreateRoleRequestObject {
+	return api.CreateRoleRequestObject{Body: &api.RoleIn{Name: "hosts", Access: []api.Access{
+		{Permission: "inventory:hosts:write", ResourceDefinitions: []api.ResourceDefinition{
+			{AttributeFilter: api.ResourceDefinitionFilter{Key: "group.id", Operation: api.Equal, Value: workspaceId}},
+		}},
+	}}}
+}
+
+func TestGroupOwnership(t *testing.T) {
+	legacyGroup := uuid.New()
+	boundGroup := uuid.New()
+	nameBasedGroup := uuid.NewSHA1(uuid.NameSpaceDNS, []byte("operators.acme.com")) // e.g. migrated from elsewhere
+	p, _ := newTestServer(t,
+		"group:"+legacyGroup.String()+"#member@user:bob",
+		"role_binding:b1#subject@group:"+boundGroup.String()+"#member",
+		"group:"+nameBasedGroup.String()+"#tenant@organization:acme",
+	)
+	ctx := withIdentity(context.Background(), "acme", "alice", true)
+
+	tests := []struct {
+		name      string
+		groupId   string
+		ownership groupOwnership
+	}{
+		{"default group", getDefaultGroupId("acme", "platform_default"), groupOwned},
+		{"another tenant's default group", getDefaultGroupId("globex", "platform_default"), groupNotFound},
+		{"group with members but no owner", legacyGroup.String(), groupNotFound},
+		{"group bound to a role but with no owner", boundGroup.String(), groupNotFound},
+		{"name-based group of the tenant's", nameBasedGroup.String(), groupOwned},
+		{"unused group", uuid.NewString(), groupUnclaimed},
+		{"unused name-based group", uuid.NewSHA1(uuid.NameSpaceDNS, []byte("admins.acme.com")).String(), groupNotFound},
+	}
+
+	for _, test := range tests {
+		ownership, err := p.getGroupOwnership(ctx, "acme", test.groupId)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if ownership != test.ownership {
+			t.Errorf("%s: expected %d, got %d", test.name, test.ownership, ownership)
+		}
+	}
+
+	groupId := uuid.New()
+	if _, err := p.AddPrincipalToGroup(ctx, api.AddPrincipalToGroupRequestObject{Uuid: groupId, Body: &api.GroupPrincipalIn{
+		Principals: []api.PrincipalIn{{Username: "bob"}},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	if ownership, _ := p.getGroupOwnership(ctx, "acme", groupId.String()); ownership != groupOwned {
+		t.Errorf("expected adding to an unused group to claim it, got %d", ownership)
+	}
+	if ownership, _ := p.getGroupOwnership(ctx, "globex", groupId.String()); ownership != groupNotFound {
+		t.Errorf("expected a claimed group to be hidden from other tenants, got %d", ownership)
+	}
+}
+
+func TestGetTenantWithTwoTenants(t *testing.T) {
+	p, _ := newTestServer(t, "group:g1#tenant@organization:acme", "group:g1#tenant@organization:globex")
+
+	if _, err := p.getTenant(context.Background(), "group", "g1"); err == nil {
+		t.Errorf("expected an error for a group with two tenants")
+	}
+}
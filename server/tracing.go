@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/merlante/prbac-spicedb/api"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer traces requests and their calls to SpiceDB, through the global tracer provider, which traces nothing unless
+// one is set up, as main does.
+var tracer = otel.Tracer("github.com/merlante/prbac-spicedb/server")
+
+// Span attributes of this service's own, alongside the semantic conventions
+const (
+	orgAttribute         = attribute.Key("prbac.org_id")
+	applicationAttribute = attribute.Key("prbac.application")
+	permissionAttribute  = attribute.Key("prbac.permission")
+	resourceAttribute    = attribute.Key("prbac.resource")
+	subjectsAttribute    = attribute.Key("prbac.subjects")
+	allowedAttribute     = attribute.Key("prbac.allowed")
+	resultsAttribute     = attribute.Key("prbac.results")
+)
+
+// TracingMiddleware starts a span for each request, continuing the trace of the caller if its W3C traceparent header
+// has one. OperationTracingMiddleware must be among the strict handler's middlewares for the span to be named after
+// the operation.
+func TracingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+		ctx, span := tracer.Start(ctx, "HTTP "+r.Method, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(
+			semconv.HTTPMethod(r.Method),
+			semconv.URLPath(r.URL.Path),
+		))
+		defer span.End()
+
+		rw := &statusResponseWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rw, r.WithContext(ctx))
+
+		span.SetAttributes(semconv.HTTPStatusCode(rw.status))
+		if rw.status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(rw.status))
+		}
+	})
+}
+
+// OperationTracingMiddleware names a request's span after the operation answering it, and records the caller's
+// organization and, for access queries, the application they are for and the number of accesses found.
+func OperationTracingMiddleware(f api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		span := trace.SpanFromContext(ctx)
+		span.SetName(operationID)
+		span.SetAttributes(orgAttribute.String(getUserOrg(ctx)))
+
+		switch request := request.(type) {
+		case api.GetPrincipalAccessRequestObject:
+			span.SetAttributes(applicationAttribute.String(request.Params.Application))
+		}
+
+		response, err := f(ctx, w, r, request)
+
+		switch response := response.(type) {
+		case api.GetPrincipalAccess200JSONResponse:
+			span.SetAttributes(resultsAttribute.Int(len(response.Data)))
+		}
+
+		return response, err
+	}
+}
+
+// startSpan starts a span for work within a request, e.g. a sequence of checks, to tell its cost apart from the rest.
+func startSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// endSpan ends a span started by startSpan, recording the error the work ended with, if any.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
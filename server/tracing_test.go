@@ -0,0 +1,75 @@
+package server
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/merlante/prbac-spicedb/api"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracing(t *testing.T) {
+	// The global tracer provider can only be set once for the tracer made at init to use it
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	spicedb := newFakeSpiceDB(t)
+	spicedb.permissions = []string{"dispatcher/service:remediations#view@user:alice"}
+	p := &PrbacSpicedbServer{RbacServices: Services{"playbook-dispatcher": Permission{"run:read": ResourcePerm{
+		Permission: "dispatcher_view_runs",
+		Filter:     Filter{Name: "service", Operator: "equal", ResourceType: "dispatcher/service", Verb: "view"},
+	}}}, SpicedbClient: spicedb.client(), Metadata: NewInMemoryMetadataStore()}
+
+	r := chi.NewRouter()
+	r.Use(TracingMiddleware)
+	r.Use(IdentityMiddleware(true))
+	api.HandlerFromMux(api.NewStrictHandler(p, []api.StrictMiddlewareFunc{AuthorizationMiddleware(p), OperationTracingMiddleware}), r)
+
+	const traceId = "4bf92f3577b34da6a3ce929d0e0e4736"
+	request := httptest.NewRequest(http.MethodGet, "/access/?application=playbook-dispatcher", nil)
+	request.Header.Set("traceparent", "00-"+traceId+"-00f067aa0ba902b7-01")
+	request.Header.Set(identityHeader, base64.StdEncoding.EncodeToString([]byte(`{"identity": {"org_id": "acme", "user": {"username": "alice"}}}`)))
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, request)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		if span.SpanContext().TraceID().String() != traceId {
+			t.Errorf("expected span %s to continue the caller's trace, got %s", span.Name(), span.SpanContext().TraceID())
+		}
+		spans[span.Name()] = span
+	}
+
+	operation, ok := spans["GetPrincipalAccess"]
+	if !ok {
+		t.Fatalf("expected a span named after the operation, got %v", spans)
+	}
+	attributes := attribute.NewSet(operation.Attributes()...)
+	for key, expected := range map[attribute.Key]attribute.Value{
+		orgAttribute:         attribute.StringValue("acme"),
+		applicationAttribute: attribute.StringValue("playbook-dispatcher"),
+		resultsAttribute:     attribute.IntValue(1),
+	} {
+		if value, _ := attributes.Value(key); value != expected {
+			t.Errorf("expected %s to be %v, got %v", key, expected.Emit(), value.Emit())
+		}
+	}
+
+	for _, name := range []string{"checkPermissionForAnySubject", "lookupResourcesForAnySubject"} {
+		if span, ok := spans[name]; !ok || span.Parent().SpanID() != operation.SpanContext().SpanID() {
+			t.Errorf("expected a %s span within the request's", name)
+		}
+	}
+}
@@ -0,0 +1,323 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+)
+
+// DefaultWatchRetryInterval is how long a Watcher waits before watching again, or publishing again, after a failure.
+const DefaultWatchRetryInterval = 5 * time.Second
+
+// watchedObjectTypes are the resources whose relationships make up access; workspace hierarchy and the rbac/v1role
+// bookkeeping of role_bindings do not change anyone's access by themselves.
+var watchedObjectTypes = []string{"group", "role", "role_binding"}
+
+// AccessChangeType is what kind of access change an AccessChangeEvent reports.
+type AccessChangeType string
+
+const (
+	PrincipalAddedToGroup     AccessChangeType = "principal_added_to_group"
+	PrincipalRemovedFromGroup AccessChangeType = "principal_removed_from_group"
+	RoleBoundToGroup          AccessChangeType = "role_bound_to_group"
+	RoleUnboundFromGroup      AccessChangeType = "role_unbound_from_group"
+	RolePermissionsChanged    AccessChangeType = "role_permissions_changed"
+)
+
+// AccessChangeEvent is a relationship update in RBAC terms. Revision is the ZedToken the change is visible at, so that
+// a cache can tell whether what it holds predates the change.
+type AccessChangeEvent struct {
+	Type       AccessChangeType `json:"type"`
+	Group      string           `json:"group,omitempty"`
+	Principal  string           `json:"principal,omitempty"`
+	Role       string           `json:"role,omitempty"`
+	Workspace  string           `json:"workspace,omitempty"`
+	Permission string           `json:"permission,omitempty"`
+	Removed    bool             `json:"removed,omitempty"` // for role_permissions_changed, whether the permission was revoked
+	Revision   string           `json:"revision"`
+}
+
+// ChangeSink is where a Watcher publishes access changes. Publish is called again with the same events if it fails,
+// so sinks deliver at least once.
+type ChangeSink interface {
+	Publish(ctx context.Context, events []AccessChangeEvent) error
+}
+
+// CursorStore keeps the ZedToken a Watcher has published changes through, so that it resumes from there.
+type CursorStore interface {
+	// Load returns the stored ZedToken, or "" if there is none.
+	Load() (string, error)
+	Save(token string) error
+}
+
+// Watcher publishes the access changes SpiceDB's Watch API reports, and keeps its cursor after each publication. With
+// no stored cursor, it starts from the changes made after it starts.
+type Watcher struct {
+	Server        *PrbacSpicedbServer
+	Sink          ChangeSink
+	Cursor        CursorStore
+	RetryInterval time.Duration
+}
+
+// Run publishes changes until ctx is done, which is not reported as an error. Lost connections to SpiceDB are
+// resumed from the cursor.
+func (w *Watcher) Run(ctx context.Context) error {
+	cursor, err := w.Cursor.Load()
+	if err != nil {
+		return fmt.Errorf("loading watch cursor: %w", err)
+	}
+
+	for {
+		err := w.watch(ctx, &cursor)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		fmt.Printf("[WARN] Watch stopped, resuming from %q in %s: %v\n", cursor, w.retryInterval(), err)
+		if !sleep(ctx, w.retryInterval()) {
+			return nil
+		}
+	}
+}
+
+func (w *Watcher) watch(ctx context.Context, cursor *string) error {
+	request := &v1.WatchRequest{OptionalObjectTypes: watchedObjectTypes}
+	if *cursor != "" {
+		request.OptionalStartCursor = &v1.ZedToken{Token: *cursor}
+	}
+
+	stream, err := w.Server.SpicedbClient.Watch(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		token := response.GetChangesThrough().GetToken()
+		if events := w.Server.accessChangeEvents(response.GetUpdates(), token); len(events) != 0 {
+			if err := w.publish(ctx, events); err != nil {
+				return err
+			}
+		}
+
+		if err := w.Cursor.Save(token); err != nil {
+			return fmt.Errorf("saving watch cursor: %w", err)
+		}
+		*cursor = token
+	}
+}
+
+// publish publishes events, retrying until they are published or ctx is done, as changes after them must not be
+// published first.
+func (w *Watcher) publish(ctx context.Context, events []AccessChangeEvent) error {
+	for {
+		err := w.Sink.Publish(ctx, events)
+		if err == nil {
+			return nil
+		}
+
+		fmt.Printf("[WARN] Publishing %d access changes failed, retrying in %s: %v\n", len(events), w.retryInterval(), err)
+		if !sleep(ctx, w.retryInterval()) {
+			return ctx.Err()
+		}
+	}
+}
+
+func (w *Watcher) retryInterval() time.Duration {
+	if w.RetryInterval <= 0 {
+		return DefaultWatchRetryInterval
+	}
+
+	return w.RetryInterval
+}
+
+// sleep waits for d, reporting false if ctx is done first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+// accessChangeEvents translates relationship updates back into what was done in RBAC terms, as written by
+// AddPrincipalToGroup, AddRoleToGroup and CreateRole:
+//   - group:<group>#member@user:<principal> is a principal's membership of a group
+//   - role_binding:<binding>#subject@group:<group>#member binds a group to the role of the binding, which is either
+//     one of a role's own bindings, <role> or <role>_<workspace>, or a group's binding to a system role at the root
+//     workspace, <group>_<role>
+//   - role:<role>#<permission>@user:* and the granted relation of a role's own bindings are the role's permissions
+//
+// Updates with no meaning in RBAC terms are left out.
+func (p *PrbacSpicedbServer) accessChangeEvents(updates []*v1.RelationshipUpdate, revision string) []AccessChangeEvent {
+	var events []AccessChangeEvent
+
+	for _, update := range updates {
+		relationship := update.GetRelationship()
+		resourceId := relationship.GetResource().GetObjectId()
+		subjectId := relationship.GetSubject().GetObject().GetObjectId()
+		removed := update.GetOperation() == v1.RelationshipUpdate_OPERATION_DELETE
+
+		event := AccessChangeEvent{Revision: revision}
+
+		switch relationship.GetResource().GetObjectType() + "#" + relationship.GetRelation() {
+		case "group#member":
+			if relationship.GetSubject().GetObject().GetObjectType() != "user" {
+				continue
+			}
+
+			event.Type, event.Group, event.Principal = PrincipalAddedToGroup, resourceId, subjectId
+			if removed {
+				event.Type = PrincipalRemovedFromGroup
+			}
+		case "role_binding#subject":
+			if relationship.GetSubject().GetObject().GetObjectType() != "group" {
+				continue
+			}
+
+			event.Type, event.Group = RoleBoundToGroup, subjectId
+			event.Role, event.Workspace = bindingRole(resourceId)
+			if removed {
+				event.Type = RoleUnboundFromGroup
+			}
+		case "role_binding#granted":
+			role, workspace := bindingRole(resourceId)
+			if role == subjectId {
+				continue // a group's binding to a system role, reported by its subject
+			}
+
+			permission, ok := permissionForSystemRole(subjectId)
+			if !ok {
+				continue
+			}
+
+			event.Type, event.Role, event.Workspace, event.Permission, event.Removed = RolePermissionsChanged, role, workspace, permission, removed
+		default:
+			if relationship.GetResource().GetObjectType() != "role" || isSystemRole(resourceId) {
+				continue
+			}
+
+			permission, ok := p.permissionFromRelation(relationship.GetRelation())
+			if !ok {
+				continue
+			}
+
+			event.Type, event.Role, event.Permission, event.Removed = RolePermissionsChanged, resourceId, permission, removed
+		}
+
+		events = append(events, event)
+	}
+
+	return events
+}
+
+// bindingRole finds the role a role_binding binds from its id, as roleUpdates and rootBindingUpdates name them, and
+// the workspace it is limited to, if any.
+func bindingRole(bindingId string) (role, workspace string) {
+	prefix, suffix, found := strings.Cut(bindingId, "_")
+	if !found {
+		return bindingId, ""
+	}
+
+	if isSystemRole(suffix) {
+		return suffix, ""
+	}
+
+	return prefix, suffix
+}
+
+// LogChangeSink writes access changes to stdout as JSON.
+type LogChangeSink struct{}
+
+func (LogChangeSink) Publish(ctx context.Context, events []AccessChangeEvent) error {
+	for _, event := range events {
+		encoded, _ := json.Marshal(event)
+		fmt.Printf("[INFO] access change: %s\n", encoded)
+	}
+
+	return nil
+}
+
+// WebhookChangeSink posts access changes to a URL as {"events": [...]}.
+type WebhookChangeSink struct {
+	URL        string
+	HTTPClient *http.Client
+}
+
+func (s *WebhookChangeSink) Publish(ctx context.Context, events []AccessChangeEvent) error {
+	body, err := json.Marshal(map[string][]AccessChangeEvent{"events": events})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	client := s.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return fmt.Errorf("webhook returned %d: %s", response.StatusCode, body)
+	}
+
+	return nil
+}
+
+// FileCursorStore keeps the cursor in a file, replaced whole on each save so that a crash never leaves half a token.
+type FileCursorStore struct {
+	Path string
+}
+
+func (s *FileCursorStore) Load() (string, error) {
+	token, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+
+	return strings.TrimSpace(string(token)), err
+}
+
+func (s *FileCursorStore) Save(token string) error {
+	temp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+
+	if _, err := temp.WriteString(token); err != nil {
+		temp.Close()
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(temp.Name(), s.Path)
+}
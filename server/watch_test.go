@@ -0,0 +1,186 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+// recordingChangeSink keeps what is published to it, after failing as many times as it is told to.
+type recordingChangeSink struct {
+	mu       sync.Mutex
+	events   []AccessChangeEvent
+	failures int
+	attempts int
+}
+
+func (s *recordingChangeSink) Publish(ctx context.Context, events []AccessChangeEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.attempts++
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("sink unavailable")
+	}
+
+	s.events = append(s.events, events...)
+	return nil
+}
+
+func (s *recordingChangeSink) published() []AccessChangeEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]AccessChangeEvent(nil), s.events...)
+}
+
+type memoryCursorStore struct {
+	mu    sync.Mutex
+	token string
+}
+
+func (s *memoryCursorStore) Load() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.token, nil
+}
+
+func (s *memoryCursorStore) Save(token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = token
+	return nil
+}
+
+// startWatcher runs a watcher until the returned function is called, which waits for it to stop.
+func startWatcher(t *testing.T, p *PrbacSpicedbServer, sink ChangeSink, cursor CursorStore) func() {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- (&Watcher{Server: p, Sink: sink, Cursor: cursor, RetryInterval: time.Millisecond}).Run(ctx)
+	}()
+
+	return func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+// awaitEvents waits for the sink to have published n events.
+func awaitEvents(t *testing.T, sink *recordingChangeSink, n int) []AccessChangeEvent {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		events := sink.published()
+		if len(events) >= n || time.Now().After(deadline) {
+			return events
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestWatcher(t *testing.T) {
+	p, _ := newTestServer(t, "workspace:ws1#parent@workspace:acme_root", "workspace:ws1#tenant@organization:acme")
+	ctx := withIdentity(context.Background(), "acme", "alice", true)
+
+	sink := &recordingChangeSink{failures: 2}
+	cursor := &memoryCursorStore{token: "revision-0"} // from the start, as writes may be made before the watch starts
+	stop := startWatcher(t, p, sink, cursor)
+
+	groupId := uuid.New()
+	if _, err := p.AddPrincipalToGroup(ctx, api.AddPrincipalToGroupRequestObject{Uuid: groupId, Body: &api.GroupPrincipalIn{
+		Principals: []api.PrincipalIn{{Username: "alice"}},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := p.CreateRole(ctx, api.CreateRoleRequestObject{Body: &api.RoleIn{
+		Name: "hosts",
+		Access: []api.Access{
+			{Permission: "inventory:hosts:read"},
+			{Permission: "inventory:hosts:write", ResourceDefinitions: []api.ResourceDefinition{
+				{AttributeFilter: api.ResourceDefinitionFilter{Key: "group.id", Operation: api.Equal, Value: "ws1"}},
+			}},
+		},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	roleId := created.(api.CreateRole201JSONResponse).Uuid
+
+	groupsRead := uuid.MustParse(permissionsToSystemRoles["inventory:groups:read"])
+	if _, err := p.AddRoleToGroup(ctx, api.AddRoleToGroupRequestObject{Uuid: groupId, Body: &api.GroupRoleIn{
+		Roles: []uuid.UUID{roleId, groupsRead},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []AccessChangeEvent{
+		{Type: PrincipalAddedToGroup, Group: groupId.String(), Principal: "alice", Revision: "revision-1"},
+		{Type: RolePermissionsChanged, Role: roleId.String(), Permission: "inventory:hosts:read", Revision: "revision-2"},
+		{Type: RolePermissionsChanged, Role: roleId.String(), Workspace: "ws1", Permission: "inventory:hosts:write", Revision: "revision-2"},
+		{Type: RoleBoundToGroup, Group: groupId.String(), Role: roleId.String(), Revision: "revision-3"},
+		{Type: RoleBoundToGroup, Group: groupId.String(), Role: roleId.String(), Workspace: "ws1", Revision: "revision-3"},
+		{Type: RoleBoundToGroup, Group: groupId.String(), Role: groupsRead.String(), Revision: "revision-3"},
+	}
+
+	events := awaitEvents(t, sink, len(expected))
+	stop()
+
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("unexpected events:\nexpected %+v\ngot      %+v", expected, events)
+	}
+	if sink.attempts != 3+2 {
+		t.Errorf("expected the first publication to be retried until it succeeded, got %d attempts", sink.attempts)
+	}
+	if cursor.token != "revision-3" {
+		t.Errorf("expected the cursor at revision-3, got %q", cursor.token)
+	}
+
+	// Changes made while the watcher is down are published once it is back, and nothing before them is published again
+	if _, err := p.DeletePrincipalFromGroup(ctx, api.DeletePrincipalFromGroupRequestObject{Uuid: groupId, Params: api.DeletePrincipalFromGroupParams{Usernames: "alice"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	sink = &recordingChangeSink{}
+	stop = startWatcher(t, p, sink, cursor)
+	events = awaitEvents(t, sink, 1)
+	stop()
+
+	expected = []AccessChangeEvent{{Type: PrincipalRemovedFromGroup, Group: groupId.String(), Principal: "alice", Revision: "revision-4"}}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("unexpected events after restart: %+v", events)
+	}
+}
+
+func TestFileCursorStore(t *testing.T) {
+	store := &FileCursorStore{Path: filepath.Join(t.TempDir(), "cursor")}
+
+	if token, err := store.Load(); err != nil || token != "" {
+		t.Fatalf("expected no cursor, got %q, %v", token, err)
+	}
+
+	for _, token := range []string{"GhUKEzE2OTk", "GhUKEzE3MDA"} {
+		if err := store.Save(token); err != nil {
+			t.Fatal(err)
+		}
+		if loaded, err := store.Load(); err != nil || loaded != token {
+			t.Errorf("expected %q, got %q, %v", token, loaded, err)
+		}
+	}
+}
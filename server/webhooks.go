@@ -0,0 +1,692 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/google/uuid"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+const (
+	// DefaultWebhookAttempts is how many times a delivery is attempted before it is given up on.
+	DefaultWebhookAttempts = 5
+	// DefaultWebhookBackoff is how long the first retry of a delivery waits; each further retry waits twice as long.
+	DefaultWebhookBackoff = time.Second
+
+	webhookTimeout = 10 * time.Second
+)
+
+// Webhook is a tenant's registration to be notified of changes.
+type Webhook struct {
+	Id         string
+	OrgId      string
+	URL        string
+	Secret     string
+	EventTypes []api.WebhookEventType
+	Created    time.Time
+}
+
+// WebhookStore keeps the webhooks of each tenant. List returns a tenant's webhooks oldest first.
+type WebhookStore interface {
+	List(org string) ([]Webhook, error)
+	Get(org, id string) (Webhook, bool, error)
+	Put(webhook Webhook) error
+	Delete(org, id string) (bool, error)
+}
+
+type inMemoryWebhookStore struct {
+	mu       sync.RWMutex
+	webhooks map[string]map[string]Webhook
+}
+
+// NewInMemoryWebhookStore keeps webhooks for as long as the process runs, for tests and dev mode.
+func NewInMemoryWebhookStore() WebhookStore {
+	return &inMemoryWebhookStore{webhooks: make(map[string]map[string]Webhook)}
+}
+
+func (s *inMemoryWebhookStore) List(org string) ([]Webhook, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	webhooks := make([]Webhook, 0, len(s.webhooks[org]))
+	for _, webhook := range s.webhooks[org] {
+		webhooks = append(webhooks, webhook)
+	}
+	sort.Slice(webhooks, func(i, j int) bool {
+		if !webhooks[i].Created.Equal(webhooks[j].Created) {
+			return webhooks[i].Created.Before(webhooks[j].Created)
+		}
+		return webhooks[i].Id < webhooks[j].Id
+	})
+
+	return webhooks, nil
+}
+
+func (s *inMemoryWebhookStore) Get(org, id string) (Webhook, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	webhook, ok := s.webhooks[org][id]
+	return webhook, ok, nil
+}
+
+func (s *inMemoryWebhookStore) Put(webhook Webhook) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.webhooks[webhook.OrgId] == nil {
+		s.webhooks[webhook.OrgId] = make(map[string]Webhook)
+	}
+	s.webhooks[webhook.OrgId][webhook.Id] = webhook
+	return nil
+}
+
+func (s *inMemoryWebhookStore) Delete(org, id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.webhooks[org][id]
+	delete(s.webhooks[org], id)
+
+	return ok, nil
+}
+
+// SQLWebhookStore keeps webhooks in a SQL database, in the webhooks table, with their event types as a JSON array.
+// Statements use $n placeholders, as PostgreSQL and SQLite both accept.
+type SQLWebhookStore struct {
+	db *sql.DB
+}
+
+// NewSQLWebhookStore creates the webhooks table in db unless it exists.
+func NewSQLWebhookStore(db *sql.DB) (*SQLWebhookStore, error) {
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS webhooks (
+			id TEXT PRIMARY KEY,
+			org_id TEXT NOT NULL,
+			url TEXT NOT NULL,
+			secret TEXT NOT NULL,
+			event_types TEXT NOT NULL,
+			created BIGINT NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS webhooks_org_created ON webhooks (org_id, created)`,
+	}
+
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			return nil, fmt.Errorf("creating webhooks table: %w", err)
+		}
+	}
+
+	return &SQLWebhookStore{db: db}, nil
+}
+
+func (s *SQLWebhookStore) List(org string) ([]Webhook, error) {
+	rows, err := s.db.Query(`SELECT id, org_id, url, secret, event_types, created FROM webhooks WHERE org_id = $1 ORDER BY created, id`, org)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	webhooks := make([]Webhook, 0)
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, rows.Err()
+}
+
+func (s *SQLWebhookStore) Get(org, id string) (Webhook, bool, error) {
+	webhook, err := scanWebhook(s.db.QueryRow(`SELECT id, org_id, url, secret, event_types, created FROM webhooks WHERE org_id = $1 AND id = $2`, org, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return Webhook{}, false, nil
+	}
+	if err != nil {
+		return Webhook{}, false, err
+	}
+
+	return webhook, true, nil
+}
+
+func (s *SQLWebhookStore) Put(webhook Webhook) error {
+	eventTypes, err := json.Marshal(webhook.EventTypes)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Exec(`INSERT INTO webhooks (id, org_id, url, secret, event_types, created) VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (id) DO UPDATE SET url = excluded.url, secret = excluded.secret, event_types = excluded.event_types`,
+		webhook.Id, webhook.OrgId, webhook.URL, webhook.Secret, string(eventTypes), webhook.Created.UnixNano())
+	return err
+}
+
+func (s *SQLWebhookStore) Delete(org, id string) (bool, error) {
+	result, err := s.db.Exec(`DELETE FROM webhooks WHERE org_id = $1 AND id = $2`, org, id)
+	if err != nil {
+		return false, err
+	}
+
+	deleted, err := result.RowsAffected()
+	return deleted != 0, err
+}
+
+// scanWebhook reads a webhook from a row of the webhooks table.
+func scanWebhook(row interface{ Scan(dest ...any) error }) (Webhook, error) {
+	var webhook Webhook
+	var eventTypes string
+	var created int64
+	if err := row.Scan(&webhook.Id, &webhook.OrgId, &webhook.URL, &webhook.Secret, &eventTypes, &created); err != nil {
+		return Webhook{}, err
+	}
+
+	webhook.Created = time.Unix(0, created).UTC()
+	return webhook, json.Unmarshal([]byte(eventTypes), &webhook.EventTypes)
+}
+
+// WebhookEvent is the body of a webhook delivery, as the WebhookEvent schema documents it.
+type WebhookEvent struct {
+	Id          string               `json:"id"`
+	Type        api.WebhookEventType `json:"type"`
+	OrgId       string               `json:"org_id"`
+	OccurredAt  time.Time            `json:"occurred_at"`
+	Actor       string               `json:"actor,omitempty"`
+	Group       string               `json:"group,omitempty"`
+	Role        string               `json:"role,omitempty"`
+	Name        string               `json:"name,omitempty"`
+	Principals  []string             `json:"principals,omitempty"`
+	Roles       []string             `json:"roles,omitempty"`
+	Permissions []string             `json:"permissions,omitempty"`
+}
+
+// WebhookNotifier delivers events to the webhooks subscribed to them, in the background, retrying failed deliveries
+// with exponential backoff.
+type WebhookNotifier struct {
+	Store      WebhookStore
+	HTTPClient *http.Client // nil for webhookHTTPClient
+	Attempts   int
+	Backoff    time.Duration
+
+	deliveries sync.WaitGroup
+	once       sync.Once
+	ctx        context.Context // cancelled by Shutdown, abandoning deliveries under way
+	abandon    context.CancelFunc
+}
+
+func NewWebhookNotifier(store WebhookStore) *WebhookNotifier {
+	return &WebhookNotifier{Store: store, Attempts: DefaultWebhookAttempts, Backoff: DefaultWebhookBackoff}
+}
+
+// context is cancelled once deliveries are to be abandoned.
+func (n *WebhookNotifier) context() context.Context {
+	n.once.Do(func() {
+		n.ctx, n.abandon = context.WithCancel(context.Background())
+	})
+
+	return n.ctx
+}
+
+// Subscribed reports whether any of a tenant's webhooks is subscribed to an event type, so that events costly to
+// work out are only worked out when needed.
+func (n *WebhookNotifier) Subscribed(org string, eventType api.WebhookEventType) bool {
+	webhooks, err := n.Store.List(org)
+	if err != nil {
+		fmt.Printf("[ERROR] Listing the webhooks of %s: %v\n", org, err)
+		return false
+	}
+
+	for _, webhook := range webhooks {
+		if slices.Contains(webhook.EventTypes, eventType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Notify starts delivering an event to each of the tenant's webhooks subscribed to it.
+func (n *WebhookNotifier) Notify(event WebhookEvent) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		fmt.Printf("[ERROR] Encoding webhook event %s: %v\n", event.Id, err)
+		return
+	}
+
+	webhooks, err := n.Store.List(event.OrgId)
+	if err != nil {
+		fmt.Printf("[ERROR] Listing the webhooks to deliver event %s to: %v\n", event.Id, err)
+		return
+	}
+
+	ctx := n.context()
+	for _, webhook := range webhooks {
+		if !slices.Contains(webhook.EventTypes, event.Type) {
+			continue
+		}
+
+		n.deliveries.Add(1)
+		go func(webhook Webhook) {
+			defer n.deliveries.Done()
+			n.deliver(ctx, webhook, event, body)
+		}(webhook)
+	}
+}
+
+// Wait waits for deliveries under way to succeed or be given up on.
+func (n *WebhookNotifier) Wait() {
+	n.deliveries.Wait()
+}
+
+// Shutdown waits for deliveries under way, as Wait does, until ctx is done. Deliveries still under way then, whether
+// attempting or waiting to retry, are abandoned, and it returns once they have stopped.
+func (n *WebhookNotifier) Shutdown(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		n.deliveries.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		n.context()
+		n.abandon()
+		<-done
+		return ctx.Err()
+	}
+}
+
+func (n *WebhookNotifier) deliver(ctx context.Context, webhook Webhook, event WebhookEvent, body []byte) {
+	backoff := n.Backoff
+	attempts := n.Attempts
+	if attempts <= 0 {
+		attempts = DefaultWebhookAttempts
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := n.post(ctx, webhook, event, body)
+		if err == nil {
+			return
+		}
+
+		if attempt == attempts {
+			fmt.Printf("[ERROR] Giving up delivering webhook event %s to %s after %d attempts: %v\n", event.Id, webhook.URL, attempts, err)
+			return
+		}
+
+		fmt.Printf("[WARN] Delivering webhook event %s to %s failed, retrying in %s: %v\n", event.Id, webhook.URL, backoff, err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			fmt.Printf("[ERROR] Abandoning webhook event %s to %s after %d attempts, shutting down\n", event.Id, webhook.URL, attempt)
+			return
+		}
+		backoff *= 2
+	}
+}
+
+func (n *WebhookNotifier) post(ctx context.Context, webhook Webhook, event WebhookEvent, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	// Signed afresh for each attempt, so that receivers can reject deliveries with old timestamps as replays
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Prbac-Event", string(event.Type))
+	request.Header.Set("X-Prbac-Delivery", event.Id)
+	request.Header.Set("X-Prbac-Timestamp", timestamp)
+	request.Header.Set("X-Prbac-Signature", signWebhookPayload(webhook.Secret, timestamp, body))
+
+	client := n.HTTPClient
+	if client == nil {
+		client = webhookHTTPClient
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return fmt.Errorf("webhook returned %d: %s", response.StatusCode, body)
+	}
+
+	return nil
+}
+
+// webhookHTTPClient delivers webhooks. Tenants choose where deliveries go, so it refuses to connect to addresses
+// internal to the service's network, whatever a webhook's host resolves to at the time of each connection, and
+// connects without a proxy, which would connect on its behalf unchecked.
+var webhookHTTPClient = func() *http.Client {
+	dialer := &net.Dialer{Timeout: webhookTimeout, Control: refuseInternalAddresses}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{Transport: transport}
+}()
+
+// refuseInternalAddresses is a net.Dialer Control refusing connections to internal addresses.
+func refuseInternalAddresses(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	if ip, err := netip.ParseAddr(host); err != nil || isInternalAddress(ip) {
+		return fmt.Errorf("refusing to connect to internal address %s", host)
+	}
+
+	return nil
+}
+
+// internalPrefixes are the address ranges webhooks may not be delivered to: those of the local host and network,
+// private and shared address space, link-local addresses such as that of a cloud metadata service, multicast, and
+// reserved ranges. IPv6 addresses that translate to IPv4 ones through NAT64 are refused whatever IPv4 address they
+// embed.
+var internalPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),      // this network
+	netip.MustParsePrefix("10.0.0.0/8"),     // private
+	netip.MustParsePrefix("100.64.0.0/10"),  // shared address space, carrier-grade NAT
+	netip.MustParsePrefix("127.0.0.0/8"),    // loopback
+	netip.MustParsePrefix("169.254.0.0/16"), // link-local
+	netip.MustParsePrefix("172.16.0.0/12"),  // private
+	netip.MustParsePrefix("192.0.0.0/24"),   // IETF protocol assignments
+	netip.MustParsePrefix("192.168.0.0/16"), // private
+	netip.MustParsePrefix("198.18.0.0/15"),  // benchmarking
+	netip.MustParsePrefix("224.0.0.0/4"),    // multicast
+	netip.MustParsePrefix("240.0.0.0/4"),    // reserved, and broadcast
+	netip.MustParsePrefix("::/128"),         // unspecified
+	netip.MustParsePrefix("::1/128"),        // loopback
+	netip.MustParsePrefix("64:ff9b::/96"),   // NAT64
+	netip.MustParsePrefix("64:ff9b:1::/48"), // local-use NAT64
+	netip.MustParsePrefix("fc00::/7"),       // unique local
+	netip.MustParsePrefix("fe80::/10"),      // link-local
+	netip.MustParsePrefix("ff00::/8"),       // multicast
+}
+
+// isInternalAddress reports whether an address is in one of internalPrefixes, IPv4-mapped IPv6 addresses as the
+// IPv4 addresses they map.
+func isInternalAddress(ip netip.Addr) bool {
+	ip = ip.Unmap().WithZone("")
+
+	for _, prefix := range internalPrefixes {
+		if prefix.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// signWebhookPayload signs a delivery as sha256=<hex HMAC-SHA256 of "<timestamp>.<body>">.
+func signWebhookPayload(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// notify delivers an event about a change the caller made to the caller's organization's webhooks.
+func (p *PrbacSpicedbServer) notify(ctx context.Context, event WebhookEvent) {
+	if p.Webhooks == nil {
+		return
+	}
+
+	event.Id = uuid.NewString()
+	event.OrgId = getUserOrg(ctx)
+	event.OccurredAt = time.Now().UTC()
+	if identity, ok := identityFromContext(ctx); ok {
+		event.Actor = identity.Username
+	}
+
+	p.Webhooks.Notify(event)
+}
+
+// notifyPrincipalsAdded notifies of principals added to a group, and whether the group administers user access.
+func (p *PrbacSpicedbServer) notifyPrincipalsAdded(ctx context.Context, groupId string, usernames []string) {
+	if p.Webhooks == nil {
+		return
+	}
+
+	p.notify(ctx, WebhookEvent{Type: api.GroupPrincipalsAdded, Group: groupId, Principals: usernames})
+
+	if !p.Webhooks.Subscribed(getUserOrg(ctx), api.AdminGroupPrincipalsAdded) {
+		return
+	}
+
+	admin, err := p.isAdminGroup(ctx, groupId)
+	if err != nil {
+		fmt.Printf("[ERROR] Checking whether group %s is an admin group: %v\n", groupId, err)
+		return
+	}
+	if admin {
+		p.notify(ctx, WebhookEvent{Type: api.AdminGroupPrincipalsAdded, Group: groupId, Principals: usernames})
+	}
+}
+
+// notifyRoleWritePermissions notifies of the write permissions in accesses that are not in previous.
+func (p *PrbacSpicedbServer) notifyRoleWritePermissions(ctx context.Context, roleId, name string, accesses, previous []api.Access) {
+	var gained []string
+	for _, access := range accesses {
+		if !isWritePermission(access.Permission) || slices.Contains(gained, access.Permission) {
+			continue
+		}
+
+		if !slices.ContainsFunc(previous, func(a api.Access) bool { return a.Permission == access.Permission }) {
+			gained = append(gained, access.Permission)
+		}
+	}
+
+	if len(gained) != 0 {
+		sort.Strings(gained)
+		p.notify(ctx, WebhookEvent{Type: api.RoleWritePermissionGranted, Role: roleId, Name: name, Permissions: gained})
+	}
+}
+
+// isAdminGroup reports whether a group administers user access: the organization's admin_default group, or a group
+// bound to the User Access Administrator role or to a role with unrestricted rbac write permissions.
+func (p *PrbacSpicedbServer) isAdminGroup(ctx context.Context, groupId string) (bool, error) {
+	if groupId == getDefaultGroupId(getUserOrg(ctx), "admin_default") {
+		return true, nil
+	}
+
+	roles, err := p.getGroupRoles(ctx, groupId)
+	if err != nil {
+		return false, err
+	}
+
+	for _, role := range roles {
+		if role == userAccessAdministratorRole {
+			return true, nil
+		}
+		if isSystemRole(role) {
+			continue
+		}
+
+		roleRelationships, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+			ResourceType:       "rbac/v1role",
+			OptionalResourceId: role,
+		})
+		if err != nil {
+			return false, err
+		}
+
+		accesses, err := p.getRoleAccesses(ctx, role, roleRelationships)
+		if err != nil {
+			return false, err
+		}
+		for _, access := range accesses {
+			if strings.HasPrefix(access.Permission, "rbac:") && isWritePermission(access.Permission) && len(access.ResourceDefinitions) == 0 {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// isWritePermission reports whether an application:resource:verb permission allows writing.
+func isWritePermission(permission string) bool {
+	verb := permission[strings.LastIndex(permission, ":")+1:]
+	return verb == "write" || verb == "*"
+}
+
+func (p *PrbacSpicedbServer) ListWebhooks(ctx context.Context, request api.ListWebhooksRequestObject) (api.ListWebhooksResponseObject, error) {
+	if !isOrgAdmin(ctx) {
+		return api.ListWebhooks403JSONResponse(forbiddenBody("only organization administrators can manage webhooks")), nil
+	}
+	if p.Webhooks == nil {
+		return api.ListWebhooks500JSONResponse(errorBody(500, "webhooks are not enabled")), nil
+	}
+
+	webhooks, err := p.Webhooks.Store.List(getUserOrg(ctx))
+	if err != nil {
+		return api.ListWebhooks500JSONResponse(errorBody(500, err.Error())), nil
+	}
+	start, end, pageSize := pageBounds(len(webhooks), request.Params.Limit, request.Params.Offset)
+
+	data := make([]api.Webhook, 0, end-start)
+	for _, webhook := range webhooks[start:end] {
+		data = append(data, toApiWebhook(webhook))
+	}
+
+	return api.ListWebhooks200JSONResponse{
+		Data:  data,
+		Links: p.paginationLinks("/webhooks/", len(webhooks), start, pageSize),
+		Meta:  paginationMeta(len(webhooks)),
+	}, nil
+}
+
+func (p *PrbacSpicedbServer) CreateWebhook(ctx context.Context, request api.CreateWebhookRequestObject) (api.CreateWebhookResponseObject, error) {
+	if !isOrgAdmin(ctx) {
+		return api.CreateWebhook403JSONResponse(forbiddenBody("only organization administrators can manage webhooks")), nil
+	}
+	if p.Webhooks == nil {
+		return api.CreateWebhook500JSONResponse(errorBody(500, "webhooks are not enabled")), nil
+	}
+
+	target, err := url.Parse(request.Body.Url)
+	if err != nil || target.Scheme != "https" || target.Hostname() == "" {
+		return api.CreateWebhook400JSONResponse(errorBody(400, "url must be an absolute https URL")), nil
+	}
+	// Hosts resolving to internal addresses are refused on delivery, see webhookHTTPClient; these are refused up front
+	if ip, err := netip.ParseAddr(target.Hostname()); strings.EqualFold(target.Hostname(), "localhost") || (err == nil && isInternalAddress(ip)) {
+		return api.CreateWebhook400JSONResponse(errorBody(400, "url must not be that of an internal address")), nil
+	}
+	if request.Body.Secret == nil || len(*request.Body.Secret) < 16 {
+		return api.CreateWebhook400JSONResponse(errorBody(400, "secret must be at least 16 characters")), nil
+	}
+	if len(request.Body.EventTypes) == 0 {
+		return api.CreateWebhook400JSONResponse(errorBody(400, "event_types must not be empty")), nil
+	}
+	for _, eventType := range request.Body.EventTypes {
+		if !isWebhookEventType(eventType) {
+			return api.CreateWebhook400JSONResponse(errorBody(400, "unknown event type: "+string(eventType))), nil
+		}
+	}
+
+	webhook := Webhook{
+		Id:         uuid.NewString(),
+		OrgId:      getUserOrg(ctx),
+		URL:        request.Body.Url,
+		Secret:     *request.Body.Secret,
+		EventTypes: request.Body.EventTypes,
+		Created:    time.Now().UTC(),
+	}
+	if err := p.Webhooks.Store.Put(webhook); err != nil {
+		return api.CreateWebhook500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	return api.CreateWebhook201JSONResponse(toApiWebhook(webhook)), nil
+}
+
+func (p *PrbacSpicedbServer) GetWebhook(ctx context.Context, request api.GetWebhookRequestObject) (api.GetWebhookResponseObject, error) {
+	if !isOrgAdmin(ctx) {
+		return api.GetWebhook403JSONResponse(forbiddenBody("only organization administrators can manage webhooks")), nil
+	}
+	if p.Webhooks == nil {
+		return api.GetWebhook500JSONResponse(errorBody(500, "webhooks are not enabled")), nil
+	}
+
+	webhook, ok, err := p.Webhooks.Store.Get(getUserOrg(ctx), request.Uuid.String())
+	if err != nil {
+		return api.GetWebhook500JSONResponse(errorBody(500, err.Error())), nil
+	}
+	if !ok {
+		return api.GetWebhook404JSONResponse(errorBody(404, "webhook not found: "+request.Uuid.String())), nil
+	}
+
+	return api.GetWebhook200JSONResponse(toApiWebhook(webhook)), nil
+}
+
+func (p *PrbacSpicedbServer) DeleteWebhook(ctx context.Context, request api.DeleteWebhookRequestObject) (api.DeleteWebhookResponseObject, error) {
+	if !isOrgAdmin(ctx) {
+		return api.DeleteWebhook403JSONResponse(forbiddenBody("only organization administrators can manage webhooks")), nil
+	}
+	if p.Webhooks == nil {
+		return api.DeleteWebhook500JSONResponse(errorBody(500, "webhooks are not enabled")), nil
+	}
+
+	deleted, err := p.Webhooks.Store.Delete(getUserOrg(ctx), request.Uuid.String())
+	if err != nil {
+		return api.DeleteWebhook500JSONResponse(errorBody(500, err.Error())), nil
+	}
+	if !deleted {
+		return api.DeleteWebhook404JSONResponse(errorBody(404, "webhook not found: "+request.Uuid.String())), nil
+	}
+
+	return api.DeleteWebhook204Response{}, nil
+}
+
+func toApiWebhook(webhook Webhook) api.Webhook {
+	return api.Webhook{
+		Uuid:       uuid.MustParse(webhook.Id),
+		Url:        webhook.URL,
+		EventTypes: webhook.EventTypes,
+		Created:    webhook.Created,
+	}
+}
+
+func isWebhookEventType(eventType api.WebhookEventType) bool {
+	switch eventType {
+	case api.GroupPrincipalsAdded, api.GroupPrincipalsRemoved, api.GroupRolesAdded, api.AdminGroupPrincipalsAdded,
+		api.RoleCreated, api.RoleUpdated, api.RoleWritePermissionGranted:
+		return true
+	}
+
+	return false
+}
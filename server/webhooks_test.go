@@ -0,0 +1,432 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+// webhookReceiver is a tenant's webhook endpoint, failing as many deliveries as it is told to before accepting them.
+type webhookReceiver struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	failures   int
+	attempts   []string // X-Prbac-Delivery of each attempt
+	deliveries []receivedDelivery
+}
+
+type receivedDelivery struct {
+	header http.Header
+	body   []byte
+	event  WebhookEvent
+}
+
+// webhookReceiverURL is what a webhookReceiver is registered as, a host its certificate is valid for.
+const webhookReceiverURL = "https://example.com/rbac"
+
+// newWebhookReceiver receives p's webhook deliveries, whatever host they are for.
+func newWebhookReceiver(t *testing.T, p *PrbacSpicedbServer, failures int) *webhookReceiver {
+	r := &webhookReceiver{failures: failures}
+	r.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		r.attempts = append(r.attempts, req.Header.Get("X-Prbac-Delivery"))
+		if r.failures > 0 {
+			r.failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var event WebhookEvent
+		if err := json.Unmarshal(body, &event); err != nil {
+			t.Error(err)
+		}
+		r.deliveries = append(r.deliveries, receivedDelivery{header: req.Header.Clone(), body: body, event: event})
+	}))
+	t.Cleanup(r.Close)
+
+	transport := r.Client().Transport.(*http.Transport).Clone()
+	transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, r.Listener.Addr().String())
+	}
+	p.Webhooks.HTTPClient = &http.Client{Transport: transport}
+
+	return r
+}
+
+func (r *webhookReceiver) eventTypes() []api.WebhookEventType {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var types []api.WebhookEventType
+	for _, delivery := range r.deliveries {
+		types = append(types, delivery.event.Type)
+	}
+	slices.Sort(types)
+
+	return types
+}
+
+func newWebhookTestServer(t *testing.T) (*PrbacSpicedbServer, context.Context) {
+	t.Helper()
+
+	notifier := NewWebhookNotifier(NewInMemoryWebhookStore())
+	notifier.Backoff = time.Millisecond
+
+	p, _ := newTestServer(t)
+	p.Webhooks = notifier
+	ctx := withIdentity(context.Background(), "acme", "alice", true)
+
+	return p, ctx
+}
+
+func registerWebhook(t *testing.T, p *PrbacSpicedbServer, ctx context.Context, url string, eventTypes ...api.WebhookEventType) api.Webhook {
+	t.Helper()
+
+	secret := "0123456789abcdef"
+	resp, err := p.CreateWebhook(ctx, api.CreateWebhookRequestObject{Body: &api.WebhookIn{Url: url, Secret: &secret, EventTypes: eventTypes}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, ok := resp.(api.CreateWebhook201JSONResponse)
+	if !ok {
+		t.Fatalf("expected 201 response, got %T", resp)
+	}
+
+	return api.Webhook(created)
+}
+
+func TestWebhookDelivery(t *testing.T) {
+	p, ctx := newWebhookTestServer(t)
+	receiver := newWebhookReceiver(t, p, 2)
+	registerWebhook(t, p, ctx, webhookReceiverURL, api.GroupPrincipalsAdded, api.AdminGroupPrincipalsAdded)
+
+	adminGroup := uuid.MustParse(getDefaultGroupId("acme", "admin_default"))
+	if _, err := p.AddPrincipalToGroup(ctx, api.AddPrincipalToGroupRequestObject{Uuid: adminGroup, Body: &api.GroupPrincipalIn{
+		Principals: []api.PrincipalIn{{Username: "mallory"}},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	p.Webhooks.Wait()
+
+	if types := receiver.eventTypes(); !slices.Equal(types, []api.WebhookEventType{api.AdminGroupPrincipalsAdded, api.GroupPrincipalsAdded}) {
+		t.Fatalf("unexpected deliveries %v", types)
+	}
+	if len(receiver.attempts) != 4 {
+		t.Errorf("expected the failed deliveries to be retried, got %d attempts", len(receiver.attempts))
+	}
+
+	for _, delivery := range receiver.deliveries {
+		event := delivery.event
+		if event.OrgId != "acme" || event.Actor != "alice" || event.Group != adminGroup.String() || !slices.Equal(event.Principals, []string{"mallory"}) {
+			t.Errorf("unexpected event %+v", event)
+		}
+		if delivery.header.Get("X-Prbac-Delivery") != event.Id || delivery.header.Get("X-Prbac-Event") != string(event.Type) {
+			t.Errorf("unexpected headers %v", delivery.header)
+		}
+
+		expected := signWebhookPayload("0123456789abcdef", delivery.header.Get("X-Prbac-Timestamp"), delivery.body)
+		if signature := delivery.header.Get("X-Prbac-Signature"); signature != expected {
+			t.Errorf("expected signature %s, got %s", expected, signature)
+		}
+	}
+}
+
+func TestWebhookDeliveryGivesUp(t *testing.T) {
+	p, ctx := newWebhookTestServer(t)
+	p.Webhooks.Attempts = 3
+	receiver := newWebhookReceiver(t, p, 10)
+	registerWebhook(t, p, ctx, webhookReceiverURL, api.GroupPrincipalsRemoved)
+
+	groupId := uuid.New()
+	if _, err := p.AddPrincipalToGroup(ctx, api.AddPrincipalToGroupRequestObject{Uuid: groupId, Body: &api.GroupPrincipalIn{
+		Principals: []api.PrincipalIn{{Username: "bob"}},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.DeletePrincipalFromGroup(ctx, api.DeletePrincipalFromGroupRequestObject{Uuid: groupId, Params: api.DeletePrincipalFromGroupParams{Usernames: "bob"}}); err != nil {
+		t.Fatal(err)
+	}
+	p.Webhooks.Wait()
+
+	if len(receiver.attempts) != 3 || len(receiver.deliveries) != 0 {
+		t.Errorf("expected 3 failed attempts, got %d attempts and %d deliveries", len(receiver.attempts), len(receiver.deliveries))
+	}
+	if receiver.attempts[0] != receiver.attempts[2] {
+		t.Errorf("retries were delivered as different events: %v", receiver.attempts)
+	}
+}
+
+func TestWebhookShutdownAbandonsRetries(t *testing.T) {
+	p, ctx := newWebhookTestServer(t)
+	p.Webhooks.Backoff = time.Hour
+	receiver := newWebhookReceiver(t, p, 10)
+	registerWebhook(t, p, ctx, webhookReceiverURL, api.GroupPrincipalsAdded)
+
+	if _, err := p.AddPrincipalToGroup(ctx, api.AddPrincipalToGroupRequestObject{Uuid: uuid.New(), Body: &api.GroupPrincipalIn{
+		Principals: []api.PrincipalIn{{Username: "bob"}},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := p.Webhooks.Shutdown(shutdownCtx); err == nil {
+		t.Errorf("expected the delivery to be abandoned")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("shutdown waited %s for the retry", elapsed)
+	}
+
+	receiver.mu.Lock()
+	defer receiver.mu.Unlock()
+	if len(receiver.attempts) != 1 {
+		t.Errorf("expected a single attempt, got %d", len(receiver.attempts))
+	}
+}
+
+func TestWebhookWritePermissionGranted(t *testing.T) {
+	p, ctx := newWebhookTestServer(t)
+	receiver := newWebhookReceiver(t, p, 0)
+	registerWebhook(t, p, ctx, webhookReceiverURL, api.RoleWritePermissionGranted)
+
+	created, err := p.CreateRole(ctx, api.CreateRoleRequestObject{Body: &api.RoleIn{
+		Name:   "hosts",
+		Access: []api.Access{{Permission: "inventory:hosts:read"}, {Permission: "inventory:hosts:write"}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	roleId := created.(api.CreateRole201JSONResponse).Uuid
+
+	// Only the write permission the role did not already have is reported
+	if _, err := p.UpdateRole(ctx, api.UpdateRoleRequestObject{Uuid: roleId, Body: &api.RoleWithAccess{
+		Name:   "hosts",
+		Access: []api.Access{{Permission: "inventory:hosts:write"}, {Permission: "inventory:groups:*"}},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	p.Webhooks.Wait()
+
+	if len(receiver.deliveries) != 2 {
+		t.Fatalf("expected 2 deliveries, got %d", len(receiver.deliveries))
+	}
+
+	var permissions [][]string
+	for _, delivery := range receiver.deliveries {
+		if delivery.event.Role != roleId.String() || delivery.event.Name != "hosts" {
+			t.Errorf("unexpected event %+v", delivery.event)
+		}
+		permissions = append(permissions, delivery.event.Permissions)
+	}
+	slices.SortFunc(permissions, func(a, b []string) int { return len(a[0]) - len(b[0]) })
+	if !slices.Equal(permissions[0], []string{"inventory:groups:*"}) || !slices.Equal(permissions[1], []string{"inventory:hosts:write"}) {
+		t.Errorf("unexpected permissions %v", permissions)
+	}
+}
+
+func TestWebhookManagement(t *testing.T) {
+	p, ctx := newWebhookTestServer(t)
+
+	member := withIdentity(context.Background(), "acme", "bob", false)
+	if resp, _ := p.ListWebhooks(member, api.ListWebhooksRequestObject{}); !isResponse[api.ListWebhooks403JSONResponse](resp) {
+		t.Errorf("expected 403 response for a member, got %T", resp)
+	}
+
+	secret := "too short"
+	if resp, _ := p.CreateWebhook(ctx, api.CreateWebhookRequestObject{Body: &api.WebhookIn{Url: "https://hooks.example.com", Secret: &secret, EventTypes: []api.WebhookEventType{api.RoleCreated}}}); !isResponse[api.CreateWebhook400JSONResponse](resp) {
+		t.Errorf("expected 400 response for a short secret, got %T", resp)
+	}
+	secret = "0123456789abcdef"
+	if resp, _ := p.CreateWebhook(ctx, api.CreateWebhookRequestObject{Body: &api.WebhookIn{Url: "hooks.example.com", Secret: &secret, EventTypes: []api.WebhookEventType{api.RoleCreated}}}); !isResponse[api.CreateWebhook400JSONResponse](resp) {
+		t.Errorf("expected 400 response for a relative URL, got %T", resp)
+	}
+	if resp, _ := p.CreateWebhook(ctx, api.CreateWebhookRequestObject{Body: &api.WebhookIn{Url: "https://hooks.example.com", Secret: &secret, EventTypes: []api.WebhookEventType{"role.renamed"}}}); !isResponse[api.CreateWebhook400JSONResponse](resp) {
+		t.Errorf("expected 400 response for an unknown event type, got %T", resp)
+	}
+
+	for _, url := range []string{
+		"http://hooks.example.com/rbac",
+		"https://169.254.169.254/latest/meta-data/",
+		"https://localhost:8443/",
+		"https://10.0.0.1/",
+		"https://[::1]/",
+	} {
+		if resp, _ := p.CreateWebhook(ctx, api.CreateWebhookRequestObject{Body: &api.WebhookIn{Url: url, Secret: &secret, EventTypes: []api.WebhookEventType{api.RoleCreated}}}); !isResponse[api.CreateWebhook400JSONResponse](resp) {
+			t.Errorf("expected 400 response for %s, got %T", url, resp)
+		}
+	}
+
+	webhook := registerWebhook(t, p, ctx, "https://hooks.example.com/rbac", api.RoleCreated)
+
+	// Other tenants do not see it
+	other := withIdentity(context.Background(), "globex", "carol", true)
+	if resp, _ := p.GetWebhook(other, api.GetWebhookRequestObject{Uuid: webhook.Uuid}); !isResponse[api.GetWebhook404JSONResponse](resp) {
+		t.Errorf("expected 404 response for another tenant, got %T", resp)
+	}
+
+	resp, err := p.ListWebhooks(ctx, api.ListWebhooksRequestObject{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page := resp.(api.ListWebhooks200JSONResponse); len(page.Data) != 1 || page.Data[0].Url != "https://hooks.example.com/rbac" {
+		t.Errorf("unexpected webhooks %+v", page.Data)
+	}
+
+	if resp, _ := p.DeleteWebhook(ctx, api.DeleteWebhookRequestObject{Uuid: webhook.Uuid}); !isResponse[api.DeleteWebhook204Response](resp) {
+		t.Errorf("expected 204 response, got %T", resp)
+	}
+	if resp, _ := p.DeleteWebhook(ctx, api.DeleteWebhookRequestObject{Uuid: webhook.Uuid}); !isResponse[api.DeleteWebhook404JSONResponse](resp) {
+		t.Errorf("expected 404 response once deleted, got %T", resp)
+	}
+}
+
+func TestInMemoryWebhookStore(t *testing.T) {
+	testWebhookStore(t, NewInMemoryWebhookStore())
+}
+
+func TestSQLWebhookStore(t *testing.T) {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "webhooks.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	store, err := NewSQLWebhookStore(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testWebhookStore(t, store)
+}
+
+// testWebhookStore checks that a backend keeps each tenant's webhooks apart, listing them oldest first.
+func testWebhookStore(t *testing.T, store WebhookStore) {
+	t.Helper()
+
+	created := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	webhooks := []Webhook{
+		{Id: "b", OrgId: "acme", URL: "https://example.com/b", Secret: "0123456789abcdef", EventTypes: []api.WebhookEventType{api.RoleCreated}, Created: created.Add(time.Minute)},
+		{Id: "a", OrgId: "acme", URL: "https://example.com/a", Secret: "0123456789abcdef", EventTypes: []api.WebhookEventType{api.GroupPrincipalsAdded, api.GroupRolesAdded}, Created: created},
+		{Id: "c", OrgId: "globex", URL: "https://example.com/c", Secret: "fedcba9876543210", EventTypes: []api.WebhookEventType{api.RoleUpdated}, Created: created},
+	}
+	for _, webhook := range webhooks {
+		if err := store.Put(webhook); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	listed, err := store.List("acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listed) != 2 || listed[0].Id != "a" || listed[1].Id != "b" || !slices.Equal(listed[0].EventTypes, webhooks[1].EventTypes) || !listed[0].Created.Equal(created) {
+		t.Errorf("unexpected webhooks: %+v", listed)
+	}
+
+	if webhook, ok, err := store.Get("acme", "b"); err != nil || !ok || webhook.URL != "https://example.com/b" || webhook.Secret != "0123456789abcdef" {
+		t.Errorf("unexpected webhook: %+v, %v, %v", webhook, ok, err)
+	}
+	if _, ok, err := store.Get("acme", "c"); err != nil || ok {
+		t.Errorf("found another tenant's webhook: %v, %v", ok, err)
+	}
+
+	if deleted, err := store.Delete("acme", "c"); err != nil || deleted {
+		t.Errorf("deleted another tenant's webhook: %v, %v", deleted, err)
+	}
+	if deleted, err := store.Delete("acme", "a"); err != nil || !deleted {
+		t.Errorf("webhook not deleted: %v, %v", deleted, err)
+	}
+	if listed, _ := store.List("acme"); len(listed) != 1 {
+		t.Errorf("unexpected webhooks after delete: %+v", listed)
+	}
+	if listed, _ := store.List("initech"); len(listed) != 0 {
+		t.Errorf("unexpected webhooks for a tenant without any: %+v", listed)
+	}
+}
+
+func TestWebhookDeliveryRefusesInternalAddresses(t *testing.T) {
+	receiver := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer receiver.Close()
+	_, port, _ := net.SplitHostPort(receiver.Listener.Addr().String())
+
+	// Hosts are checked as they are connected to, so names resolving to internal addresses are refused too
+	for _, url := range []string{receiver.URL, "https://localhost:" + port} {
+		_, err := webhookHTTPClient.Post(url, "application/json", strings.NewReader("{}"))
+		if err == nil || !strings.Contains(err.Error(), "refusing to connect to internal address") {
+			t.Errorf("expected delivery to %s to be refused, got %v", url, err)
+		}
+	}
+}
+
+func TestIsInternalAddress(t *testing.T) {
+	tests := []struct {
+		address  string
+		internal bool
+	}{
+		{"0.0.0.0", true},
+		{"0.1.2.3", true},
+		{"10.1.2.3", true},
+		{"100.64.0.1", true},
+		{"100.127.255.254", true},
+		{"127.0.0.1", true},
+		{"169.254.169.254", true},
+		{"172.16.0.1", true},
+		{"192.0.0.8", true},
+		{"192.168.1.1", true},
+		{"198.18.0.1", true},
+		{"198.19.255.254", true},
+		{"224.0.0.1", true},
+		{"255.255.255.255", true},
+		{"::", true},
+		{"::1", true},
+		{"::ffff:127.0.0.1", true},
+		{"::ffff:169.254.169.254", true},
+		{"::ffff:100.64.0.1", true},
+		{"64:ff9b::a9fe:a9fe", true}, // NAT64 of 169.254.169.254
+		{"64:ff9b::808:808", true},   // NAT64 of 8.8.8.8, refused as any NAT64 address is
+		{"64:ff9b:1::a00:1", true},
+		{"fc00::1", true},
+		{"fd12:3456::1", true},
+		{"fe80::1", true},
+		{"fe80::1%eth0", true},
+		{"ff02::1", true},
+		{"8.8.8.8", false},
+		{"100.128.0.1", false},
+		{"198.20.0.1", false},
+		{"192.0.1.1", false},
+		{"::ffff:8.8.8.8", false},
+		{"2001:4860:4860::8888", false},
+	}
+
+	for _, test := range tests {
+		if internal := isInternalAddress(netip.MustParseAddr(test.address)); internal != test.internal {
+			t.Errorf("%s: expected internal %t, got %t", test.address, test.internal, internal)
+		}
+	}
+}
+
+func isResponse[T any](response interface{}) bool {
+	_, ok := response.(T)
+	return ok
+}
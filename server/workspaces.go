@@ -0,0 +1,461 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/google/uuid"
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+func (p *PrbacSpicedbServer) ListWorkspaces(ctx context.Context, request api.ListWorkspacesRequestObject) (api.ListWorkspacesResponseObject, error) {
+	rootWorkspace := getRootWorkspace(getUserOrg(ctx))
+
+	// Walk the tree breadth first from the root, so parents are always listed before their children
+	var workspaces []api.Workspace
+	queue := []workspaceRef{{Id: rootWorkspace, ParentType: "organization", Parent: getUserOrg(ctx)}}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+
+		workspaces = append(workspaces, p.toWorkspace(next))
+
+		children, err := p.getWorkspaceChildren(ctx, next.Id)
+		if err != nil {
+			return api.ListWorkspaces500JSONResponse(errorBody(500, err.Error())), nil
+		}
+		queue = append(queue, children...)
+	}
+
+	start, end, pageSize := pageBounds(len(workspaces), request.Params.Limit, request.Params.Offset)
+
+	return api.ListWorkspaces200JSONResponse{
+		Data:  workspaces[start:end],
+		Links: p.paginationLinks("/workspaces/", len(workspaces), start, pageSize),
+		Meta:  paginationMeta(len(workspaces)),
+	}, nil
+}
+
+func (p *PrbacSpicedbServer) CreateWorkspace(ctx context.Context, request api.CreateWorkspaceRequestObject) (api.CreateWorkspaceResponseObject, error) {
+	parent := getRootWorkspace(getUserOrg(ctx))
+	if request.Body.Parent != nil {
+		parent = *request.Body.Parent
+	}
+
+	if _, found, err := p.getWorkspace(ctx, parent); err != nil {
+		return api.CreateWorkspace500JSONResponse(errorBody(500, err.Error())), nil
+	} else if !found {
+		return api.CreateWorkspace400JSONResponse(errorBody(400, "parent workspace not found: "+parent)), nil
+	}
+
+	workspaceId := uuid.NewString()
+
+	parentUpdate, parentless := workspaceParentClaim(workspaceId, parent)
+	_, err := p.writeRelationships(ctx, auditEntry(ctx, "createWorkspace", auditTargets("workspace", workspaceId)...), []*v1.RelationshipUpdate{
+		parentUpdate,
+		tenantUpdate(v1.RelationshipUpdate_OPERATION_CREATE, "workspace", workspaceId, getUserOrg(ctx)),
+	}, parentless)
+	if err != nil {
+		return api.CreateWorkspace500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	now := time.Now().UTC()
+	err = p.Metadata.Put("workspace", workspaceId, Metadata{
+		Name:        request.Body.Name,
+		Description: valueOrEmpty(request.Body.Description),
+		Created:     now,
+		Modified:    now,
+	})
+	if err != nil {
+		return api.CreateWorkspace500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	return api.CreateWorkspace201JSONResponse(p.toWorkspace(workspaceRef{Id: workspaceId, ParentType: "workspace", Parent: parent})), nil
+}
+
+func (p *PrbacSpicedbServer) GetWorkspace(ctx context.Context, request api.GetWorkspaceRequestObject) (api.GetWorkspaceResponseObject, error) {
+	workspace, found, err := p.getWorkspace(ctx, request.Id)
+	if err != nil {
+		return api.GetWorkspace500JSONResponse(errorBody(500, err.Error())), nil
+	}
+	if !found {
+		return api.GetWorkspace404JSONResponse(errorBody(404, "workspace not found: "+request.Id)), nil
+	}
+
+	return api.GetWorkspace200JSONResponse(p.toWorkspace(workspace)), nil
+}
+
+func (p *PrbacSpicedbServer) UpdateWorkspace(ctx context.Context, request api.UpdateWorkspaceRequestObject) (api.UpdateWorkspaceResponseObject, error) {
+	workspace, found, err := p.getWorkspace(ctx, request.Id)
+	if err != nil {
+		return api.UpdateWorkspace500JSONResponse(errorBody(500, err.Error())), nil
+	}
+	if !found {
+		return api.UpdateWorkspace404JSONResponse(errorBody(404, "workspace not found: "+request.Id)), nil
+	}
+
+	if request.Body.Parent != nil && *request.Body.Parent != workspace.Parent {
+		newParent := *request.Body.Parent
+
+		if workspace.ParentType != "workspace" {
+			return api.UpdateWorkspace400JSONResponse(errorBody(400, "the root workspace cannot be moved")), nil
+		}
+
+		if _, found, err := p.getWorkspace(ctx, newParent); err != nil {
+			return api.UpdateWorkspace500JSONResponse(errorBody(500, err.Error())), nil
+		} else if !found {
+			return api.UpdateWorkspace400JSONResponse(errorBody(400, "parent workspace not found: "+newParent)), nil
+		}
+
+		// Reparenting underneath itself or one of its own descendants would create a cycle
+		ancestors, err := p.getWorkspaceAncestors(ctx, newParent)
+		if err != nil {
+			return api.UpdateWorkspace500JSONResponse(errorBody(500, err.Error())), nil
+		}
+		for _, ancestor := range append([]workspaceRef{{Id: newParent}}, ancestors...) {
+			if ancestor.Id == workspace.Id {
+				return api.UpdateWorkspace400JSONResponse(errorBody(400, fmt.Sprintf("workspace %s cannot be moved beneath itself", workspace.Id))), nil
+			}
+		}
+
+		//TODO: some sort of concurrency check is required here: the cycle check is dependent upon results read above
+		// The parent read above must still be the only one, or a concurrent move would leave the workspace with two
+		_, err = p.writeRelationships(ctx, auditEntry(ctx, "updateWorkspace", auditTargets("workspace", workspace.Id)...), []*v1.RelationshipUpdate{
+			createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_DELETE, "workspace", workspace.Id, "parent", "workspace", workspace.Parent),
+			createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_CREATE, "workspace", workspace.Id, "parent", "workspace", newParent),
+		}, &v1.Precondition{
+			Operation: v1.Precondition_OPERATION_MUST_MATCH,
+			Filter: &v1.RelationshipFilter{
+				ResourceType:          "workspace",
+				OptionalResourceId:    workspace.Id,
+				OptionalRelation:      "parent",
+				OptionalSubjectFilter: &v1.SubjectFilter{SubjectType: "workspace", OptionalSubjectId: workspace.Parent},
+			},
+		})
+		if err != nil {
+			return api.UpdateWorkspace500JSONResponse(errorBody(500, err.Error())), nil
+		}
+
+		workspace.Parent = newParent
+	}
+
+	now := time.Now().UTC()
+	metadata, ok, err := p.Metadata.Get("workspace", workspace.Id)
+	if err != nil {
+		return api.UpdateWorkspace500JSONResponse(errorBody(500, err.Error())), nil
+	}
+	if !ok {
+		metadata.Created = now
+	}
+	metadata.Name = request.Body.Name
+	metadata.Description = valueOrEmpty(request.Body.Description)
+	metadata.Modified = now
+	if err := p.Metadata.Put("workspace", workspace.Id, metadata); err != nil {
+		return api.UpdateWorkspace500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	return api.UpdateWorkspace200JSONResponse(p.toWorkspace(workspace)), nil
+}
+
+func (p *PrbacSpicedbServer) DeleteWorkspace(ctx context.Context, request api.DeleteWorkspaceRequestObject) (api.DeleteWorkspaceResponseObject, error) {
+	workspace, found, err := p.getWorkspace(ctx, request.Id)
+	if err != nil {
+		return api.DeleteWorkspace500JSONResponse(errorBody(500, err.Error())), nil
+	}
+	if !found {
+		return api.DeleteWorkspace404JSONResponse(errorBody(404, "workspace not found: "+request.Id)), nil
+	}
+
+	if workspace.ParentType != "workspace" {
+		return api.DeleteWorkspace409JSONResponse(errorBody(409, "the root workspace cannot be deleted")), nil
+	}
+
+	inUse, err := p.getWorkspaceUsage(ctx, workspace.Id)
+	if err != nil {
+		return api.DeleteWorkspace500JSONResponse(errorBody(500, err.Error())), nil
+	}
+	if inUse != "" {
+		return api.DeleteWorkspace409JSONResponse(errorBody(409, fmt.Sprintf("workspace %s is still referenced by %s", workspace.Id, inUse))), nil
+	}
+
+	_, err = p.writeRelationships(ctx, auditEntry(ctx, "deleteWorkspace", auditTargets("workspace", workspace.Id)...), []*v1.RelationshipUpdate{
+		createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_DELETE, "workspace", workspace.Id, "parent", "workspace", workspace.Parent),
+		tenantUpdate(v1.RelationshipUpdate_OPERATION_DELETE, "workspace", workspace.Id, getUserOrg(ctx)),
+	})
+	if err != nil {
+		return api.DeleteWorkspace500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	if err := p.Metadata.Delete("workspace", workspace.Id); err != nil {
+		return api.DeleteWorkspace500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	return api.DeleteWorkspace204Response{}, nil
+}
+
+func (p *PrbacSpicedbServer) ListWorkspaceChildren(ctx context.Context, request api.ListWorkspaceChildrenRequestObject) (api.ListWorkspaceChildrenResponseObject, error) {
+	if _, found, err := p.getWorkspace(ctx, request.Id); err != nil {
+		return api.ListWorkspaceChildren500JSONResponse(errorBody(500, err.Error())), nil
+	} else if !found {
+		return api.ListWorkspaceChildren404JSONResponse(errorBody(404, "workspace not found: "+request.Id)), nil
+	}
+
+	children, err := p.getWorkspaceChildren(ctx, request.Id)
+	if err != nil {
+		return api.ListWorkspaceChildren500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	workspaces := make([]api.Workspace, len(children))
+	for i, child := range children {
+		workspaces[i] = p.toWorkspace(child)
+	}
+
+	start, end, pageSize := pageBounds(len(workspaces), request.Params.Limit, request.Params.Offset)
+
+	return api.ListWorkspaceChildren200JSONResponse{
+		Data:  workspaces[start:end],
+		Links: p.paginationLinks("/workspaces/"+request.Id+"/children/", len(workspaces), start, pageSize),
+		Meta:  paginationMeta(len(workspaces)),
+	}, nil
+}
+
+func (p *PrbacSpicedbServer) ListWorkspaceAncestors(ctx context.Context, request api.ListWorkspaceAncestorsRequestObject) (api.ListWorkspaceAncestorsResponseObject, error) {
+	if _, found, err := p.getWorkspace(ctx, request.Id); err != nil {
+		return api.ListWorkspaceAncestors500JSONResponse(errorBody(500, err.Error())), nil
+	} else if !found {
+		return api.ListWorkspaceAncestors404JSONResponse(errorBody(404, "workspace not found: "+request.Id)), nil
+	}
+
+	ancestors, err := p.getWorkspaceAncestors(ctx, request.Id)
+	if err != nil {
+		return api.ListWorkspaceAncestors500JSONResponse(errorBody(500, err.Error())), nil
+	}
+
+	workspaces := make([]api.Workspace, len(ancestors))
+	for i, ancestor := range ancestors {
+		workspaces[i] = p.toWorkspace(ancestor)
+	}
+
+	return api.ListWorkspaceAncestors200JSONResponse{
+		Data: workspaces,
+		Meta: paginationMeta(len(workspaces)),
+	}, nil
+}
+
+// workspaceRef is a workspace together with its parent, which is either another workspace or, for a tenant's
+// root workspace, the organization.
+type workspaceRef struct {
+	Id         string
+	ParentType string
+	Parent     string
+}
+
+// getWorkspace looks up a workspace, only finding it if it is part of the caller's organization.
+func (p *PrbacSpicedbServer) getWorkspace(ctx context.Context, workspaceId string) (workspaceRef, bool, error) {
+	return p.getOrgWorkspace(ctx, getUserOrg(ctx), workspaceId)
+}
+
+// getOrgWorkspace looks up a workspace, only finding it if it is part of an organization: either its owner on record,
+// or for workspaces created before owners were recorded, the organization its root workspace belongs to.
+func (p *PrbacSpicedbServer) getOrgWorkspace(ctx context.Context, org, workspaceId string) (workspaceRef, bool, error) {
+	workspace, found, err := p.getWorkspaceParent(ctx, workspaceId)
+	if err != nil || !found {
+		return workspaceRef{}, false, err
+	}
+
+	rootWorkspace := getRootWorkspace(org)
+	if workspace.Id == rootWorkspace {
+		return workspace, true, nil
+	}
+
+	if tenant, err := p.getTenant(ctx, "workspace", workspaceId); err != nil {
+		return workspaceRef{}, false, err
+	} else if tenant != "" {
+		return workspace, tenant == org, nil
+	}
+
+	ancestors, err := p.getWorkspaceAncestors(ctx, workspaceId)
+	if err != nil {
+		return workspaceRef{}, false, err
+	}
+	for _, ancestor := range ancestors {
+		if ancestor.Id == rootWorkspace {
+			return workspace, true, nil
+		}
+	}
+
+	return workspaceRef{}, false, nil
+}
+
+// getWorkspaceParent finds the parent of a workspace, which has exactly one unless it does not exist.
+func (p *PrbacSpicedbServer) getWorkspaceParent(ctx context.Context, workspaceId string) (workspaceRef, bool, error) {
+	relationships, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+		ResourceType:       "workspace",
+		OptionalResourceId: workspaceId,
+		OptionalRelation:   "parent",
+	})
+	if err != nil || len(relationships) == 0 {
+		return workspaceRef{}, false, err
+	}
+	if len(relationships) > 1 {
+		return workspaceRef{}, false, fmt.Errorf("workspace %s has %d parents", workspaceId, len(relationships))
+	}
+
+	parent := relationships[0].GetSubject().GetObject()
+
+	return workspaceRef{Id: workspaceId, ParentType: parent.GetObjectType(), Parent: parent.GetObjectId()}, true, nil
+}
+
+// workspaceParentClaim returns what a write giving a new workspace its parent must include: the parent, and a
+// precondition failing the write should the workspace already have one, so that no workspace ends up with two.
+func workspaceParentClaim(workspaceId, parent string) (*v1.RelationshipUpdate, *v1.Precondition) {
+	return createRelationshipUpdate(v1.RelationshipUpdate_OPERATION_CREATE, "workspace", workspaceId, "parent", "workspace", parent), &v1.Precondition{
+		Operation: v1.Precondition_OPERATION_MUST_NOT_MATCH,
+		Filter:    &v1.RelationshipFilter{ResourceType: "workspace", OptionalResourceId: workspaceId, OptionalRelation: "parent"},
+	}
+}
+
+// getWorkspaceAncestors follows the parent relation up to the organization, returning the nearest ancestor first.
+func (p *PrbacSpicedbServer) getWorkspaceAncestors(ctx context.Context, workspaceId string) ([]workspaceRef, error) {
+	var ancestors []workspaceRef
+
+	workspace, found, err := p.getWorkspaceParent(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	visited := map[string]bool{workspaceId: true}
+	for found && workspace.ParentType == "workspace" {
+		if visited[workspace.Parent] {
+			return nil, fmt.Errorf("workspace hierarchy contains a cycle at %s", workspace.Parent)
+		}
+		visited[workspace.Parent] = true
+
+		parentId := workspace.Parent
+		workspace, found, err = p.getWorkspaceParent(ctx, parentId)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			workspace = workspaceRef{Id: parentId}
+		}
+
+		ancestors = append(ancestors, workspace)
+	}
+
+	return ancestors, nil
+}
+
+func (p *PrbacSpicedbServer) getWorkspaceChildren(ctx context.Context, workspaceId string) ([]workspaceRef, error) {
+	relationships, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+		ResourceType:     "workspace",
+		OptionalRelation: "parent",
+		OptionalSubjectFilter: &v1.SubjectFilter{
+			SubjectType:       "workspace",
+			OptionalSubjectId: workspaceId,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	children := make([]workspaceRef, len(relationships))
+	for i, relationship := range relationships {
+		children[i] = workspaceRef{Id: relationship.GetResource().GetObjectId(), ParentType: "workspace", Parent: workspaceId}
+	}
+
+	return children, nil
+}
+
+// getWorkspaceUsage describes the first thing found still referencing a workspace: a child workspace, a role binding
+// other than ignoredBindings, or a resource of any workspace-scoped type in the schema. It returns an empty string if
+// the workspace is unused.
+func (p *PrbacSpicedbServer) getWorkspaceUsage(ctx context.Context, workspaceId string, ignoredBindings ...string) (string, error) {
+	children, err := p.getWorkspaceChildren(ctx, workspaceId)
+	if err != nil {
+		return "", err
+	}
+	if len(children) != 0 {
+		return "workspace:" + children[0].Id, nil
+	}
+
+	bindings, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+		ResourceType:       "workspace",
+		OptionalResourceId: workspaceId,
+		OptionalRelation:   "user_grant",
+	})
+	if err != nil {
+		return "", err
+	}
+	for _, binding := range bindings {
+		bindingId := binding.GetSubject().GetObject().GetObjectId()
+		if !slices.Contains(ignoredBindings, bindingId) {
+			return "role_binding:" + bindingId, nil
+		}
+	}
+
+	definitions, err := readSchemaDefinitions(ctx, p.SpicedbClient)
+	if err != nil {
+		return "", err
+	}
+
+	for _, resourceType := range workspaceScopedTypes(definitions) {
+		resources, err := readRelationships(ctx, p.SpicedbClient, &v1.RelationshipFilter{
+			ResourceType:     resourceType,
+			OptionalRelation: "workspace",
+			OptionalSubjectFilter: &v1.SubjectFilter{
+				SubjectType:       "workspace",
+				OptionalSubjectId: workspaceId,
+			},
+		})
+		if err != nil {
+			return "", err
+		}
+		if len(resources) != 0 {
+			return resourceType + ":" + resources[0].GetResource().GetObjectId(), nil
+		}
+	}
+
+	return "", nil
+}
+
+// toWorkspace combines a workspace's place in the hierarchy with its metadata. Workspaces created implicitly, e.g.
+// by CreateRole for a group.id resource definition, have no metadata and are named after their id.
+func (p *PrbacSpicedbServer) toWorkspace(workspace workspaceRef) api.Workspace {
+	result := api.Workspace{
+		Id:   workspace.Id,
+		Name: workspace.Id,
+	}
+
+	if workspace.ParentType == "workspace" {
+		parent := workspace.Parent
+		result.Parent = &parent
+	}
+
+	if metadata, ok := p.getMetadata("workspace", workspace.Id); ok {
+		result.Name = metadata.Name
+		if metadata.Description != "" {
+			description := metadata.Description
+			result.Description = &description
+		}
+		result.Created = metadata.Created
+		result.Modified = metadata.Modified
+	}
+
+	return result
+}
+
+func getRootWorkspace(org string) string {
+	return org + "_root"
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/merlante/prbac-spicedb/api"
+)
+
+const testSchema = `
+definition workspace {
+    relation parent: workspace | organization
+    relation user_grant: role_binding
+}
+
+definition inventory/hosts {
+    relation workspace: workspace
+    permission read = workspace->inventory_hosts_read
+}
+`
+
+func newWorkspaceTestServer(t *testing.T, tuples ...string) (*PrbacSpicedbServer, *fakeSpiceDB) {
+	return newTestServer(t, append([]string{"workspace:aspian_root#parent@organization:aspian"}, tuples...)...)
+}
+
+func TestCreateWorkspace(t *testing.T) {
+	p, _ := newWorkspaceTestServer(t)
+	description := "Hosts serving production traffic"
+
+	resp, err := p.CreateWorkspace(context.Background(), api.CreateWorkspaceRequestObject{Body: &api.WorkspaceIn{Name: "Production", Description: &description}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created := resp.(api.CreateWorkspace201JSONResponse)
+	if created.Name != "Production" || *created.Description != description || *created.Parent != "aspian_root" {
+		t.Errorf("unexpected workspace: %+v", created)
+	}
+
+	got, _ := p.GetWorkspace(context.Background(), api.GetWorkspaceRequestObject{Id: created.Id})
+	if got.(api.GetWorkspace200JSONResponse).Name != "Production" {
+		t.Errorf("unexpected workspace: %+v", got)
+	}
+
+	list, _ := p.ListWorkspaces(context.Background(), api.ListWorkspacesRequestObject{})
+	if data := list.(api.ListWorkspaces200JSONResponse).Data; len(data) != 2 || data[0].Id != "aspian_root" || data[1].Id != created.Id {
+		t.Errorf("unexpected workspaces: %+v", data)
+	}
+}
+
+func TestCreateWorkspaceRejectsUnknownParent(t *testing.T) {
+	p, _ := newWorkspaceTestServer(t, "workspace:other_root#parent@organization:other", "workspace:ws1#parent@workspace:other_root")
+	parent := "ws1"
+
+	resp, _ := p.CreateWorkspace(context.Background(), api.CreateWorkspaceRequestObject{Body: &api.WorkspaceIn{Name: "Nested", Parent: &parent}})
+	if _, ok := resp.(api.CreateWorkspace400JSONResponse); !ok {
+		t.Errorf("expected 400 response, got %T", resp)
+	}
+}
+
+func TestUpdateWorkspaceRejectsCycles(t *testing.T) {
+	p, spicedb := newWorkspaceTestServer(t,
+		"workspace:ws1#parent@workspace:aspian_root",
+		"workspace:ws2#parent@workspace:ws1",
+		"workspace:ws3#parent@workspace:ws2",
+	)
+
+	for _, parent := range []string{"ws1", "ws3"} {
+		parent := parent
+		resp, _ := p.UpdateWorkspace(context.Background(), api.UpdateWorkspaceRequestObject{Id: "ws1", Body: &api.WorkspaceIn{Name: "ws1", Parent: &parent}})
+		if _, ok := resp.(api.UpdateWorkspace400JSONResponse); !ok {
+			t.Errorf("expected moving ws1 beneath %s to be rejected, got %T", parent, resp)
+		}
+	}
+
+	parent := "aspian_root"
+	resp, _ := p.UpdateWorkspace(context.Background(), api.UpdateWorkspaceRequestObject{Id: "ws3", Body: &api.WorkspaceIn{Name: "Moved", Parent: &parent}})
+	if updated, ok := resp.(api.UpdateWorkspace200JSONResponse); !ok || updated.Name != "Moved" || *updated.Parent != "aspian_root" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+
+	for _, tuple := range spicedb.tuples() {
+		if tuple == "workspace:ws3#parent@workspace:ws2" {
+			t.Errorf("old parent relationship was not removed")
+		}
+	}
+}
+
+func TestWorkspaceWithTwoParents(t *testing.T) {
+	p, spicedb := newWorkspaceTestServer(t,
+		"workspace:ws1#parent@workspace:aspian_root",
+		"workspace:ws2#parent@workspace:aspian_root",
+		"workspace:ws2#parent@workspace:ws1",
+	)
+
+	if _, _, err := p.getWorkspaceParent(context.Background(), "ws2"); err == nil {
+		t.Errorf("expected an error for a workspace with two parents")
+	}
+
+	parent := "aspian_root"
+	resp, _ := p.UpdateWorkspace(context.Background(), api.UpdateWorkspaceRequestObject{Id: "ws2", Body: &api.WorkspaceIn{Name: "ws2", Parent: &parent}})
+	if _, ok := resp.(api.UpdateWorkspace500JSONResponse); !ok {
+		t.Errorf("expected 500 response, got %T", resp)
+	}
+	if spicedb.writes != 0 {
+		t.Errorf("wrote %d times", spicedb.writes)
+	}
+}
+
+func TestListWorkspaceAncestors(t *testing.T) {
+	p, _ := newWorkspaceTestServer(t,
+		"workspace:ws1#parent@workspace:aspian_root",
+		"workspace:ws2#parent@workspace:ws1",
+	)
+
+	resp, _ := p.ListWorkspaceAncestors(context.Background(), api.ListWorkspaceAncestorsRequestObject{Id: "ws2"})
+
+	data := resp.(api.ListWorkspaceAncestors200JSONResponse).Data
+	if len(data) != 2 || data[0].Id != "ws1" || data[1].Id != "aspian_root" {
+		t.Errorf("unexpected ancestors: %+v", data)
+	}
+}
+
+func TestDeleteWorkspaceInUse(t *testing.T) {
+	p, spicedb := newWorkspaceTestServer(t,
+		"workspace:parent#parent@workspace:aspian_root",
+		"workspace:child#parent@workspace:parent",
+		"workspace:bound#parent@workspace:aspian_root",
+		"workspace:bound#user_grant@role_binding:rb1",
+		"workspace:hosts#parent@workspace:aspian_root",
+		"inventory/hosts:h1#workspace@workspace:hosts",
+		"workspace:empty#parent@workspace:aspian_root",
+	)
+
+	for _, id := range []string{"parent", "bound", "hosts", "aspian_root"} {
+		resp, _ := p.DeleteWorkspace(context.Background(), api.DeleteWorkspaceRequestObject{Id: id})
+		if _, ok := resp.(api.DeleteWorkspace409JSONResponse); !ok {
+			t.Errorf("expected deleting %s to be refused, got %T", id, resp)
+		}
+	}
+
+	resp, _ := p.DeleteWorkspace(context.Background(), api.DeleteWorkspaceRequestObject{Id: "empty"})
+	if _, ok := resp.(api.DeleteWorkspace204Response); !ok {
+		t.Fatalf("expected 204 response, got %T", resp)
+	}
+	if len(spicedb.tuples()) != 7 {
+		t.Errorf("unexpected relationships after delete: %v", spicedb.tuples())
+	}
+}